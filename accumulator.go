@@ -4,9 +4,11 @@ import (
 	"accumulator/db"
 	"bytes"
 	"context"
-	"encoding/json"
+	"database/sql"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
 	"go.uber.org/zap"
 )
 
@@ -37,116 +40,75 @@ var sessionManager *scs.SessionManager
 // ErrNotImplemented is used to stub empty funcs
 var ErrNotImplemented = errors.New("not implemented")
 
-// ErrUnableToPopulate occurs because of SQLite's ID creation order
-var ErrUnableToPopulate = "db: unable to populate default values"
-
 // SecureHandlerFunc is a custom http.HandlerFunc that returns a status code and error
 type SecureHandlerFunc func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error)
 
 // HandlerFunc is a custom http.HandlerFunc that returns a status code and error
 type HandlerFunc func(w http.ResponseWriter, r *http.Request) (interface{}, int, error)
 
-// ErrorResponse for HTTP
-type ErrorResponse struct {
-	Err     string `json:"err"`
-	Message string `json:"message"`
-}
-
-// Err constructor
-func Err(err error, message ...string) *ErrorResponse {
-	e := &ErrorResponse{
-		Err: err.Error(),
+// userFromRequest authenticates the JWT from the cookie or the Authorization header
+func userFromRequest(auther *Auther, r *http.Request) (*db.User, error) {
+	jwtString := ""
+	cookie, err := r.Cookie("jwt")
+	if err != nil {
+		jwtString = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
 	}
-	e.Message = err.Error()
-	if len(message) > 0 {
-		e.Message = message[0]
+	if cookie != nil {
+		jwtString = cookie.Value
+	}
+	if jwtString == "" {
+		return nil, errors.New("no jwt provided in cookie or header")
 	}
-	return e
-}
 
-// Unwrap the inner error
-func (e *ErrorResponse) Unwrap() error {
-	return errors.New(e.Err)
-}
-func (e *ErrorResponse) Error() string {
-	return e.Message
-}
+	token, err := auther.TokenAuth.Decode(jwtString)
+	if err != nil {
+		return nil, err
+	}
 
-// JSON body for HTTP response
-func (e *ErrorResponse) JSON() string {
-	b, err := json.Marshal(e)
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("could not cast to jwt.MapClaims")
+	}
+	idI, ok := claims["id"]
+	if !ok {
+		return nil, errors.New("could not read value for key id")
+	}
+	idStr, ok := idI.(string)
+	if !ok {
+		return nil, errors.New("could not cast id to string")
+	}
+	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		fmt.Println(err)
-		return ""
+		return nil, err
 	}
-	return string(b)
+	return db.FindUserG(int64(id))
 }
 func withUser(auther *Auther, next SecureHandlerFunc) HandlerFunc {
 	fn := func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
-		jwtString := ""
-		cookie, err := r.Cookie("jwt")
-		if err != nil {
-			jwtString = strings.TrimLeft(r.Header.Get("Authorization"), "Bearer ")
-		}
-		if cookie != nil {
-			jwtString = cookie.Value
-		}
-		if jwtString == "" {
-			return nil, http.StatusUnauthorized, errors.New("no jwt provided in cookie or header")
-		}
-
-		token, err := auther.TokenAuth.Decode(jwtString)
-		if err != nil {
-			return nil, http.StatusUnauthorized, err
-		}
-
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return nil, http.StatusUnauthorized, errors.New("could not cast to jwt.MapClaims")
-		}
-		idI, ok := claims["id"]
-		if !ok {
-			return nil, http.StatusUnauthorized, errors.New("could not read value for key id")
-		}
-		idStr, ok := idI.(string)
-		if !ok {
-			return nil, http.StatusUnauthorized, errors.New("could not cast id to string")
-		}
-		id, err := strconv.Atoi(idStr)
+		u, err := userFromRequest(auther, r)
 		if err != nil {
-			return nil, http.StatusUnauthorized, err
-		}
-		u, err := db.FindUserG(null.Int64From(int64(id)))
-		if err != nil {
-			return nil, http.StatusUnauthorized, err
+			return nil, http.StatusUnauthorized, errUnauthorized(err)
 		}
 		return next(w, r, u)
 	}
 	return fn
 }
-func withError(next HandlerFunc) http.HandlerFunc {
+
+// withError writes the result as JSON, or the error in the shape described by ErrorResponse
+func (c *API) withError(next HandlerFunc) http.HandlerFunc {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		result, code, err := next(w, r)
 		if err != nil {
-			fmt.Println(err)
-			http.Error(w, Err(err).JSON(), code)
+			writeError(w, r, c.log, err, code)
 			return
 		}
-		if result == nil {
-			if err == nil {
-				err = errors.New("no response")
-			}
-			fmt.Println(err)
-			http.Error(w, Err(err, "no response").JSON(), code)
-			return
+		if code == 0 {
+			code = http.StatusOK
 		}
-		err = json.NewEncoder(w).Encode(result)
+		err = writeJSON(w, code, result)
 		if err != nil {
-			fmt.Println(err)
-			http.Error(w, Err(err).JSON(), code)
-			return
+			c.log.Errorw("write response", "request_id", middleware.GetReqID(r.Context()), "err", err)
 		}
-		return
 	}
 	return fn
 }
@@ -168,18 +130,23 @@ const caddyfileTemplate = `
 `
 
 // RunServer the service
-func RunServer(ctx context.Context, conn *sqlx.DB, serverAddr string, jwtsecret string, d *Darer, log *zap.SugaredLogger) error {
+func RunServer(ctx context.Context, conn *sqlx.DB, serverAddr string, jwtsecret string, d *Darer, blobs *BlobStorage, hub *Hub, stepMinutes int, log *zap.SugaredLogger) error {
 	sessionManager = scs.New()
 	sessionManager.Lifetime = 24 * time.Hour
 	log.Infow("start api", "svc-addr", serverAddr)
 	auther := NewAuther(jwtsecret)
-	c := &API{log}
+	c := &API{log, blobs, time.Duration(stepMinutes) * time.Minute}
+	r := c.router(auther, d, hub)
+	return http.ListenAndServe(serverAddr, withoutSessionsForWebSockets(sessionManager.LoadAndSave(r), r))
+}
 
+// router of every API route, each of which apiRoutes describes
+func (c *API) router(auther *Auther, d *Darer, hub *Hub) chi.Router {
 	cors := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", "Deprecation", "API-Version"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
@@ -188,46 +155,148 @@ func RunServer(ctx context.Context, conn *sqlx.DB, serverAddr string, jwtsecret
 	r.Use(cors.Handler)
 	r.Use(middleware.RequestID)
 	r.Use(middleware.RealIP)
-	r.Use(middleware.Logger)
+	r.Use(requestLogger(c.log))
 	r.Use(middleware.Recoverer)
 	r.Route("/api", func(r chi.Router) {
 		// Authenticated routes
 		r.Group(func(r chi.Router) {
-			r.Post("/auth/sign_out", withError(c.signOutHandler))
-			r.Get("/auth/check", withError(withUser(auther, c.checkHandler(auther))))
-			r.Post("/auth/set_password", withError(withUser(auther, c.setPasswordHandler())))
-			r.Get("/auth/jwt", withError(withUser(auther, c.userJWTHandler(auther))))
-
-			r.Get("/blobs/{blob_id}", c.blobHandler())
-
-			r.Get("/users/list", withError(withUser(auther, c.userListHandler())))
-			r.Post("/users/impersonate/{user_id}", withError(withUser(auther, c.userImpersonateHandler(auther))))
-
-			r.Get("/integrations/list", withError(withUser(auther, c.integrationsListHandler)))
-			r.Post("/integrations/add_username", withError(withUser(auther, c.integrationsAddUsernameHandler(d))))
-			r.Post("/integrations/{integration_id}/update_friends", withError(withUser(auther, c.integrationUpdateFriendsHandler(d))))
-			r.Post("/integrations/{integration_id}/delete", withError(withUser(auther, c.integrationsDeleteHandler)))
-			r.Get("/integrations/{integration_id}/attendance/{teacher_id}/list", withError(withUser(auther, c.attendanceListHandler)))
-			r.Get("/integrations/{integration_id}/friends/list", withError(withUser(auther, c.friendListHandler)))
-			r.Post("/integrations/{integration_id}/friends/refresh", withError(withUser(auther, c.friendRefreshHandler)))
-			r.Post("/integrations/{integration_id}/friends/{friend_id}/promote", withError(withUser(auther, c.friendPromoteHandler)))
-			r.Post("/integrations/{integration_id}/friends/{friend_id}/demote", withError(withUser(auther, c.friendDemoteHandler)))
+			r.Use(deprecations)
+			r.Post("/auth/sign_out", c.withError(c.signOutHandler))
+			r.Get("/auth/check", c.withError(withUser(auther, c.checkHandler(auther))))
+			r.Post("/auth/set_password", c.withError(withUser(auther, c.setPasswordHandler())))
+			r.Get("/auth/jwt", c.withError(withUser(auther, c.userJWTHandler(auther))))
+
+			r.Get("/blobs/{blob_id}", c.blobHandler(auther))
+			r.Post("/blobs/{blob_id}/sign", c.withError(withUser(auther, c.blobSignHandler(auther))))
+
+			r.Get("/users/list", c.withError(withUser(auther, c.userListHandler())))
+			r.Post("/users/impersonate/{user_id}", c.withError(withUser(auther, c.userImpersonateHandler(auther))))
+
+			r.Get("/integrations/list", c.withError(withUser(auther, c.integrationsListHandler)))
+			r.Post("/integrations/add_username", c.withError(withUser(auther, c.integrationsAddUsernameHandler(d))))
+			r.Post("/integrations/{integration_id}/update_friends", c.withError(withUser(auther, c.integrationUpdateFriendsHandler(d))))
+			r.Post("/integrations/{integration_id}/delete", c.withError(withUser(auther, c.integrationsDeleteHandler)))
+			r.Get("/integrations/{integration_id}/attendance/{teacher_id}/list", c.withError(withUser(auther, c.attendanceListHandler)))
+			r.Get("/integrations/{integration_id}/live", c.liveHandler(auther, hub))
+			r.Get("/integrations/{integration_id}/friends/list", c.withError(withUser(auther, c.friendListHandler)))
+			r.Post("/integrations/{integration_id}/friends/refresh", c.withError(withUser(auther, c.friendRefreshHandler)))
+			r.Post("/integrations/{integration_id}/friends/{vrchat_id}/promote", c.withError(withUser(auther, c.friendPromoteHandler)))
+			r.Post("/integrations/{integration_id}/friends/{vrchat_id}/demote", c.withError(withUser(auther, c.friendDemoteHandler)))
+		})
+
+		// Stable API for external integrations
+		r.Route("/v1", func(r chi.Router) {
+			r.Use(c.negotiateVersion)
+			r.Get("/integrations", c.withError(withUser(auther, c.v1IntegrationsListHandler)))
+			r.Get("/integrations/{integration_id}", c.withError(withUser(auther, c.v1IntegrationHandler)))
+			r.Patch("/integrations/{integration_id}", c.withError(withUser(auther, c.v1IntegrationUpdateHandler)))
+			r.Get("/integrations/{integration_id}/friends", c.withError(withUser(auther, c.v1FriendsListHandler)))
+			r.Get("/integrations/{integration_id}/friends/{friend_id}", c.withError(withUser(auther, c.v1FriendHandler)))
+			r.Patch("/integrations/{integration_id}/friends/{friend_id}", c.withError(withUser(auther, c.v1FriendUpdateHandler)))
+			r.Get("/integrations/{integration_id}/attendance", c.withError(withUser(auther, c.v1AttendanceListHandler)))
+			r.Get("/integrations/{integration_id}/presence", c.withError(withUser(auther, c.v1PresenceHandler(hub))))
+			r.Get("/integrations/{integration_id}/webhooks", c.withError(withUser(auther, c.v1WebhooksListHandler)))
+			r.Post("/integrations/{integration_id}/webhooks", c.withError(withUser(auther, c.v1WebhookCreateHandler(d))))
+			r.Delete("/integrations/{integration_id}/webhooks/{webhook_id}", c.withError(withUser(auther, c.v1WebhookDeleteHandler)))
+			r.Get("/integrations/{integration_id}/webhooks/{webhook_id}/deliveries", c.withError(withUser(auther, c.v1WebhookDeliveriesHandler)))
+			r.Post("/integrations/{integration_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/replay", c.withError(withUser(auther, c.v1WebhookReplayHandler)))
+			r.Get("/integrations/{integration_id}/schedules", c.withError(withUser(auther, c.v1SchedulesListHandler)))
+			r.Post("/integrations/{integration_id}/schedules", c.withError(withUser(auther, c.v1ScheduleCreateHandler)))
+			r.Put("/integrations/{integration_id}/schedules/{schedule_id}", c.withError(withUser(auther, c.v1ScheduleUpdateHandler)))
+			r.Delete("/integrations/{integration_id}/schedules/{schedule_id}", c.withError(withUser(auther, c.v1ScheduleDeleteHandler)))
+			r.Get("/integrations/{integration_id}/schedules/{schedule_id}/occurrences", c.withError(withUser(auther, c.v1OccurrencesHandler)))
+			r.Get("/integrations/{integration_id}/rosters", c.withError(withUser(auther, c.v1RostersListHandler)))
+			r.Post("/integrations/{integration_id}/rosters", c.withError(withUser(auther, c.v1RosterCreateHandler)))
+			r.Put("/integrations/{integration_id}/rosters/{roster_id}", c.withError(withUser(auther, c.v1RosterUpdateHandler)))
+			r.Delete("/integrations/{integration_id}/rosters/{roster_id}", c.withError(withUser(auther, c.v1RosterDeleteHandler)))
+			r.Get("/integrations/{integration_id}/rosters/{roster_id}/students", c.withError(withUser(auther, c.v1EnrolmentsListHandler)))
+			r.Put("/integrations/{integration_id}/rosters/{roster_id}/students/{friend_id}", c.withError(withUser(auther, c.v1EnrolHandler)))
+			r.Delete("/integrations/{integration_id}/rosters/{roster_id}/students/{friend_id}", c.withError(withUser(auther, c.v1UnenrolHandler)))
+			r.Get("/integrations/{integration_id}/sessions", c.withError(withUser(auther, c.v1SessionsListHandler)))
+			r.Get("/integrations/{integration_id}/sessions/export", c.v1SessionsExportHandler(auther))
+			r.Put("/integrations/{integration_id}/sessions/{session_id}/override", c.withError(withUser(auther, c.v1SessionOverrideHandler)))
+			r.Delete("/integrations/{integration_id}/sessions/{session_id}/override", c.withError(withUser(auther, c.v1SessionOverrideDeleteHandler)))
+			r.Get("/integrations/{integration_id}/manual_attendance", c.withError(withUser(auther, c.v1ManualAttendanceListHandler)))
+			r.Post("/integrations/{integration_id}/manual_attendance", c.withError(withUser(auther, c.v1ManualAttendanceCreateHandler)))
+			r.Put("/integrations/{integration_id}/manual_attendance/{entry_id}", c.withError(withUser(auther, c.v1ManualAttendanceUpdateHandler)))
+			r.Delete("/integrations/{integration_id}/manual_attendance/{entry_id}", c.withError(withUser(auther, c.v1ManualAttendanceDeleteHandler)))
+			r.Get("/integrations/{integration_id}/audit", c.withError(withUser(auther, c.v1AuditHandler)))
+			r.Get("/integrations/{integration_id}/coverage", c.withError(withUser(auther, c.v1CoverageHandler)))
+			r.Get("/integrations/{integration_id}/reprocess_jobs", c.withError(withUser(auther, c.v1ReprocessJobsListHandler)))
+			r.Post("/integrations/{integration_id}/reprocess_jobs", c.withError(withUser(auther, c.v1ReprocessJobCreateHandler)))
+			r.Get("/integrations/{integration_id}/reprocess_jobs/{job_id}", c.withError(withUser(auther, c.v1ReprocessJobHandler)))
 		})
 
 		// Public routes
 		r.Group(func(r chi.Router) {
-			r.Post("/auth/sign_in", withError(c.signInHandler(auther)))
-			r.Post("/auth/sign_up", withError(c.signUpHandler(auther)))
+			r.Post("/auth/sign_in", c.withError(c.signInHandler(auther)))
+			r.Post("/auth/sign_up", c.withError(c.signUpHandler(auther)))
 			r.Get("/metrics", promhttp.Handler().ServeHTTP)
+			r.Get("/openapi.json", c.withError(c.openAPIHandler(openAPISpec())))
 		})
 
 	})
-
-	return http.ListenAndServe(serverAddr, sessionManager.LoadAndSave(r))
+	return r
 }
 
 type API struct {
-	log *zap.SugaredLogger
+	log   *zap.SugaredLogger
+	blobs *BlobStorage
+	// step is the time between the tracker's samples, for evaluating sessions again
+	step time.Duration
+}
+
+// Request and response bodies, named so the OpenAPI spec can describe them
+
+type credentialsRequest struct {
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Password string `json:"password" validate:"required,maxbytes=72"`
+}
+
+type setPasswordRequest struct {
+	Password string `json:"password" validate:"required,min=8,maxbytes=72"`
+}
+
+type addUsernameRequest struct {
+	Username string `json:"username" validate:"required,max=255"`
+	Password string `json:"password" validate:"required"`
+}
+
+type successResponse struct {
+	Success bool `json:"success"`
+}
+
+type userResponse struct {
+	Data *db.User `json:"data"`
+}
+
+type usersResponse struct {
+	Data db.UserSlice `json:"data"`
+}
+
+type jwtResponse struct {
+	Data string `json:"data"`
+}
+
+type tokenResponse struct {
+	Token string `json:"token"`
+}
+
+type integrationsResponse struct {
+	Data db.IntegrationSlice `json:"data"`
+}
+
+type friendsResponse struct {
+	Data db.FriendSlice `json:"data"`
+}
+
+type attendanceResponse struct {
+	Data db.AttendanceSlice `json:"data"`
+}
+
+type blobSignResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 // RunLoadBalancer starts Caddy
@@ -262,44 +331,110 @@ func RunLoadBalancer(ctx context.Context, conn *sqlx.DB, loadBalancerAddr, serve
 	return nil
 }
 
-func isIntegrationOwner(IntegrationID int, userID null.Int64) error {
-	integration, err := db.FindIntegrationG(null.Int64From(int64(IntegrationID)))
+// isIntegrationOwner returns a not found error for missing integrations and forbids access to other users' integrations
+func isIntegrationOwner(IntegrationID int64, userID int64) error {
+	integration, err := db.FindIntegrationG(IntegrationID)
+	if err == sql.ErrNoRows {
+		return errNotFound("integration")
+	}
 	if err != nil {
 		return err
 	}
-	if userID.Int64 != integration.UserID {
-		return errors.New("unauthorized")
+	if userID != integration.UserID {
+		return errForbidden(errors.New("not the owner of the integration"))
 	}
 	return nil
 }
 
+// deleteIntegration along with the friends, attendance, classes and webhooks recorded for it
+func deleteIntegration(integration *db.Integration) error {
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	_, err = db.ReprocessJobs(db.ReprocessJobWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.TrackerHeartbeats(db.TrackerHeartbeatWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.AuditLogs(db.AuditLogWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.ClassSessions(db.ClassSessionWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.ManualAttendances(db.ManualAttendanceWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Schedules(db.ScheduleWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Enrolments(
+		qm.Where(db.EnrolmentColumns.RosterID+" IN (SELECT id FROM rosters WHERE integration_id = ?)", integration.ID),
+	).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Rosters(db.RosterWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Attendances(db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integration.ID))).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.LocationSamples(db.LocationSampleWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Friends(db.FriendWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.WebhookDeliveries(
+		qm.Where(db.WebhookDeliveryColumns.WebhookID+" IN (SELECT id FROM webhooks WHERE integration_id = ?)", integration.ID),
+	).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = db.Webhooks(db.WebhookWhere.IntegrationID.EQ(integration.ID)).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = integration.Delete(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
 func (c *API) integrationUpdateFriendsHandler(d *Darer) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		IntegrationIDStr := chi.URLParam(r, "integration_id")
-		type Response struct {
-			Success bool `json:"success,omitempty"`
-		}
-		IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+		IntegrationID, err := urlParamID(r, "integration_id")
 		if err != nil {
-			return nil, http.StatusInternalServerError, err
+			return nil, http.StatusBadRequest, err
 		}
 		err = isIntegrationOwner(IntegrationID, u.ID)
 		if err != nil {
 			return nil, http.StatusForbidden, err
 		}
-		err = refreshFriendCache(d, IntegrationID, true)
+		err = RefreshFriends(d, c.blobs, IntegrationID, c.log)
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
-		return &Response{true}, http.StatusOK, nil
+		return &successResponse{true}, http.StatusOK, nil
 	}
 	return fn
 }
 func (c *API) checkHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		type Response struct {
-			Data *db.User `json:"data"`
-		}
 		cookie, err := r.Cookie("jwt")
 		if err != nil {
 			return nil, http.StatusUnauthorized, err
@@ -309,37 +444,26 @@ func (c *API) checkHandler(auther *Auther) func(w http.ResponseWriter, r *http.R
 			return nil, http.StatusUnauthorized, err
 		}
 		u.PasswordHash = ""
-		return &Response{u}, 200, nil
+		return &userResponse{u}, 200, nil
 	}
 	return fn
 
 }
 
 func (c *API) integrationsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	type Response struct {
-		Data db.IntegrationSlice `json:"data"`
-	}
-	result, err := db.Integrations(db.IntegrationWhere.UserID.EQ(u.ID.Int64)).AllG()
+	result, err := db.Integrations(db.IntegrationWhere.UserID.EQ(u.ID)).AllG()
 	if err != nil {
-		return nil, 500, err
+		return nil, http.StatusInternalServerError, err
 	}
 	if result == nil {
-		return &Response{}, 500, err
+		return &integrationsResponse{}, http.StatusOK, nil
 	}
-	return &Response{result}, 200, nil
+	return &integrationsResponse{result}, 200, nil
 }
 func (c *API) integrationsAddUsernameHandler(d *Darer) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		type Request struct {
-			Username string `json:"username"`
-			Password string `json:"password"`
-		}
-		type Response struct {
-			Data string `json:"data"`
-		}
-
-		req := &Request{}
-		err := json.NewDecoder(r.Body).Decode(req)
+		req := &addUsernameRequest{}
+		err := decodeJSON(w, r, req)
 		if err != nil {
 			return nil, http.StatusBadRequest, err
 		}
@@ -355,7 +479,7 @@ func (c *API) integrationsAddUsernameHandler(d *Darer) func(w http.ResponseWrite
 		}
 
 		record := &db.Integration{
-			UserID:         u.ID.Int64,
+			UserID:         u.ID,
 			Username:       req.Username,
 			APIKey:         apiKey,
 			AuthToken:      encryptedAuthToken,
@@ -372,7 +496,7 @@ func (c *API) integrationsAddUsernameHandler(d *Darer) func(w http.ResponseWrite
 			if err != nil {
 				return nil, http.StatusInternalServerError, err
 			}
-			existingRecord.UserID = u.ID.Int64
+			existingRecord.UserID = u.ID
 			existingRecord.APIKey = apiKey
 
 			encryptedAuthToken, nonce, err := d.encrypt([]byte(authToken))
@@ -394,7 +518,7 @@ func (c *API) integrationsAddUsernameHandler(d *Darer) func(w http.ResponseWrite
 		}
 		c.log.Infow("integration email does not exist, creating...", "email", req.Username)
 		err = record.InsertG(boil.Infer())
-		if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
+		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
 		return record, http.StatusOK, nil
@@ -402,23 +526,19 @@ func (c *API) integrationsAddUsernameHandler(d *Darer) func(w http.ResponseWrite
 	return fn
 }
 func (c *API) integrationsDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	type Response struct {
-		Data db.FriendSlice `json:"data"`
-	}
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	err = isIntegrationOwner(IntegrationID, u.ID)
 	if err != nil {
 		return nil, http.StatusForbidden, err
 	}
-	integration, err := db.FindIntegrationG(null.Int64From(int64(IntegrationID)))
+	integration, err := db.FindIntegrationG(int64(IntegrationID))
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
-	_, err = integration.DeleteG()
+	err = deleteIntegration(integration)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
@@ -426,34 +546,22 @@ func (c *API) integrationsDeleteHandler(w http.ResponseWriter, r *http.Request,
 }
 
 func (c *API) signOutHandler(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
-	type Response struct {
-		Success bool `json:"success"`
-	}
 	cookie := http.Cookie{Name: "jwt", Value: "", Expires: time.Unix(0, 0), HttpOnly: true, Path: "/", SameSite: http.SameSiteDefaultMode, Secure: false}
 	http.SetCookie(w, &cookie)
-	w.WriteHeader(http.StatusOK)
-	return &Response{true}, http.StatusOK, nil
+	return &successResponse{true}, http.StatusOK, nil
 }
 func (c *API) signInHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
-		type Request struct {
-			Email    string
-			Password string
-		}
-		type Response struct {
-			Success bool `json:"success"`
-		}
-		failedMessage := errors.New("Bad username or password")
-		req := &Request{}
-		err := json.NewDecoder(r.Body).Decode(req)
+		req := &credentialsRequest{}
+		err := decodeJSON(w, r, req)
 		if err != nil {
-			return nil, http.StatusInternalServerError, err
+			return nil, http.StatusBadRequest, err
 		}
 		defer r.Body.Close()
 
 		err = auther.ValidatePassword(req.Email, req.Password)
 		if err != nil {
-			return nil, http.StatusBadRequest, failedMessage
+			return nil, http.StatusUnauthorized, &AppError{http.StatusUnauthorized, CodeUnauthorized, "Bad username or password", err}
 		}
 
 		user, err := db.Users(db.UserWhere.Email.EQ(req.Email)).OneG()
@@ -462,28 +570,21 @@ func (c *API) signInHandler(auther *Auther) func(w http.ResponseWriter, r *http.
 		}
 
 		expiration := time.Now().Add(time.Duration(30) * time.Hour * 24)
-		jwt, err := auther.GenerateJWT(user.Email, strconv.Itoa(int(user.ID.Int64)), user.Role, expiration)
+		jwt, err := auther.GenerateJWT(user.Email, strconv.Itoa(int(user.ID)), user.Role, expiration)
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
 		cookie := http.Cookie{Name: "jwt", Value: jwt, Expires: expiration, HttpOnly: true, Path: "/", SameSite: http.SameSiteDefaultMode, Secure: false}
 		http.SetCookie(w, &cookie)
 
-		return &Response{true}, http.StatusOK, nil
+		return &successResponse{true}, http.StatusOK, nil
 	}
 	return fn
 }
 func (c *API) signUpHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
-		type Request struct {
-			Email    string
-			Password string
-		}
-		type Response struct {
-			Data string `json:"data"`
-		}
-		req := &Request{}
-		err := json.NewDecoder(r.Body).Decode(req)
+		req := &credentialsRequest{}
+		err := decodeJSON(w, r, req)
 		if err != nil {
 			return nil, http.StatusBadRequest, err
 		}
@@ -492,40 +593,135 @@ func (c *API) signUpHandler(auther *Auther) func(w http.ResponseWriter, r *http.
 			PasswordHash: HashPassword(req.Password),
 		}
 		err = u.InsertG(boil.Infer())
-		if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
+		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
 		return nil, 200, ErrNotImplemented
 	}
 	return fn
 }
-func (c *API) blobHandler() func(w http.ResponseWriter, r *http.Request) {
+
+// blobHandler serves blob contents to users who can see a friend using the blob, or to anyone holding a signed URL.
+// The size query parameter selects one of the resized renditions of an image.
+func (c *API) blobHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request) {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		blobFilename := chi.URLParam(r, "blob_id")
-		blob, err := db.Blobs(db.BlobWhere.FileName.EQ(blobFilename)).OneG()
+		blob, err := c.blobs.Find(blobFilename)
+		if err == sql.ErrNoRows {
+			writeError(w, r, c.log, errNotFound("blob"), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			writeError(w, r, c.log, err, http.StatusInternalServerError)
+			return
+		}
+
+		cacheControl := "private, max-age=31536000, immutable"
+		if signature := r.URL.Query().Get("signature"); signature != "" {
+			expires := r.URL.Query().Get("expires")
+			err = auther.VerifyBlobSignature(blob.FileName, expires, signature)
+			if err != nil {
+				writeError(w, r, c.log, &AppError{http.StatusForbidden, CodeForbidden, err.Error(), err}, http.StatusForbidden)
+				return
+			}
+			// shared caches may keep the content until the URL stops being valid
+			unix, _ := strconv.ParseInt(expires, 10, 64)
+			cacheControl = fmt.Sprintf("public, max-age=%d", int64(time.Until(time.Unix(unix, 0)).Seconds()))
+		} else {
+			u, err := userFromRequest(auther, r)
+			if err != nil {
+				writeError(w, r, c.log, err, http.StatusUnauthorized)
+				return
+			}
+			err = canReadBlob(blob.FileName, u)
+			if err != nil {
+				writeError(w, r, c.log, err, http.StatusForbidden)
+				return
+			}
+		}
+
+		if sizeStr := r.URL.Query().Get("size"); sizeStr != "" {
+			size, err := strconv.Atoi(sizeStr)
+			if err != nil {
+				writeError(w, r, c.log, errBadRequest(err, "size must be a number"), http.StatusBadRequest)
+				return
+			}
+			blob, err = c.blobs.FindRendition(blob, size)
+			if err != nil {
+				writeError(w, r, c.log, err, http.StatusInternalServerError)
+				return
+			}
+		}
+
+		// blobs are content addressed, so the file name is a strong validator and the content never changes
+		etag := fmt.Sprintf(`"%s"`, blob.FileName)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", cacheControl)
+		if blobNotModified(r, etag, blob.CreatedAt) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		rdr, err := c.blobs.Open(blob)
 		if err != nil {
-			http.Error(w, Err(err).JSON(), http.StatusBadRequest)
+			writeError(w, r, c.log, err, http.StatusInternalServerError)
 			return
 		}
+		defer rdr.Close()
+
+		err = countBlobView(blob.FileName)
+		if err != nil {
+			c.log.Errorw("count blob view", "file_name", blob.FileName, "err", err)
+		}
 
-		// tell the browser the returned content should be downloaded/inline
+		// images are shown in the browser, anything else is downloaded
+		disposition := "attachment"
+		if strings.HasPrefix(blob.MimeType, "image/") {
+			disposition = "inline"
+		}
 		if blob.MimeType != "" && blob.MimeType != "unknown" {
-			w.Header().Add("Content-Type", blob.MimeType)
+			w.Header().Set("Content-Type", blob.MimeType)
+		}
+		w.Header().Set("Content-Disposition", fmt.Sprintf("%s;filename=%s", disposition, blob.FileName))
+		if rs, ok := rdr.(io.ReadSeeker); ok {
+			http.ServeContent(w, r, blob.FileName, blob.CreatedAt, rs)
+			return
+		}
+		// the store can only stream, so range requests are not supported
+		w.Header().Set("Last-Modified", blob.CreatedAt.UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.FormatInt(blob.FileSizeBytes, 10))
+		_, err = io.Copy(w, rdr)
+		if err != nil {
+			c.log.Errorw("stream blob", "file_name", blob.FileName, "err", err)
 		}
-		w.Header().Add("Content-Disposition", fmt.Sprintf("%s;filename=%s", "attachment", blob.FileName))
-		rdr := bytes.NewReader(blob.File)
-		http.ServeContent(w, r, blob.FileName, time.Now(), rdr)
 		return
 	}
 	return fn
 }
-func (c *API) setPasswordHandler() func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+
+// blobSignHandler returns a URL for the blob that can be embedded without authentication until it expires
+func (c *API) blobSignHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		type Request struct {
-			Password string `json:"password"`
+		blobFilename := chi.URLParam(r, "blob_id")
+		err := canReadBlob(blobFilename, u)
+		if err != nil {
+			return nil, http.StatusForbidden, err
 		}
-		req := &Request{}
-		err := json.NewDecoder(r.Body).Decode(req)
+		expires := time.Now().Add(blobSignedURLLifetime).Truncate(time.Second)
+		q := url.Values{}
+		q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+		q.Set("signature", auther.SignBlob(blobFilename, expires))
+		return &blobSignResponse{
+			URL:       fmt.Sprintf("/api/blobs/%s?%s", blobFilename, q.Encode()),
+			ExpiresAt: expires.UTC(),
+		}, http.StatusOK, nil
+	}
+	return fn
+}
+func (c *API) setPasswordHandler() func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+		req := &setPasswordRequest{}
+		err := decodeJSON(w, r, req)
 		if err != nil {
 			return nil, http.StatusBadRequest, err
 		}
@@ -540,42 +736,33 @@ func (c *API) setPasswordHandler() func(w http.ResponseWriter, r *http.Request,
 }
 
 func (c *API) attendanceListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	TeacherIDStr := chi.URLParam(r, "teacher_id")
-	type Response struct {
-		Data db.AttendanceSlice `json:"data"`
-	}
-
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	err = isIntegrationOwner(IntegrationID, u.ID)
 	if err != nil {
 		return nil, http.StatusForbidden, err
 	}
-	TeacherID, err := strconv.Atoi(TeacherIDStr)
+	TeacherID, err := urlParamID(r, "teacher_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	result, err := db.Attendances(
 		db.AttendanceWhere.IntegrationID.EQ(null.Int64From(int64(IntegrationID))),
 		db.AttendanceWhere.TeacherID.EQ(null.Int64From(int64(TeacherID))),
+		enrolledAttendance(),
 	).AllG()
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 
-	return &Response{result}, 200, nil
+	return &attendanceResponse{result}, 200, nil
 }
 func (c *API) friendListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	type Response struct {
-		Data db.FriendSlice `json:"data"`
-	}
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 
 	err = isIntegrationOwner(IntegrationID, u.ID)
@@ -586,108 +773,81 @@ func (c *API) friendListHandler(w http.ResponseWriter, r *http.Request, u *db.Us
 	// if err != nil {
 	// 	return nil, http.StatusInternalServerError, err
 	// }
-	result, err := db.Friends(db.FriendWhere.IntegrationID.EQ(int64(IntegrationID))).AllG()
+	result, err := db.Friends(
+		db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
+		db.FriendWhere.Archived.EQ(false),
+	).AllG()
 	if err != nil {
-		return nil, 500, err
+		return nil, http.StatusInternalServerError, err
 	}
 	if result == nil {
-		return &Response{}, 500, err
+		return &friendsResponse{}, http.StatusOK, nil
 	}
-	return &Response{result}, 200, nil
+	return &friendsResponse{result}, 200, nil
 }
 func (c *API) friendRefreshHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	type Response struct {
-		Data db.FriendSlice `json:"data"`
-	}
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	err = isIntegrationOwner(IntegrationID, u.ID)
 	if err != nil {
 		return nil, http.StatusForbidden, err
 	}
 	// TODO: Manually refresh friend locations
-	result, err := db.Friends().AllG()
+	result, err := db.Friends(
+		db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
+		db.FriendWhere.Archived.EQ(false),
+	).AllG()
 	if err != nil {
-		return nil, 500, err
+		return nil, http.StatusInternalServerError, err
 	}
 	if result == nil {
-		return &Response{}, 500, err
+		return &friendsResponse{}, http.StatusOK, nil
 	}
-	return &Response{result}, 200, nil
+	return &friendsResponse{result}, 200, nil
 }
 func (c *API) friendPromoteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	type Response struct {
-		Data *db.Friend `json:"data"`
-	}
-
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	err = isIntegrationOwner(IntegrationID, u.ID)
 	if err != nil {
 		return nil, http.StatusForbidden, err
 	}
-	FriendID := chi.URLParam(r, "friend_id")
-	friend, err := db.Friends(
-		db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
-		db.FriendWhere.VrchatID.EQ(FriendID),
-	).OneG()
-	if err != nil {
-		return nil, http.StatusInternalServerError, err
-	}
-	friend.IsTeacher = true
-	_, err = friend.UpdateG(boil.Whitelist(db.FriendColumns.IsTeacher))
+	VrchatID := chi.URLParam(r, "vrchat_id")
+	friend, err := SetTeacher(int64(IntegrationID), VrchatID, true)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 	return friend, 200, nil
 }
 func (c *API) friendDemoteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-	type Response struct {
-		Data *db.Friend `json:"data"`
-	}
-
-	IntegrationIDStr := chi.URLParam(r, "integration_id")
-	IntegrationID, err := strconv.Atoi(IntegrationIDStr)
+	IntegrationID, err := urlParamID(r, "integration_id")
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, http.StatusBadRequest, err
 	}
 	err = isIntegrationOwner(IntegrationID, u.ID)
 	if err != nil {
 		return nil, http.StatusForbidden, err
 	}
-	FriendID := chi.URLParam(r, "friend_id")
-	friend, err := db.Friends(
-		db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
-		db.FriendWhere.VrchatID.EQ(FriendID),
-	).OneG()
-	if err != nil {
-		return nil, http.StatusInternalServerError, err
-	}
-	friend.IsTeacher = false
-	_, err = friend.UpdateG(boil.Whitelist(db.FriendColumns.IsTeacher))
+	VrchatID := chi.URLParam(r, "vrchat_id")
+	friend, err := SetTeacher(int64(IntegrationID), VrchatID, false)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 	return friend, 200, nil
 }
 func (c *API) teacherListHandler(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
-	type Response struct {
-		Data db.FriendSlice `json:"data"`
-	}
 	result, err := db.Friends(db.FriendWhere.IsTeacher.EQ(true)).AllG()
 	if err != nil {
-		return nil, 500, err
+		return nil, http.StatusInternalServerError, err
 	}
 	if result == nil {
-		return &Response{}, 500, err
+		return &friendsResponse{}, http.StatusOK, nil
 	}
-	return &Response{result}, 200, nil
+	return &friendsResponse{result}, 200, nil
 }
 
 func (c *API) metricsHandler(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
@@ -696,75 +856,62 @@ func (c *API) metricsHandler(w http.ResponseWriter, r *http.Request) (interface{
 
 func (c *API) userJWTHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		type Response struct {
-			Data string `json:"data"`
-		}
 		expiration := time.Now().Add(time.Duration(30) * time.Hour * 24)
-		jwt, err := auther.GenerateJWT(u.Email, strconv.Itoa(int(u.ID.Int64)), u.Role, expiration)
+		jwt, err := auther.GenerateJWT(u.Email, strconv.Itoa(int(u.ID)), u.Role, expiration)
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
-		return &Response{jwt}, http.StatusOK, nil
+		return &jwtResponse{jwt}, http.StatusOK, nil
 	}
 	return fn
 }
 func (c *API) userListHandler() func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 		if u.Role != roleAdmin {
-			return nil, http.StatusForbidden, errors.New("unauthorized")
-		}
-		type Response struct {
-			Data db.UserSlice `json:"data"`
+			return nil, http.StatusForbidden, errForbidden(errors.New("admin only"))
 		}
 		users, err := db.Users().AllG()
 		if err != nil {
-			return nil, http.StatusBadRequest, err
+			return nil, http.StatusInternalServerError, err
 		}
-		return &Response{users}, 200, nil
+		return &usersResponse{users}, 200, nil
 	}
 	return fn
 }
 func (c *API) apiKeyHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
-		type Response struct {
-			Token string `json:"token"`
-		}
 		expiration := time.Now().Add(time.Duration(30) * time.Hour * 24)
-		id := strconv.Itoa(int(u.ID.Int64))
+		id := strconv.Itoa(int(u.ID))
 		token, err := auther.GenerateJWT(u.Email, id, u.Role, expiration)
 		if err != nil {
-			return nil, http.StatusBadRequest, err
+			return nil, http.StatusInternalServerError, err
 		}
-		return &Response{token}, http.StatusOK, nil
+		return &tokenResponse{token}, http.StatusOK, nil
 	}
 	return fn
 }
 func (c *API) userImpersonateHandler(auther *Auther) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
 		if u.Role != roleAdmin {
-			return nil, http.StatusForbidden, errors.New("unauthorized")
+			return nil, http.StatusForbidden, errForbidden(errors.New("admin only"))
 		}
-		type Response struct {
-			Token string `json:"token"`
-		}
-		targetUserIDStr := chi.URLParam(r, "user_id")
-		targetUserID, err := strconv.Atoi(targetUserIDStr)
+		targetUserID, err := urlParamID(r, "user_id")
 		if err != nil {
-			return nil, http.StatusInternalServerError, err
+			return nil, http.StatusBadRequest, err
 		}
-		targetUser, err := db.FindUserG(null.Int64From(int64(targetUserID)))
+		targetUser, err := db.FindUserG(int64(targetUserID))
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
 		expiration := time.Now().Add(time.Duration(30) * time.Hour * 24)
-		jwt, err := auther.GenerateJWT(targetUser.Email, strconv.Itoa(int(targetUser.ID.Int64)), targetUser.Role, expiration)
+		jwt, err := auther.GenerateJWT(targetUser.Email, strconv.Itoa(int(targetUser.ID)), targetUser.Role, expiration)
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
 		cookie := http.Cookie{Name: "jwt", Value: jwt, Expires: expiration, HttpOnly: true, Path: "/", SameSite: http.SameSiteDefaultMode, Secure: false}
 		http.SetCookie(w, &cookie)
 
-		return &Response{jwt}, http.StatusOK, nil
+		return &tokenResponse{jwt}, http.StatusOK, nil
 	}
 	return fn
 }
@@ -0,0 +1,1928 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// apiVersion is the version of the stable API under /api/v1
+const apiVersion = 1
+
+const (
+	v1AttendanceLimit    = 1000
+	v1AttendanceMaxLimit = 10000
+)
+
+var versionedMediaType = regexp.MustCompile(`^application/vnd\.accumulator\.v(\d+)\+json$`)
+
+// The shapes of /api/v1 are kept stable for external integrations and don't follow the database schema
+
+// v1Integration is an integration without its VRChat credentials
+type v1Integration struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// ScheduledOnly integrations only record attendance during the classes of their schedules
+	ScheduledOnly bool `json:"scheduled_only"`
+	// The attendance policy of schedules without their own, see v1Schedule
+	MinPresencePercent int64     `json:"min_presence_percent"`
+	LateAfterMinutes   int64     `json:"late_after_minutes"`
+	LeftEarlyMinutes   int64     `json:"left_early_minutes"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type v1Friend struct {
+	ID            int64  `json:"id"`
+	IntegrationID int64  `json:"integration_id"`
+	VrchatID      string `json:"vrchat_id"`
+	Username      string `json:"username"`
+	DisplayName   string `json:"display_name"`
+	IsTeacher     bool   `json:"is_teacher"`
+	Location      string `json:"location"`
+	AvatarURL     string `json:"avatar_url,omitempty"`
+}
+
+type v1Attendance struct {
+	Time       time.Time `json:"time"`
+	FriendID   int64     `json:"friend_id"`
+	TeacherID  int64     `json:"teacher_id"`
+	Location   string    `json:"location"`
+	WorldID    string    `json:"world_id"`
+	InstanceID string    `json:"instance_id"`
+	Region     string    `json:"region"`
+	AccessType string    `json:"access_type"`
+	OwnerID    string    `json:"owner_id"`
+}
+
+type v1IntegrationsResponse struct {
+	Data []*v1Integration `json:"data"`
+}
+
+type v1IntegrationResponse struct {
+	Data *v1Integration `json:"data"`
+}
+
+type v1FriendsResponse struct {
+	Data []*v1Friend `json:"data"`
+}
+
+type v1FriendResponse struct {
+	Data *v1Friend `json:"data"`
+}
+
+// v1AttendanceResponse is a page of attendance, HasMore is set when the next page starts at offset + limit
+type v1AttendanceResponse struct {
+	Data    []*v1Attendance `json:"data"`
+	HasMore bool            `json:"has_more"`
+}
+
+// v1IntegrationUpdateRequest changes the fields that are given
+type v1IntegrationUpdateRequest struct {
+	ScheduledOnly      *bool  `json:"scheduled_only,omitempty"`
+	MinPresencePercent *int64 `json:"min_presence_percent,omitempty" validate:"min=0,max=100"`
+	LateAfterMinutes   *int64 `json:"late_after_minutes,omitempty" validate:"min=0,max=1440"`
+	LeftEarlyMinutes   *int64 `json:"left_early_minutes,omitempty" validate:"min=0,max=1440"`
+}
+
+type v1FriendUpdateRequest struct {
+	IsTeacher *bool `json:"is_teacher" validate:"required"`
+}
+
+func toV1Integration(i *db.Integration) *v1Integration {
+	return &v1Integration{i.ID, i.Username, i.ScheduledOnly, i.MinPresencePercent, i.LateAfterMinutes, i.LeftEarlyMinutes, i.CreatedAt}
+}
+
+func toV1Friend(f *db.Friend) *v1Friend {
+	result := &v1Friend{
+		ID:            f.ID,
+		IntegrationID: f.IntegrationID,
+		VrchatID:      f.VrchatID,
+		Username:      f.VrchatUsername,
+		DisplayName:   f.VrchatDisplayName,
+		IsTeacher:     f.IsTeacher,
+		Location:      f.VrchatLocation,
+	}
+	if f.AvatarBlobFilename.Valid {
+		result.AvatarURL = "/api/blobs/" + f.AvatarBlobFilename.String
+	}
+	return result
+}
+
+func toV1Attendance(a *db.Attendance) *v1Attendance {
+	return &v1Attendance{
+		Time:       time.Unix(a.Timestamp, 0).UTC(),
+		FriendID:   a.FriendID.Int64,
+		TeacherID:  a.TeacherID.Int64,
+		Location:   a.Location,
+		WorldID:    a.WorldID,
+		InstanceID: a.InstanceID,
+		Region:     a.Region,
+		AccessType: a.AccessType,
+		OwnerID:    a.OwnerID,
+	}
+}
+
+// negotiateVersion refuses requests asking for another version with Accept: application/vnd.accumulator.vN+json,
+// and tells clients the version they got in the API-Version header
+func (c *API) negotiateVersion(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		requested := []string{}
+		for _, mediaRange := range strings.Split(r.Header.Get("Accept"), ",") {
+			mediaType := strings.TrimSpace(strings.Split(mediaRange, ";")[0])
+			if match := versionedMediaType.FindStringSubmatch(mediaType); match != nil {
+				requested = append(requested, match[1])
+			}
+		}
+		supported := strconv.Itoa(apiVersion)
+		ok := len(requested) == 0
+		for _, v := range requested {
+			ok = ok || v == supported
+		}
+		if !ok {
+			err := &AppError{http.StatusNotAcceptable, CodeUnsupportedVersion, fmt.Sprintf("API version %s is not supported, this server supports version %s", strings.Join(requested, ", "), supported), nil}
+			writeError(w, r, c.log, err, err.Status)
+			return
+		}
+		w.Header().Set("API-Version", supported)
+		next.ServeHTTP(w, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+// deprecations points external integrations calling UI routes that have a replacement in /api/v1 to it,
+// with the Deprecation and Link headers. The UI authenticates with the cookie and doesn't get them.
+func deprecations(next http.Handler) http.Handler {
+	successors := map[string]string{}
+	for _, route := range apiRoutes {
+		if route.Successor != "" {
+			successors[route.Method+" "+route.Pattern] = route.Successor
+		}
+	}
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		successor, ok := successors[r.Method+" "+chi.RouteContext(r.Context()).RoutePattern()]
+		if ok && r.Header.Get("Authorization") != "" {
+			link := pathParamPattern.ReplaceAllStringFunc(successor, func(param string) string {
+				return chi.URLParam(r, strings.Trim(param, "{}"))
+			})
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, link))
+		}
+		next.ServeHTTP(w, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+// ownedIntegration is the integration in the URL, if the user owns it. Archived integrations are not found, like in the list.
+func ownedIntegration(r *http.Request, u *db.User) (*db.Integration, error) {
+	integrationID, err := urlParamID(r, "integration_id")
+	if err != nil {
+		return nil, err
+	}
+	integration, err := db.Integrations(
+		db.IntegrationWhere.ID.EQ(integrationID),
+		db.IntegrationWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("integration")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if integration.UserID != u.ID {
+		return nil, errForbidden(fmt.Errorf("integration %d belongs to user %d", integration.ID, integration.UserID))
+	}
+	return integration, nil
+}
+
+func (c *API) v1IntegrationsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integrations, err := db.Integrations(
+		db.IntegrationWhere.UserID.EQ(u.ID),
+		db.IntegrationWhere.Archived.EQ(false),
+		qm.OrderBy(db.IntegrationColumns.ID),
+	).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1IntegrationsResponse{[]*v1Integration{}}
+	for _, integration := range integrations {
+		result.Data = append(result.Data, toV1Integration(integration))
+	}
+	return result, http.StatusOK, nil
+}
+
+func (c *API) v1IntegrationHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	return &v1IntegrationResponse{toV1Integration(integration)}, http.StatusOK, nil
+}
+
+// v1IntegrationUpdateHandler turns recording only during scheduled classes on or off, and sets the attendance policy.
+// A new policy applies to classes evaluated from then on.
+func (c *API) v1IntegrationUpdateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1IntegrationUpdateRequest{}
+	err = decodeJSON(w, r, req)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	columns := []string{db.IntegrationColumns.UpdatedAt}
+	if req.ScheduledOnly != nil {
+		integration.ScheduledOnly = *req.ScheduledOnly
+		columns = append(columns, db.IntegrationColumns.ScheduledOnly)
+	}
+	if req.MinPresencePercent != nil {
+		integration.MinPresencePercent = *req.MinPresencePercent
+		columns = append(columns, db.IntegrationColumns.MinPresencePercent)
+	}
+	if req.LateAfterMinutes != nil {
+		integration.LateAfterMinutes = *req.LateAfterMinutes
+		columns = append(columns, db.IntegrationColumns.LateAfterMinutes)
+	}
+	if req.LeftEarlyMinutes != nil {
+		integration.LeftEarlyMinutes = *req.LeftEarlyMinutes
+		columns = append(columns, db.IntegrationColumns.LeftEarlyMinutes)
+	}
+	_, err = integration.UpdateG(boil.Whitelist(columns...))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1IntegrationResponse{toV1Integration(integration)}, http.StatusOK, nil
+}
+
+func (c *API) v1FriendsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	mods := []qm.QueryMod{
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.Archived.EQ(false),
+		qm.OrderBy(db.FriendColumns.ID),
+	}
+	if teachers := r.URL.Query().Get("is_teacher"); teachers != "" {
+		isTeacher, err := strconv.ParseBool(teachers)
+		if err != nil {
+			return nil, http.StatusBadRequest, &ValidationError{[]FieldError{{"is_teacher", "must be true or false"}}}
+		}
+		mods = append(mods, db.FriendWhere.IsTeacher.EQ(isTeacher))
+	}
+	if vrchatID := r.URL.Query().Get("vrchat_id"); vrchatID != "" {
+		mods = append(mods, db.FriendWhere.VrchatID.EQ(vrchatID))
+	}
+	friends, err := db.Friends(mods...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1FriendsResponse{[]*v1Friend{}}
+	for _, friend := range friends {
+		result.Data = append(result.Data, toV1Friend(friend))
+	}
+	return result, http.StatusOK, nil
+}
+
+// ownedFriend is the friend of the integration with the ID in the URL. Like everywhere else in v1, friend_id is the
+// friend's ID, not their VRChat ID.
+func ownedFriend(r *http.Request, integration *db.Integration) (*db.Friend, error) {
+	friendID, err := urlParamID(r, "friend_id")
+	if err != nil {
+		return nil, err
+	}
+	friend, err := db.Friends(
+		db.FriendWhere.ID.EQ(friendID),
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("friend")
+	}
+	return friend, err
+}
+
+func (c *API) v1FriendHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	friend, err := ownedFriend(r, integration)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	return &v1FriendResponse{toV1Friend(friend)}, http.StatusOK, nil
+}
+
+func (c *API) v1FriendUpdateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1FriendUpdateRequest{}
+	err = decodeJSON(w, r, req)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	friend, err := ownedFriend(r, integration)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	friend, err = SetTeacher(integration.ID, friend.VrchatID, *req.IsTeacher)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1FriendResponse{toV1Friend(friend)}, http.StatusOK, nil
+}
+
+// v1AttendanceListHandler pages through the attendance of an integration in time order,
+// optionally of one teacher's classes, one roster or one friend, between from (inclusive) and to (exclusive).
+// Only students enrolled in a roster of the teacher are listed.
+func (c *API) v1AttendanceListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	invalid := []FieldError{}
+	mods := []qm.QueryMod{db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integration.ID)), enrolledAttendance()}
+	for _, param := range []struct {
+		name   string
+		column string
+	}{{"teacher_id", db.AttendanceColumns.TeacherID}, {"friend_id", db.AttendanceColumns.FriendID}} {
+		if s := q.Get(param.name); s != "" {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || id < 1 {
+				invalid = append(invalid, FieldError{param.name, "must be a positive integer"})
+				continue
+			}
+			mods = append(mods, qm.Where(param.column+" = ?", id))
+		}
+	}
+	if s := q.Get("roster_id"); s != "" {
+		id, _ := strconv.ParseInt(s, 10, 64)
+		roster, err := db.Rosters(
+			db.RosterWhere.ID.EQ(id),
+			db.RosterWhere.IntegrationID.EQ(integration.ID),
+		).OneG()
+		if err != nil && err != sql.ErrNoRows {
+			return nil, http.StatusInternalServerError, err
+		}
+		if roster == nil {
+			invalid = append(invalid, FieldError{"roster_id", "must be a roster of the integration"})
+		} else {
+			mods = append(mods, rosterAttendance(roster))
+		}
+	}
+	if s := q.Get("from"); s != "" {
+		from, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"from", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.AttendanceWhere.Timestamp.GTE(from.Unix()))
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		to, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"to", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.AttendanceWhere.Timestamp.LT(to.Unix()))
+		}
+	}
+	limit, offset := v1AttendanceLimit, 0
+	if s := q.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit < 1 || limit > v1AttendanceMaxLimit {
+			invalid = append(invalid, FieldError{"limit", fmt.Sprintf("must be between 1 and %d", v1AttendanceMaxLimit)})
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		offset, err = strconv.Atoi(s)
+		if err != nil || offset < 0 {
+			invalid = append(invalid, FieldError{"offset", "must be a positive integer"})
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+
+	// one more than the limit tells whether there is another page
+	mods = append(mods,
+		qm.OrderBy(db.AttendanceColumns.Timestamp+", "+db.AttendanceColumns.FriendID+", "+db.AttendanceColumns.TeacherID),
+		qm.Limit(limit+1),
+		qm.Offset(offset),
+	)
+	records, err := db.Attendances(mods...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1AttendanceResponse{Data: []*v1Attendance{}}
+	if len(records) > limit {
+		records, result.HasMore = records[:limit], true
+	}
+	for _, record := range records {
+		result.Data = append(result.Data, toV1Attendance(record))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1Webhook is a webhook subscription. The secret signing its deliveries is only returned when it is created.
+type v1Webhook struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type v1WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	EventID        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int64           `json:"attempts"`
+	NextAttemptAt  null.Time       `json:"next_attempt_at"`
+	LastAttemptAt  null.Time       `json:"last_attempt_at"`
+	ResponseStatus null.Int64      `json:"response_status"`
+	LastError      null.String     `json:"last_error"`
+	DeliveredAt    null.Time       `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+type v1WebhookCreateRequest struct {
+	URL    string   `json:"url" validate:"required,url,max=2048"`
+	Events []string `json:"events" validate:"required,oneof=student.joined student.left class.started class.ended integration.auth_expired"`
+}
+
+type v1WebhooksResponse struct {
+	Data []*v1Webhook `json:"data"`
+}
+
+type v1WebhookResponse struct {
+	Data *v1Webhook `json:"data"`
+}
+
+type v1WebhookDeliveriesResponse struct {
+	Data []*v1WebhookDelivery `json:"data"`
+}
+
+type v1WebhookDeliveryResponse struct {
+	Data *v1WebhookDelivery `json:"data"`
+}
+
+func toV1Webhook(w *db.Webhook) *v1Webhook {
+	return &v1Webhook{ID: w.ID, URL: w.URL, Events: webhookEvents(w), CreatedAt: w.CreatedAt}
+}
+
+func toV1WebhookDelivery(d *db.WebhookDelivery) *v1WebhookDelivery {
+	result := &v1WebhookDelivery{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		Event:          d.Event,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		Payload:        json.RawMessage(d.Payload),
+	}
+	if d.Status == deliveryPending {
+		result.NextAttemptAt = null.TimeFrom(d.NextAttemptAt)
+	}
+	return result
+}
+
+// ownedWebhook is the webhook in the URL, if it belongs to the integration in the URL and the user owns that
+func ownedWebhook(r *http.Request, u *db.User) (*db.Webhook, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	webhookID, err := urlParamID(r, "webhook_id")
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := db.Webhooks(
+		db.WebhookWhere.ID.EQ(webhookID),
+		db.WebhookWhere.IntegrationID.EQ(integration.ID),
+		db.WebhookWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("webhook")
+	}
+	return webhook, err
+}
+
+func (c *API) v1WebhooksListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	webhooks, err := db.Webhooks(
+		db.WebhookWhere.IntegrationID.EQ(integration.ID),
+		db.WebhookWhere.Archived.EQ(false),
+		qm.OrderBy(db.WebhookColumns.ID),
+	).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1WebhooksResponse{[]*v1Webhook{}}
+	for _, webhook := range webhooks {
+		result.Data = append(result.Data, toV1Webhook(webhook))
+	}
+	return result, http.StatusOK, nil
+}
+
+func (c *API) v1WebhookCreateHandler(d *Darer) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+		integration, err := ownedIntegration(r, u)
+		if err != nil {
+			return nil, http.StatusForbidden, err
+		}
+		req := &v1WebhookCreateRequest{}
+		err = decodeJSON(w, r, req)
+		if err == nil {
+			err = checkWebhookURL(r.Context(), req.URL)
+		}
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		webhook, secret, err := CreateWebhook(d, integration.ID, req.URL, req.Events)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		result := toV1Webhook(webhook)
+		result.Secret = secret
+		return &v1WebhookResponse{result}, http.StatusCreated, nil
+	}
+	return fn
+}
+
+func (c *API) v1WebhookDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	webhook, err := ownedWebhook(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	err = DeleteWebhook(webhook)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &successResponse{true}, http.StatusOK, nil
+}
+
+// v1WebhookDeliveriesHandler lists the newest deliveries of a webhook, optionally only those with a status
+func (c *API) v1WebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	webhook, err := ownedWebhook(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	mods := []qm.QueryMod{
+		db.WebhookDeliveryWhere.WebhookID.EQ(webhook.ID),
+		qm.OrderBy(db.WebhookDeliveryColumns.ID + " DESC"),
+	}
+	invalid := []FieldError{}
+	if status := r.URL.Query().Get("status"); status != "" {
+		msg := checkRules(reflect.ValueOf(status), []string{"oneof=" + strings.Join([]string{deliveryPending, deliveryDelivered, deliveryFailed}, " ")})
+		if msg != "" {
+			invalid = append(invalid, FieldError{"status", msg})
+		}
+		mods = append(mods, db.WebhookDeliveryWhere.Status.EQ(status))
+	}
+	limit := 50
+	if s := r.URL.Query().Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit < 1 || limit > 500 {
+			invalid = append(invalid, FieldError{"limit", "must be between 1 and 500"})
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+	deliveries, err := db.WebhookDeliveries(append(mods, qm.Limit(limit))...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1WebhookDeliveriesResponse{[]*v1WebhookDelivery{}}
+	for _, delivery := range deliveries {
+		result.Data = append(result.Data, toV1WebhookDelivery(delivery))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1WebhookReplayHandler sends a delivery again, as a new delivery with the same event
+func (c *API) v1WebhookReplayHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	webhook, err := ownedWebhook(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	deliveryID, err := urlParamID(r, "delivery_id")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	delivery, err := db.WebhookDeliveries(
+		db.WebhookDeliveryWhere.ID.EQ(deliveryID),
+		db.WebhookDeliveryWhere.WebhookID.EQ(webhook.ID),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, http.StatusNotFound, errNotFound("delivery")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if delivery.Status == deliveryPending {
+		return nil, http.StatusConflict, errConflict("delivery is still pending", nil)
+	}
+	replay, err := ReplayDelivery(delivery)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1WebhookDeliveryResponse{toV1WebhookDelivery(replay)}, http.StatusCreated, nil
+}
+
+// v1Schedule is a recurring class. Start and end times are HH:MM in the time zone, a class ending before it starts ends the next day.
+type v1Schedule struct {
+	ID        int64    `json:"id"`
+	TeacherID int64    `json:"teacher_id"`
+	Name      string   `json:"name"`
+	Weekdays  []string `json:"weekdays"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	TimeZone  string   `json:"time_zone"`
+	// WorldID limits the class to instances of the world, any world when null
+	WorldID null.String `json:"world_id"`
+	// RosterID is the students expected at the classes, all of the teacher's enrolled students when null
+	RosterID null.Int64 `json:"roster_id"`
+	// MinPresencePercent of a class a student must be there for not to be absent
+	MinPresencePercent null.Int64 `json:"min_presence_percent"`
+	// LateAfterMinutes after the start a student first seen then is late
+	LateAfterMinutes null.Int64 `json:"late_after_minutes"`
+	// LeftEarlyMinutes before the end a student gone by then left early. The policy is the integration's where these are null.
+	LeftEarlyMinutes null.Int64 `json:"left_early_minutes"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// v1ScheduleRequest creates or replaces a schedule. The time zone is UTC when empty.
+type v1ScheduleRequest struct {
+	TeacherID int64       `json:"teacher_id" validate:"required"`
+	Name      string      `json:"name" validate:"required,max=255"`
+	Weekdays  []string    `json:"weekdays" validate:"required,oneof=mon tue wed thu fri sat sun"`
+	StartTime string      `json:"start_time" validate:"required,timeofday"`
+	EndTime   string      `json:"end_time" validate:"required,timeofday"`
+	TimeZone  string      `json:"time_zone" validate:"timezone"`
+	WorldID   null.String `json:"world_id"`
+	RosterID  null.Int64  `json:"roster_id"`
+	// The attendance policy of the schedule, the integration's where these are left out
+	MinPresencePercent *int64 `json:"min_presence_percent,omitempty" validate:"min=0,max=100"`
+	LateAfterMinutes   *int64 `json:"late_after_minutes,omitempty" validate:"min=0,max=1440"`
+	LeftEarlyMinutes   *int64 `json:"left_early_minutes,omitempty" validate:"min=0,max=1440"`
+}
+
+// v1Occurrence is one class of a schedule, with the students who attended it and those who didn't.
+// Evaluated classes have had the attendance policy applied, students there for too short a time are absent.
+// Excused students have had their session overridden. GapMinutes of the class the tracker missed, students who weren't
+// present for all of it are incomplete when it missed any.
+type v1Occurrence struct {
+	ScheduleID int64                   `json:"schedule_id"`
+	Start      time.Time               `json:"start"`
+	End        time.Time               `json:"end"`
+	Evaluated  bool                    `json:"evaluated"`
+	GapMinutes int64                   `json:"gap_minutes"`
+	Present    []*v1OccurrenceAttendee `json:"present"`
+	Absent     []*v1Friend             `json:"absent"`
+	Incomplete []*v1Friend             `json:"incomplete"`
+	Excused    []*v1Friend             `json:"excused"`
+}
+
+// v1OccurrenceAttendee is a student at the class, seen by the tracker Samples times between FirstSeen and LastSeen,
+// which are null when only manual attendance has them there. Status is present, late or left_early, null until the class
+// is evaluated, and Override is set when a user chose the status.
+type v1OccurrenceAttendee struct {
+	Student       *v1Friend          `json:"student"`
+	SessionID     null.Int64         `json:"session_id"`
+	FirstSeen     null.Time          `json:"first_seen"`
+	LastSeen      null.Time          `json:"last_seen"`
+	Samples       int                `json:"samples"`
+	ManualMinutes int64              `json:"manual_minutes"`
+	Status        null.String        `json:"status"`
+	Override      *v1SessionOverride `json:"override"`
+}
+
+type v1SchedulesResponse struct {
+	Data []*v1Schedule `json:"data"`
+}
+
+type v1ScheduleResponse struct {
+	Data *v1Schedule `json:"data"`
+}
+
+type v1OccurrencesResponse struct {
+	Data []*v1Occurrence `json:"data"`
+}
+
+func toV1Schedule(s *db.Schedule) *v1Schedule {
+	return &v1Schedule{
+		ID:        s.ID,
+		TeacherID: s.TeacherID,
+		Name:      s.Name,
+		Weekdays:  strings.Split(s.Weekdays, ","),
+		StartTime: s.StartTime,
+		EndTime:   s.EndTime,
+		TimeZone:  s.TimeZone,
+		WorldID:   s.WorldID,
+		RosterID:  s.RosterID,
+
+		MinPresencePercent: s.MinPresencePercent,
+		LateAfterMinutes:   s.LateAfterMinutes,
+		LeftEarlyMinutes:   s.LeftEarlyMinutes,
+		CreatedAt:          s.CreatedAt,
+	}
+}
+
+// ownedSchedule is the schedule in the URL, if it belongs to the integration in the URL and the user owns that
+func ownedSchedule(r *http.Request, u *db.User) (*db.Schedule, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	scheduleID, err := urlParamID(r, "schedule_id")
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := db.Schedules(
+		db.ScheduleWhere.ID.EQ(scheduleID),
+		db.ScheduleWhere.IntegrationID.EQ(integration.ID),
+		db.ScheduleWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("schedule")
+	}
+	return schedule, err
+}
+
+func (c *API) v1SchedulesListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	schedules, err := db.Schedules(
+		db.ScheduleWhere.IntegrationID.EQ(integration.ID),
+		db.ScheduleWhere.Archived.EQ(false),
+		qm.OrderBy(db.ScheduleColumns.ID),
+	).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1SchedulesResponse{[]*v1Schedule{}}
+	for _, schedule := range schedules {
+		result.Data = append(result.Data, toV1Schedule(schedule))
+	}
+	return result, http.StatusOK, nil
+}
+
+func (c *API) v1ScheduleCreateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1ScheduleRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkSchedule(integration.ID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	schedule := &db.Schedule{IntegrationID: integration.ID}
+	setSchedule(schedule, req)
+	err = schedule.InsertG(boil.Infer())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1ScheduleResponse{toV1Schedule(schedule)}, http.StatusCreated, nil
+}
+
+// v1ScheduleUpdateHandler replaces a schedule. Occurrences are computed when asked for, so past classes change with it.
+func (c *API) v1ScheduleUpdateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	schedule, err := ownedSchedule(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1ScheduleRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkSchedule(schedule.IntegrationID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	setSchedule(schedule, req)
+	_, err = schedule.UpdateG(boil.Infer())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1ScheduleResponse{toV1Schedule(schedule)}, http.StatusOK, nil
+}
+
+func (c *API) v1ScheduleDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	schedule, err := ownedSchedule(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	err = ArchiveSchedule(schedule)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &successResponse{true}, http.StatusOK, nil
+}
+
+// v1OccurrencesHandler reports the classes of a schedule between from and to, the last four weeks by default.
+// Classes that haven't started yet are left out.
+func (c *API) v1OccurrencesHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	schedule, err := ownedSchedule(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	invalid := []FieldError{}
+	now := time.Now()
+	from, to := now.AddDate(0, 0, -28), now
+	if s := q.Get("from"); s != "" {
+		from, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"from", "must be an RFC 3339 time"})
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		to, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"to", "must be an RFC 3339 time"})
+		}
+	}
+	if len(invalid) == 0 && !to.After(from) {
+		invalid = append(invalid, FieldError{"to", "must be after from"})
+	}
+	if len(invalid) == 0 && to.Sub(from) > maxOccurrenceRange {
+		invalid = append(invalid, FieldError{"to", fmt.Sprintf("must be at most %d days after from", maxOccurrenceRange/(24*time.Hour))})
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+	if to.After(now) {
+		to = now
+	}
+	result := []*v1Occurrence{}
+	if to.After(from) {
+		result, err = occurrenceReport(schedule, from, to, c.step)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+	}
+	return &v1OccurrencesResponse{result}, http.StatusOK, nil
+}
+
+// v1Roster is a named class of a teacher. Only students enrolled in one of a teacher's rosters are tracked with them.
+type v1Roster struct {
+	ID        int64     `json:"id"`
+	TeacherID int64     `json:"teacher_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type v1RosterRequest struct {
+	TeacherID int64  `json:"teacher_id" validate:"required"`
+	Name      string `json:"name" validate:"required,max=255"`
+}
+
+// v1Enrolment is a student of a roster, with the roster's tags and notes about them
+type v1Enrolment struct {
+	Student    *v1Friend `json:"student"`
+	Tags       []string  `json:"tags"`
+	Notes      string    `json:"notes"`
+	EnrolledAt time.Time `json:"enrolled_at"`
+}
+
+// v1EnrolmentRequest enrols a student, or replaces the tags and notes of their enrolment
+type v1EnrolmentRequest struct {
+	Tags  []string `json:"tags"`
+	Notes string   `json:"notes" validate:"max=10000"`
+}
+
+type v1RostersResponse struct {
+	Data []*v1Roster `json:"data"`
+}
+
+type v1RosterResponse struct {
+	Data *v1Roster `json:"data"`
+}
+
+type v1EnrolmentsResponse struct {
+	Data []*v1Enrolment `json:"data"`
+}
+
+type v1EnrolmentResponse struct {
+	Data *v1Enrolment `json:"data"`
+}
+
+func toV1Roster(r *db.Roster) *v1Roster {
+	return &v1Roster{r.ID, r.TeacherID, r.Name, r.CreatedAt}
+}
+
+func toV1Enrolment(e *db.Enrolment, student *db.Friend) *v1Enrolment {
+	result := &v1Enrolment{Student: toV1Friend(student), Tags: []string{}, Notes: e.Notes, EnrolledAt: e.CreatedAt}
+	if e.Tags != "" {
+		result.Tags = strings.Split(e.Tags, ",")
+	}
+	return result
+}
+
+// ownedRoster is the roster in the URL, if it belongs to the integration in the URL and the user owns that
+func ownedRoster(r *http.Request, u *db.User) (*db.Roster, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	rosterID, err := urlParamID(r, "roster_id")
+	if err != nil {
+		return nil, err
+	}
+	roster, err := db.Rosters(
+		db.RosterWhere.ID.EQ(rosterID),
+		db.RosterWhere.IntegrationID.EQ(integration.ID),
+		db.RosterWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("roster")
+	}
+	return roster, err
+}
+
+func (c *API) v1RostersListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	mods := []qm.QueryMod{
+		db.RosterWhere.IntegrationID.EQ(integration.ID),
+		db.RosterWhere.Archived.EQ(false),
+		qm.OrderBy(db.RosterColumns.ID),
+	}
+	if s := r.URL.Query().Get("teacher_id"); s != "" {
+		teacherID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || teacherID < 1 {
+			return nil, http.StatusBadRequest, &ValidationError{[]FieldError{{"teacher_id", "must be a positive integer"}}}
+		}
+		mods = append(mods, db.RosterWhere.TeacherID.EQ(teacherID))
+	}
+	rosters, err := db.Rosters(mods...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1RostersResponse{[]*v1Roster{}}
+	for _, roster := range rosters {
+		result.Data = append(result.Data, toV1Roster(roster))
+	}
+	return result, http.StatusOK, nil
+}
+
+func (c *API) v1RosterCreateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1RosterRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkRoster(integration.ID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	roster := &db.Roster{IntegrationID: integration.ID, TeacherID: req.TeacherID, Name: req.Name}
+	err = roster.InsertG(boil.Infer())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1RosterResponse{toV1Roster(roster)}, http.StatusCreated, nil
+}
+
+// v1RosterUpdateHandler renames a roster or gives it to another teacher, with its students
+func (c *API) v1RosterUpdateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	roster, err := ownedRoster(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1RosterRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkRoster(roster.IntegrationID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	roster.TeacherID = req.TeacherID
+	roster.Name = req.Name
+	_, err = roster.UpdateG(boil.Infer())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1RosterResponse{toV1Roster(roster)}, http.StatusOK, nil
+}
+
+func (c *API) v1RosterDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	roster, err := ownedRoster(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	err = ArchiveRoster(roster)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &successResponse{true}, http.StatusOK, nil
+}
+
+// v1EnrolmentsListHandler lists the students of a roster, optionally only those with a tag
+func (c *API) v1EnrolmentsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	roster, err := ownedRoster(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	enrolments, err := db.Enrolments(
+		db.EnrolmentWhere.RosterID.EQ(roster.ID),
+		qm.Load(db.EnrolmentRels.Friend),
+		qm.OrderBy(db.EnrolmentColumns.FriendID),
+	).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	tag := r.URL.Query().Get("tag")
+	result := &v1EnrolmentsResponse{[]*v1Enrolment{}}
+	for _, enrolment := range enrolments {
+		student := enrolment.R.Friend
+		if student.Archived {
+			continue
+		}
+		if tag != "" && !hasTag(enrolment, tag) {
+			continue
+		}
+		result.Data = append(result.Data, toV1Enrolment(enrolment, student))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1EnrolHandler enrols a student in a roster, or replaces the tags and notes of their enrolment
+func (c *API) v1EnrolHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	roster, err := ownedRoster(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	friendID, err := urlParamID(r, "friend_id")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	req := &v1EnrolmentRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkEnrolment(req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	student, err := db.Friends(
+		db.FriendWhere.ID.EQ(friendID),
+		db.FriendWhere.IntegrationID.EQ(roster.IntegrationID),
+		db.FriendWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, http.StatusNotFound, errNotFound("friend")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if student.IsTeacher {
+		return nil, http.StatusConflict, errConflict("teachers can't be enrolled", nil)
+	}
+	enrolment, err := Enrol(roster, student, req.Tags, req.Notes)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1EnrolmentResponse{toV1Enrolment(enrolment, student)}, http.StatusOK, nil
+}
+
+// v1UnenrolHandler removes a student from a roster with its tags and notes, their attendance is kept
+func (c *API) v1UnenrolHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	roster, err := ownedRoster(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	friendID, err := urlParamID(r, "friend_id")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	enrolment, err := db.Enrolments(
+		db.EnrolmentWhere.RosterID.EQ(roster.ID),
+		db.EnrolmentWhere.FriendID.EQ(friendID),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, http.StatusNotFound, errNotFound("enrolment")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	_, err = enrolment.DeleteG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &successResponse{true}, http.StatusOK, nil
+}
+
+// v1Session is the status of a student at a class of a schedule, evaluated with the attendance policy of the time once it ended.
+// Status is the override when a user set one, EvaluatedStatus is what the samples and manual attendance came to.
+// GapMinutes of the class the tracker missed, a student who wasn't present throughout one it missed any of is incomplete.
+// FirstSeenAt and LastSeenAt are when the tracker saw the student, null when it didn't. ManualMinutes of MinutesPresent
+// are covered by manual attendance.
+type v1Session struct {
+	ID                 int64              `json:"id"`
+	ScheduleID         int64              `json:"schedule_id"`
+	TeacherID          int64              `json:"teacher_id"`
+	FriendID           int64              `json:"friend_id"`
+	StartsAt           time.Time          `json:"starts_at"`
+	EndsAt             time.Time          `json:"ends_at"`
+	Status             string             `json:"status"`
+	EvaluatedStatus    string             `json:"evaluated_status"`
+	Override           *v1SessionOverride `json:"override"`
+	FirstSeenAt        null.Time          `json:"first_seen_at"`
+	LastSeenAt         null.Time          `json:"last_seen_at"`
+	Samples            int64              `json:"samples"`
+	MinutesPresent     int64              `json:"minutes_present"`
+	ManualMinutes      int64              `json:"manual_minutes"`
+	GapMinutes         int64              `json:"gap_minutes"`
+	MinPresencePercent int64              `json:"min_presence_percent"`
+	LateAfterMinutes   int64              `json:"late_after_minutes"`
+	LeftEarlyMinutes   int64              `json:"left_early_minutes"`
+	EvaluatedAt        time.Time          `json:"evaluated_at"`
+}
+
+// v1SessionOverride is the status a user gave a session, and why
+type v1SessionOverride struct {
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	AuthorID  int64     `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type v1SessionOverrideRequest struct {
+	Status string `json:"status" validate:"required,oneof=present late left_early absent excused"`
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+type v1SessionResponse struct {
+	Data *v1Session `json:"data"`
+}
+
+// v1SessionsResponse is a page of sessions, HasMore is set when the next page starts at offset + limit
+type v1SessionsResponse struct {
+	Data    []*v1Session `json:"data"`
+	HasMore bool         `json:"has_more"`
+}
+
+func toV1Session(s *db.ClassSession) *v1Session {
+	return &v1Session{
+		ID:                 s.ID,
+		ScheduleID:         s.ScheduleID,
+		TeacherID:          s.TeacherID,
+		FriendID:           s.FriendID,
+		StartsAt:           s.StartsAt.UTC(),
+		EndsAt:             s.EndsAt.UTC(),
+		Status:             sessionStatus(s),
+		EvaluatedStatus:    s.Status,
+		Override:           toV1SessionOverride(s),
+		FirstSeenAt:        s.FirstSeenAt,
+		LastSeenAt:         s.LastSeenAt,
+		Samples:            s.Samples,
+		MinutesPresent:     s.MinutesPresent,
+		ManualMinutes:      s.ManualMinutes,
+		GapMinutes:         s.GapMinutes,
+		MinPresencePercent: s.MinPresencePercent,
+		LateAfterMinutes:   s.LateAfterMinutes,
+		LeftEarlyMinutes:   s.LeftEarlyMinutes,
+		EvaluatedAt:        s.EvaluatedAt.UTC(),
+	}
+}
+
+func toV1SessionOverride(s *db.ClassSession) *v1SessionOverride {
+	if !s.OverrideStatus.Valid {
+		return nil
+	}
+	return &v1SessionOverride{s.OverrideStatus.String, s.OverrideReason.String, s.OverrideAuthorID.Int64, s.OverriddenAt.Time.UTC()}
+}
+
+// sessionFilters are the query mods of the schedule_id, teacher_id, friend_id, status, from and to query parameters
+// of the sessions list and export. Status matches overrides in place of the evaluated status.
+// From and to are compared with the start of the classes.
+func sessionFilters(integration *db.Integration, q url.Values) ([]qm.QueryMod, []FieldError) {
+	invalid := []FieldError{}
+	mods := []qm.QueryMod{db.ClassSessionWhere.IntegrationID.EQ(integration.ID)}
+	for _, param := range []struct {
+		name   string
+		column string
+	}{
+		{"schedule_id", db.ClassSessionColumns.ScheduleID},
+		{"teacher_id", db.ClassSessionColumns.TeacherID},
+		{"friend_id", db.ClassSessionColumns.FriendID},
+	} {
+		if s := q.Get(param.name); s != "" {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || id < 1 {
+				invalid = append(invalid, FieldError{param.name, "must be a positive integer"})
+				continue
+			}
+			mods = append(mods, qm.Where(param.column+" = ?", id))
+		}
+	}
+	if status := q.Get("status"); status != "" {
+		msg := checkRules(reflect.ValueOf(status), []string{"oneof=" + strings.Join(sessionStatuses, " ")})
+		if msg != "" {
+			invalid = append(invalid, FieldError{"status", msg})
+		}
+		mods = append(mods, qm.Where("COALESCE(override_status, status) = ?", status))
+	}
+	if s := q.Get("from"); s != "" {
+		from, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"from", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.ClassSessionWhere.StartsAt.GTE(from.UTC()))
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		to, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"to", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.ClassSessionWhere.StartsAt.LT(to.UTC()))
+		}
+	}
+	return mods, invalid
+}
+
+// v1SessionsListHandler pages through the evaluated sessions of an integration in the order of the classes
+func (c *API) v1SessionsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	mods, invalid := sessionFilters(integration, q)
+	limit, offset := v1AttendanceLimit, 0
+	if s := q.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit < 1 || limit > v1AttendanceMaxLimit {
+			invalid = append(invalid, FieldError{"limit", fmt.Sprintf("must be between 1 and %d", v1AttendanceMaxLimit)})
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		offset, err = strconv.Atoi(s)
+		if err != nil || offset < 0 {
+			invalid = append(invalid, FieldError{"offset", "must be a positive integer"})
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+
+	// one more than the limit tells whether there is another page
+	mods = append(mods,
+		qm.OrderBy(db.ClassSessionColumns.StartsAt+", "+db.ClassSessionColumns.ScheduleID+", "+db.ClassSessionColumns.FriendID),
+		qm.Limit(limit+1),
+		qm.Offset(offset),
+	)
+	sessions, err := db.ClassSessions(mods...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1SessionsResponse{Data: []*v1Session{}}
+	if len(sessions) > limit {
+		sessions, result.HasMore = sessions[:limit], true
+	}
+	for _, session := range sessions {
+		result.Data = append(result.Data, toV1Session(session))
+	}
+	return result, http.StatusOK, nil
+}
+
+// sessionsCSVHeader are the columns of the sessions export
+var sessionsCSVHeader = []string{
+	"session_id", "schedule_id", "schedule", "teacher_id", "teacher", "student_id", "student", "starts_at", "ends_at", "status",
+	"evaluated_status", "override_reason", "override_author_id", "first_seen_at", "last_seen_at", "minutes_present",
+	"manual_minutes", "gap_minutes", "min_presence_percent", "late_after_minutes", "left_early_minutes",
+}
+
+// v1SessionsExportHandler sends the sessions matching the filters of the sessions list as CSV, with the names of the
+// schedules, teachers and students, for spreadsheets. Status is the override where there is one. It isn't paged.
+func (c *API) v1SessionsExportHandler(auther *Auther) http.HandlerFunc {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		u, err := userFromRequest(auther, r)
+		if err != nil {
+			writeError(w, r, c.log, errUnauthorized(err), http.StatusUnauthorized)
+			return
+		}
+		integration, err := ownedIntegration(r, u)
+		if err != nil {
+			writeError(w, r, c.log, err, http.StatusForbidden)
+			return
+		}
+		mods, invalid := sessionFilters(integration, r.URL.Query())
+		if len(invalid) > 0 {
+			writeError(w, r, c.log, &ValidationError{invalid}, http.StatusBadRequest)
+			return
+		}
+		sessions, err := db.ClassSessions(append(mods,
+			qm.Load(db.ClassSessionRels.Schedule),
+			qm.Load(db.ClassSessionRels.Teacher),
+			qm.Load(db.ClassSessionRels.Friend),
+			qm.OrderBy(db.ClassSessionColumns.StartsAt+", "+db.ClassSessionColumns.ScheduleID+", "+db.ClassSessionColumns.FriendID),
+		)...).AllG()
+		if err != nil {
+			writeError(w, r, c.log, err, http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="sessions-%d.csv"`, integration.ID))
+		cw := csv.NewWriter(w)
+		cw.Write(sessionsCSVHeader)
+		for _, s := range sessions {
+			seen := func(t null.Time) string {
+				if !t.Valid {
+					return ""
+				}
+				return t.Time.UTC().Format(time.RFC3339)
+			}
+			author := ""
+			if s.OverrideAuthorID.Valid {
+				author = strconv.FormatInt(s.OverrideAuthorID.Int64, 10)
+			}
+			cw.Write([]string{
+				strconv.FormatInt(s.ID, 10), strconv.FormatInt(s.ScheduleID, 10), s.R.Schedule.Name,
+				strconv.FormatInt(s.TeacherID, 10), s.R.Teacher.VrchatDisplayName,
+				strconv.FormatInt(s.FriendID, 10), s.R.Friend.VrchatDisplayName,
+				s.StartsAt.UTC().Format(time.RFC3339), s.EndsAt.UTC().Format(time.RFC3339), sessionStatus(s),
+				s.Status, s.OverrideReason.String, author, seen(s.FirstSeenAt), seen(s.LastSeenAt),
+				strconv.FormatInt(s.MinutesPresent, 10), strconv.FormatInt(s.ManualMinutes, 10), strconv.FormatInt(s.GapMinutes, 10),
+				strconv.FormatInt(s.MinPresencePercent, 10), strconv.FormatInt(s.LateAfterMinutes, 10), strconv.FormatInt(s.LeftEarlyMinutes, 10),
+			})
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			// the status is already sent
+			c.log.Errorw("write sessions export", "integration_id", integration.ID, "err", err)
+		}
+	}
+	return http.HandlerFunc(fn)
+}
+
+// ownedSession is a session of an integration of the user
+func ownedSession(r *http.Request, u *db.User) (*db.ClassSession, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := urlParamID(r, "session_id")
+	if err != nil {
+		return nil, err
+	}
+	session, err := db.ClassSessions(
+		db.ClassSessionWhere.ID.EQ(sessionID),
+		db.ClassSessionWhere.IntegrationID.EQ(integration.ID),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("session")
+	}
+	return session, err
+}
+
+// v1SessionOverrideHandler sets the status of a session by hand, like excusing a student, with the reason in the audit log
+func (c *API) v1SessionOverrideHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	session, err := ownedSession(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1SessionOverrideRequest{}
+	err = decodeJSON(w, r, req)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	err = OverrideSession(u, session, req.Status, req.Reason)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1SessionResponse{toV1Session(session)}, http.StatusOK, nil
+}
+
+func (c *API) v1SessionOverrideDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	session, err := ownedSession(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	if !session.OverrideStatus.Valid {
+		return nil, http.StatusNotFound, errNotFound("override")
+	}
+	err = RemoveOverride(u, session)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1SessionResponse{toV1Session(session)}, http.StatusOK, nil
+}
+
+// v1ManualAttendance is attendance of a student with a teacher entered by a user, for when the tracker couldn't see them.
+// It counts towards the student's sessions like samples of the tracker do, but is never listed as attendance.
+type v1ManualAttendance struct {
+	ID        int64     `json:"id"`
+	TeacherID int64     `json:"teacher_id"`
+	FriendID  int64     `json:"friend_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason"`
+	AuthorID  int64     `json:"author_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type v1ManualAttendanceRequest struct {
+	TeacherID int64     `json:"teacher_id" validate:"required"`
+	FriendID  int64     `json:"friend_id" validate:"required"`
+	StartsAt  time.Time `json:"starts_at" validate:"required"`
+	EndsAt    time.Time `json:"ends_at" validate:"required"`
+	Reason    string    `json:"reason" validate:"required,max=1000"`
+}
+
+type v1ManualAttendanceResponse struct {
+	Data *v1ManualAttendance `json:"data"`
+}
+
+type v1ManualAttendancesResponse struct {
+	Data []*v1ManualAttendance `json:"data"`
+}
+
+func toV1ManualAttendance(m *db.ManualAttendance) *v1ManualAttendance {
+	return &v1ManualAttendance{
+		ID:        m.ID,
+		TeacherID: m.TeacherID,
+		FriendID:  m.FriendID,
+		StartsAt:  m.StartsAt.UTC(),
+		EndsAt:    m.EndsAt.UTC(),
+		Reason:    m.Reason,
+		AuthorID:  m.AuthorID,
+		UpdatedAt: m.UpdatedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func ownedManualAttendance(r *http.Request, u *db.User) (*db.ManualAttendance, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	entryID, err := urlParamID(r, "entry_id")
+	if err != nil {
+		return nil, err
+	}
+	entry, err := db.ManualAttendances(
+		db.ManualAttendanceWhere.ID.EQ(entryID),
+		db.ManualAttendanceWhere.IntegrationID.EQ(integration.ID),
+		db.ManualAttendanceWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("manual attendance")
+	}
+	return entry, err
+}
+
+// v1ManualAttendanceListHandler lists the manual attendance of an integration in time order,
+// optionally of one teacher or one student, overlapping from until to
+func (c *API) v1ManualAttendanceListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	invalid := []FieldError{}
+	mods := []qm.QueryMod{
+		db.ManualAttendanceWhere.IntegrationID.EQ(integration.ID),
+		db.ManualAttendanceWhere.Archived.EQ(false),
+	}
+	for _, param := range []struct {
+		name   string
+		column string
+	}{{"teacher_id", db.ManualAttendanceColumns.TeacherID}, {"friend_id", db.ManualAttendanceColumns.FriendID}} {
+		if s := q.Get(param.name); s != "" {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || id < 1 {
+				invalid = append(invalid, FieldError{param.name, "must be a positive integer"})
+				continue
+			}
+			mods = append(mods, qm.Where(param.column+" = ?", id))
+		}
+	}
+	if s := q.Get("from"); s != "" {
+		from, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"from", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.ManualAttendanceWhere.EndsAt.GT(from.UTC()))
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		to, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"to", "must be an RFC 3339 time"})
+		} else {
+			mods = append(mods, db.ManualAttendanceWhere.StartsAt.LT(to.UTC()))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+	entries, err := db.ManualAttendances(append(mods, qm.OrderBy(db.ManualAttendanceColumns.StartsAt+", "+db.ManualAttendanceColumns.ID))...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1ManualAttendancesResponse{[]*v1ManualAttendance{}}
+	for _, entry := range entries {
+		result.Data = append(result.Data, toV1ManualAttendance(entry))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1ManualAttendanceCreateHandler adds manual attendance. Sessions of classes that are already over are evaluated again.
+func (c *API) v1ManualAttendanceCreateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1ManualAttendanceRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkManualAttendance(integration.ID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	entry := &db.ManualAttendance{IntegrationID: integration.ID}
+	err = SaveManualAttendance(u, entry, req, c.step)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1ManualAttendanceResponse{toV1ManualAttendance(entry)}, http.StatusCreated, nil
+}
+
+// v1ManualAttendanceUpdateHandler replaces manual attendance, the user becomes its author
+func (c *API) v1ManualAttendanceUpdateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	entry, err := ownedManualAttendance(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1ManualAttendanceRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkManualAttendance(entry.IntegrationID, req)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	err = SaveManualAttendance(u, entry, req, c.step)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1ManualAttendanceResponse{toV1ManualAttendance(entry)}, http.StatusOK, nil
+}
+
+func (c *API) v1ManualAttendanceDeleteHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	entry, err := ownedManualAttendance(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	err = ArchiveManualAttendance(u, entry, c.step)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &successResponse{true}, http.StatusOK, nil
+}
+
+// v1AuditEntry is a change to attendance made by hand. OldValue and NewValue are the subject as the API returned it
+// before and after, null when it didn't exist.
+type v1AuditEntry struct {
+	ID        int64           `json:"id"`
+	UserID    int64           `json:"user_id"`
+	Action    string          `json:"action"`
+	SubjectID int64           `json:"subject_id"`
+	Reason    string          `json:"reason"`
+	OldValue  json.RawMessage `json:"old_value"`
+	NewValue  json.RawMessage `json:"new_value"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type v1AuditResponse struct {
+	Data []*v1AuditEntry `json:"data"`
+}
+
+func toV1AuditEntry(e *db.AuditLog) *v1AuditEntry {
+	result := &v1AuditEntry{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		Action:    e.Action,
+		SubjectID: e.SubjectID,
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.OldValue.Valid {
+		result.OldValue = json.RawMessage(e.OldValue.String)
+	}
+	if e.NewValue.Valid {
+		result.NewValue = json.RawMessage(e.NewValue.String)
+	}
+	return result
+}
+
+// v1AuditHandler lists the newest changes to the attendance of an integration made by hand
+func (c *API) v1AuditHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	mods := []qm.QueryMod{
+		db.AuditLogWhere.IntegrationID.EQ(integration.ID),
+		qm.OrderBy(db.AuditLogColumns.ID + " DESC"),
+	}
+	invalid := []FieldError{}
+	if action := q.Get("action"); action != "" {
+		msg := checkRules(reflect.ValueOf(action), []string{"oneof=" + strings.Join(auditActions, " ")})
+		if msg != "" {
+			invalid = append(invalid, FieldError{"action", msg})
+		}
+		mods = append(mods, db.AuditLogWhere.Action.EQ(action))
+	}
+	if s := q.Get("subject_id"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || id < 1 {
+			invalid = append(invalid, FieldError{"subject_id", "must be a positive integer"})
+		}
+		mods = append(mods, db.AuditLogWhere.SubjectID.EQ(id))
+	}
+	limit := 50
+	if s := q.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit < 1 || limit > 500 {
+			invalid = append(invalid, FieldError{"limit", "must be between 1 and 500"})
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+	entries, err := db.AuditLogs(append(mods, qm.Limit(limit))...).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1AuditResponse{[]*v1AuditEntry{}}
+	for _, entry := range entries {
+		result.Data = append(result.Data, toV1AuditEntry(entry))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1Coverage is how much of a time the tracker recorded an integration's attendance. Ticks is how many times it tried,
+// FailedTicks how many of those failed, and Gaps the times it missed, from when a tick was due until the next that succeeded.
+// TrackedSince is the integration's first tick, the time before it is a gap.
+type v1Coverage struct {
+	From         time.Time        `json:"from"`
+	To           time.Time        `json:"to"`
+	TrackedSince null.Time        `json:"tracked_since"`
+	Ticks        int              `json:"ticks"`
+	FailedTicks  int              `json:"failed_ticks"`
+	GapMinutes   int64            `json:"gap_minutes"`
+	Gaps         []*v1CoverageGap `json:"gaps"`
+}
+
+// v1CoverageGap is a time the tracker missed, with the ticks that failed in it and the error of the last, null when the
+// tracker wasn't running
+type v1CoverageGap struct {
+	Start       time.Time   `json:"start"`
+	End         time.Time   `json:"end"`
+	FailedTicks int         `json:"failed_ticks"`
+	LastError   null.String `json:"last_error"`
+}
+
+type v1CoverageResponse struct {
+	Data *v1Coverage `json:"data"`
+}
+
+// v1CoverageHandler reports the gaps in the tracking of an integration between from and to, the last day by default.
+// Heartbeats are only recorded from the migration that added them, so the time before is one gap.
+func (c *API) v1CoverageHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	q := r.URL.Query()
+	invalid := []FieldError{}
+	now := time.Now()
+	from, to := now.Add(-24*time.Hour), now
+	if s := q.Get("from"); s != "" {
+		from, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"from", "must be an RFC 3339 time"})
+		}
+	}
+	if s := q.Get("to"); s != "" {
+		to, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			invalid = append(invalid, FieldError{"to", "must be an RFC 3339 time"})
+		}
+	}
+	if len(invalid) == 0 && !to.After(from) {
+		invalid = append(invalid, FieldError{"to", "must be after from"})
+	}
+	if len(invalid) == 0 && to.Sub(from) > maxCoverageRange {
+		invalid = append(invalid, FieldError{"to", fmt.Sprintf("must be at most %d days after from", maxCoverageRange/(24*time.Hour))})
+	}
+	if len(invalid) > 0 {
+		return nil, http.StatusBadRequest, &ValidationError{invalid}
+	}
+	if to.After(now) {
+		to = now
+	}
+	result := &v1Coverage{From: from.UTC(), To: to.UTC(), Gaps: []*v1CoverageGap{}}
+	if !to.After(from) {
+		return &v1CoverageResponse{result}, http.StatusOK, nil
+	}
+	result.TrackedSince, err = firstHeartbeat(integration.ID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	gaps, ticks, err := coverageGaps(integration.ID, from, to, c.step)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result.Ticks = len(ticks)
+	for _, tick := range ticks {
+		if !tick.Succeeded {
+			result.FailedTicks++
+		}
+	}
+	result.GapMinutes = gapMinutes(gaps, from, to)
+	for _, g := range gaps {
+		result.Gaps = append(result.Gaps, &v1CoverageGap{g.start.UTC(), g.end.UTC(), g.failedTicks, g.lastError})
+	}
+	return &v1CoverageResponse{result}, http.StatusOK, nil
+}
+
+// v1ReprocessJob evaluates the classes of an integration that ended between from and to again, from the tracker's samples
+// with the schedules, rosters and attendance policy of now. Status is queued, running, succeeded or failed, and Progress
+// the percent of TotalClasses processed. UserID is null for jobs run with the admin command.
+type v1ReprocessJob struct {
+	ID               int64       `json:"id"`
+	UserID           null.Int64  `json:"user_id"`
+	From             time.Time   `json:"from"`
+	To               time.Time   `json:"to"`
+	Status           string      `json:"status"`
+	TotalClasses     int64       `json:"total_classes"`
+	ProcessedClasses int64       `json:"processed_classes"`
+	Progress         int64       `json:"progress"`
+	SessionsCreated  int64       `json:"sessions_created"`
+	SessionsChanged  int64       `json:"sessions_changed"`
+	SessionsDeleted  int64       `json:"sessions_deleted"`
+	Error            null.String `json:"error"`
+	StartedAt        null.Time   `json:"started_at"`
+	FinishedAt       null.Time   `json:"finished_at"`
+	CreatedAt        time.Time   `json:"created_at"`
+}
+
+type v1ReprocessRequest struct {
+	From time.Time `json:"from" validate:"required"`
+	To   time.Time `json:"to" validate:"required"`
+}
+
+type v1ReprocessJobResponse struct {
+	Data *v1ReprocessJob `json:"data"`
+}
+
+type v1ReprocessJobsResponse struct {
+	Data []*v1ReprocessJob `json:"data"`
+}
+
+func toV1ReprocessJob(job *db.ReprocessJob) *v1ReprocessJob {
+	result := &v1ReprocessJob{
+		ID:               job.ID,
+		UserID:           job.UserID,
+		From:             job.PeriodStart.UTC(),
+		To:               job.PeriodEnd.UTC(),
+		Status:           job.Status,
+		TotalClasses:     job.TotalClasses,
+		ProcessedClasses: job.ProcessedClasses,
+		SessionsCreated:  job.SessionsCreated,
+		SessionsChanged:  job.SessionsChanged,
+		SessionsDeleted:  job.SessionsDeleted,
+		Error:            job.ErrorMessage,
+		StartedAt:        job.StartedAt,
+		FinishedAt:       job.FinishedAt,
+		CreatedAt:        job.CreatedAt,
+	}
+	switch {
+	case job.Status == jobSucceeded:
+		result.Progress = 100
+	case job.TotalClasses > 0:
+		result.Progress = job.ProcessedClasses * 100 / job.TotalClasses
+	}
+	return result
+}
+
+// ownedReprocessJob is a reprocess job of an integration of the user
+func ownedReprocessJob(r *http.Request, u *db.User) (*db.ReprocessJob, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := urlParamID(r, "job_id")
+	if err != nil {
+		return nil, err
+	}
+	job, err := db.ReprocessJobs(
+		db.ReprocessJobWhere.ID.EQ(jobID),
+		db.ReprocessJobWhere.IntegrationID.EQ(integration.ID),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, errNotFound("reprocess job")
+	}
+	return job, err
+}
+
+// v1ReprocessJobsListHandler lists the newest 50 reprocess jobs of an integration
+func (c *API) v1ReprocessJobsListHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	jobs, err := db.ReprocessJobs(
+		db.ReprocessJobWhere.IntegrationID.EQ(integration.ID),
+		qm.OrderBy(db.ReprocessJobColumns.ID+" DESC"),
+		qm.Limit(50),
+	).AllG()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := &v1ReprocessJobsResponse{[]*v1ReprocessJob{}}
+	for _, job := range jobs {
+		result.Data = append(result.Data, toV1ReprocessJob(job))
+	}
+	return result, http.StatusOK, nil
+}
+
+// v1ReprocessJobCreateHandler queues a job reprocessing the classes of an integration, it runs in the background
+func (c *API) v1ReprocessJobCreateHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	integration, err := ownedIntegration(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	req := &v1ReprocessRequest{}
+	err = decodeJSON(w, r, req)
+	if err == nil {
+		err = checkReprocess(req.From, req.To)
+	}
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	job, err := QueueReprocess(integration.ID, u, req.From, req.To)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &v1ReprocessJobResponse{toV1ReprocessJob(job)}, http.StatusAccepted, nil
+}
+
+// v1ReprocessJobHandler shows a reprocess job with its progress
+func (c *API) v1ReprocessJobHandler(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	job, err := ownedReprocessJob(r, u)
+	if err != nil {
+		return nil, http.StatusForbidden, err
+	}
+	return &v1ReprocessJobResponse{toV1ReprocessJob(job)}, http.StatusOK, nil
+}
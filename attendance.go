@@ -3,125 +3,433 @@ package accumulator
 import (
 	"accumulator/db"
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"net/http"
+	"sort"
 	"time"
 
 	vrc "github.com/nii236/vrchat-go/client"
-	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
 	"go.uber.org/zap"
 )
 
-// RunAttendanceTracker starts the tracking service
-func RunAttendanceTracker(ctx context.Context, d *Darer, stepMinutes int, log *zap.SugaredLogger) error {
+// RunAttendanceTracker starts the tracking service, publishing what changes to the hub
+func RunAttendanceTracker(ctx context.Context, d *Darer, blobs *BlobStorage, hub *Hub, stepMinutes int, log *zap.SugaredLogger) error {
 	log.Infow("start attendance tracker")
-	log.Info("running tracker")
-	integrations, err := db.Integrations().AllG()
+	t := &tracker{
+		d:           d,
+		blobs:       blobs,
+		hub:         hub,
+		log:         log,
+		step:        time.Duration(stepMinutes) * time.Minute,
+		seen:        map[int64]*observation{},
+		authExpired: map[int64]bool{},
+	}
+	t.tick()
+	ticker := time.NewTicker(time.Duration(stepMinutes) * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			t.tick()
+		}
+	}
+}
+
+// tracker remembers what it saw at the last tick of every integration, to tell what changed since
+type tracker struct {
+	d     *Darer
+	blobs *BlobStorage
+	hub   *Hub
+	log   *zap.SugaredLogger
+	// step is the time between ticks, which every sample stands for
+	step time.Duration
+	// seen at the last tick by integration ID
+	seen        map[int64]*observation
+	authExpired map[int64]bool
+}
+
+// observation is what a tick saw of an integration
+type observation struct {
+	// classes by teacher ID
+	classes map[int64]*liveClass
+	// friends by ID, with their locations by friend ID. Friends VRChat didn't list have no location.
+	friends    map[int64]*db.Friend
+	locations  map[int64]Location
+	attendance db.AttendanceSlice
+	// worldNames of the instances friends are in by world ID, missing when they couldn't be looked up
+	worldNames map[string]string
+}
+
+// liveClass is a teacher in an instance with students
+type liveClass struct {
+	teacher  *db.Friend
+	location Location
+	students map[int64]*db.Friend
+}
+
+// tick records the attendance of every integration. Integrations that fail are skipped, keeping what was seen before.
+func (t *tracker) tick() {
+	t.log.Info("running tracker")
+	integrations, err := db.Integrations(db.IntegrationWhere.Archived.EQ(false)).AllG()
 	if err != nil {
-		return err
+		t.log.Errorw("list integrations", "err", err)
+		return
 	}
 	for _, integration := range integrations {
-		if !integration.ID.Valid {
-			fmt.Println("invalid integration ID, this should never happen")
+		ticked := time.Now()
+		seen, err := trackAttendance(t.d, t.blobs, integration, t.log)
+		friends := 0
+		if err == nil {
+			friends = len(seen.locations)
+		}
+		if herr := recordHeartbeat(integration.ID, ticked, friends, err); herr != nil {
+			t.log.Errorw("record heartbeat", "integration_id", integration.ID, "err", herr)
+		}
+		if err != nil {
+			t.log.Errorw(err.Error(), "integration_id", integration.ID, "integration_username", integration.Username)
+			if isAuthExpired(err) && !t.authExpired[integration.ID] {
+				t.authExpired[integration.ID] = true
+				t.emit(integration.ID, EventAuthExpired, &authExpiredEventData{toV1Integration(integration), err.Error()})
+			}
 			continue
 		}
-		err := trackAttendance(d, integration.ID.Int64, integration.AuthToken, integration.AuthTokenNonce, integration.APIKey, log)
+		t.authExpired[integration.ID] = false
+		t.moved(integration.ID, t.seen[integration.ID], seen)
+		before := map[int64]*liveClass{}
+		if t.seen[integration.ID] != nil {
+			before = t.seen[integration.ID].classes
+		}
+		t.changed(integration.ID, before, seen.classes)
+		for _, record := range seen.attendance {
+			t.publish(integration.ID, EventAttendanceRecorded, toV1Attendance(record))
+		}
+		t.seen[integration.ID] = seen
+		t.hub.setPresence(integration.ID, newPresence(seen, time.Now()))
+		t.evaluate(integration)
+	}
+}
+
+// evaluate the classes of the integration's schedules that ended since the lookback and haven't been evaluated yet
+func (t *tracker) evaluate(integration *db.Integration) {
+	schedules, err := db.Schedules(
+		db.ScheduleWhere.IntegrationID.EQ(integration.ID),
+		db.ScheduleWhere.Archived.EQ(false),
+	).AllG()
+	if err != nil {
+		t.log.Errorw("list schedules", "integration_id", integration.ID, "err", err)
+		return
+	}
+	now := time.Now()
+	for _, s := range schedules {
+		n, err := evaluateSessions(integration, s, now.Add(-sessionLookback), now, t.step)
 		if err != nil {
-			log.Errorw(err.Error(), "integration_id", integration.ID.Int64, "integration_username", integration.Username)
+			t.log.Errorw("evaluate sessions", "integration_id", integration.ID, "schedule_id", s.ID, "err", err)
 			continue
 		}
+		if n > 0 {
+			t.log.Infow("evaluated classes", "integration_id", integration.ID, "schedule_id", s.ID, "classes", n)
+		}
 	}
-	t := time.NewTicker(time.Duration(stepMinutes) * time.Minute)
-	for {
-		select {
-		case <-t.C:
-			log.Info("running tracker")
-			for _, integration := range integrations {
-				if !integration.ID.Valid {
-					fmt.Println("invalid integration ID, this should never happen")
-					continue
-				}
-				err := trackAttendance(d, integration.ID.Int64, integration.AuthToken, integration.AuthTokenNonce, integration.APIKey, log)
-				if err != nil {
-					log.Errorw(err.Error(), "integration_id", integration.ID.Int64)
-					continue
+}
+
+// moved publishes the friends whose location changed since the last tick, and the teachers who came online or went offline
+func (t *tracker) moved(integrationID int64, before, after *observation) {
+	if before == nil {
+		return
+	}
+	for _, friend := range sortedFriends(after.friends) {
+		from, known := before.locations[friend.ID]
+		to, listed := after.locations[friend.ID]
+		if !known || !listed || from.Raw == to.Raw {
+			continue
+		}
+		t.publish(integrationID, EventFriendMoved, &friendMovedEventData{toV1Friend(friend), from.Raw, to.Raw})
+		if !friend.IsTeacher {
+			continue
+		}
+		if from.Status() == presenceOffline {
+			t.publish(integrationID, EventTeacherOnline, &teacherEventData{toV1Friend(friend), to.Raw})
+		}
+		if to.Status() == presenceOffline {
+			t.publish(integrationID, EventTeacherOffline, &teacherEventData{toV1Friend(friend), from.Raw})
+		}
+	}
+}
+
+// changed emits the events between two ticks. Teachers moving to another instance end their class and start a new one.
+func (t *tracker) changed(integrationID int64, before, after map[int64]*liveClass) {
+	for teacherID, class := range before {
+		now := after[teacherID]
+		if now != nil && now.location.Raw == class.location.Raw {
+			for _, student := range sortedFriends(class.students) {
+				if now.students[student.ID] == nil {
+					t.emit(integrationID, EventStudentLeft, &studentEventData{toV1Friend(class.teacher), toV1Friend(student), class.location.Raw})
 				}
 			}
+			continue
+		}
+		for _, student := range sortedFriends(class.students) {
+			t.emit(integrationID, EventStudentLeft, &studentEventData{toV1Friend(class.teacher), toV1Friend(student), class.location.Raw})
+		}
+		t.emit(integrationID, EventClassEnded, &classEventData{toV1Friend(class.teacher), class.location.Raw, []*v1Friend{}})
+	}
+	for teacherID, class := range after {
+		was := before[teacherID]
+		started := was == nil || was.location.Raw != class.location.Raw
+		if started {
+			students := []*v1Friend{}
+			for _, student := range sortedFriends(class.students) {
+				students = append(students, toV1Friend(student))
+			}
+			t.emit(integrationID, EventClassStarted, &classEventData{toV1Friend(class.teacher), class.location.Raw, students})
+		}
+		for _, student := range sortedFriends(class.students) {
+			if started || was.students[student.ID] == nil {
+				t.emit(integrationID, EventStudentJoined, &studentEventData{toV1Friend(class.teacher), toV1Friend(student), class.location.Raw})
+			}
 		}
 	}
 }
 
-// trackAttendance in the database
-func trackAttendance(d *Darer, integrationID int64, encryptedAuthToken []byte, nonce []byte, apiKey string, log *zap.SugaredLogger) error {
-	err := refreshFriendCache(d, int(integrationID), false)
+// emit publishes the event to live connections and queues it for webhooks
+func (t *tracker) emit(integrationID int64, eventType string, data interface{}) {
+	event := newEvent(integrationID, eventType, data)
+	t.hub.publish(event)
+	err := emit(event)
 	if err != nil {
-		return fmt.Errorf("could not refresh friend cache: %v", err)
+		t.log.Errorw("queue webhook deliveries", "integration_id", integrationID, "event", eventType, "err", err)
+	}
+}
+
+// publish the event to live connections only
+func (t *tracker) publish(integrationID int64, eventType string, data interface{}) {
+	t.hub.publish(newEvent(integrationID, eventType, data))
+}
+
+func sortedFriends(friends map[int64]*db.Friend) []*db.Friend {
+	result := []*db.Friend{}
+	for _, f := range friends {
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// isAuthExpired is true when VRChat no longer accepts the integration's auth token
+func isAuthExpired(err error) bool {
+	return vrcStatusCode(err) == http.StatusUnauthorized
+}
+
+// vrcStatusCode of an error response from VRChat, 0 for other errors
+func vrcStatusCode(err error) int {
+	vrcErr := &vrc.ErrorResponse{}
+	if !errors.As(err, &vrcErr) {
+		return 0
 	}
+	return vrcErr.Err.StatusCode
+}
+
+// trackingRules decide who is recorded with whom: the integration's teachers, the students of their rosters, and for
+// integrations that are ScheduledOnly, when the teachers have a class. Friends who are neither are only sampled.
+type trackingRules struct {
+	integration *db.Integration
+	teachers    db.FriendSlice
+	students    db.FriendSlice
+	// others are the friends who aren't teachers or enrolled
+	others    db.FriendSlice
+	rosters   enrolled
+	schedules db.ScheduleSlice
+}
 
-	decryptedAuthToken, err := d.decrypt(encryptedAuthToken, nonce)
+// loadTrackingRules of the integration as they are now
+func loadTrackingRules(integration *db.Integration) (*trackingRules, error) {
+	r := &trackingRules{integration: integration, students: db.FriendSlice{}, others: db.FriendSlice{}, schedules: db.ScheduleSlice{}}
+	var err error
+	r.teachers, err = db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.IsTeacher.EQ(true),
+		db.FriendWhere.Archived.EQ(false),
+		qm.OrderBy(db.FriendColumns.ID),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+	friends, err := db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.IsTeacher.EQ(false),
+		db.FriendWhere.Archived.EQ(false),
+		qm.OrderBy(db.FriendColumns.ID),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+	r.rosters, err = enrolledStudents(integration.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// Friends who aren't in a roster are not students
+	for _, friend := range friends {
+		if r.rosters.any(friend.ID) {
+			r.students = append(r.students, friend)
+		} else {
+			r.others = append(r.others, friend)
+		}
+	}
+	if integration.ScheduledOnly {
+		r.schedules, err = db.Schedules(
+			db.ScheduleWhere.IntegrationID.EQ(integration.ID),
+			db.ScheduleWhere.Archived.EQ(false),
+		).AllG()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// classes at a tick from the locations of the friends then, with the attendance to record: a sample of every student in
+// the instance of each teacher whose roster they are in. A student with two of their teachers in one instance is in both
+// classes, with a sample for each. Integrations that are ScheduledOnly only record students with a teacher during one of
+// the teacher's scheduled classes, the classes are still seen.
+func (r *trackingRules) classes(at time.Time, locations map[int64]Location) (map[int64]*liveClass, db.AttendanceSlice, error) {
+	classes := map[int64]*liveClass{}
+	attendance := db.AttendanceSlice{}
+	for _, teacher := range r.teachers {
+		current := locations[teacher.ID]
+		if !current.IsInstance() {
+			continue
+		}
+		record := true
+		if r.integration.ScheduledOnly {
+			var err error
+			record, err = inSchedule(r.schedules, teacher.ID, current, at)
+			if err != nil {
+				return nil, nil, fmt.Errorf("check schedules: %w", err)
+			}
+		}
+		for _, student := range r.students {
+			if !r.rosters[teacher.ID][student.ID] || !current.SameInstance(locations[student.ID]) {
+				continue
+			}
+			if record {
+				attendance = append(attendance, newAttendance(r.integration.ID, at, student, teacher, current))
+			}
+			if classes[teacher.ID] == nil {
+				classes[teacher.ID] = &liveClass{teacher, current, map[int64]*db.Friend{}}
+			}
+			classes[teacher.ID].students[student.ID] = student
+		}
 	}
-	vrcClient, err := vrc.NewClient(vrc.ReleaseAPIURL, string(decryptedAuthToken), apiKey)
+	return classes, attendance, nil
+}
+
+// trackAttendance in the database, returning what was seen. Every friend in an instance has a location sample, and the
+// students with their teachers have attendance, both recorded in one transaction.
+func trackAttendance(d *Darer, blobs *BlobStorage, integration *db.Integration, log *zap.SugaredLogger) (*observation, error) {
+	integrationID := integration.ID
+	err := refreshFriendCache(d, blobs, int(integrationID), false, log)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("could not refresh friend cache: %w", err)
 	}
 
-	teachers, err := db.Friends(db.FriendWhere.IsTeacher.EQ(true)).AllG()
+	decryptedAuthToken, err := d.decrypt(integration.AuthToken, integration.AuthTokenNonce)
+	if err != nil {
+		return nil, err
+	}
+	vrcClient, err := vrc.NewClient(vrc.ReleaseAPIURL, string(decryptedAuthToken), integration.APIKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	students, err := db.Friends(db.FriendWhere.IsTeacher.EQ(false)).AllG()
+	rules, err := loadTrackingRules(integration)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	vrcfriends, err := vrcClient.FriendList(true)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, teacher := range teachers {
-		currentLocation := ""
+	seen := &observation{
+		friends:    map[int64]*db.Friend{},
+		locations:  map[int64]Location{},
+		worldNames: map[string]string{},
+	}
+	// locations of every friend VRChat listed, only the teachers and students are seen
+	locations := map[int64]Location{}
+	for _, friend := range append(append(append(db.FriendSlice{}, rules.teachers...), rules.students...), rules.others...) {
 		for _, vrcfriend := range vrcfriends {
-			if vrcfriend.ID == teacher.VrchatID {
-				currentLocation = vrcfriend.Location
+			if vrcfriend.ID == friend.VrchatID {
+				locations[friend.ID] = ParseLocation(vrcfriend.Location)
 			}
 		}
-		if currentLocation == "offline" {
-			log.Errorw("teacher is offline", "vrc_id", teacher.VrchatID, "display_name", teacher.VrchatDisplayName)
+	}
+	for _, friend := range append(append(db.FriendSlice{}, rules.teachers...), rules.students...) {
+		seen.friends[friend.ID] = friend
+		if l, listed := locations[friend.ID]; listed {
+			seen.locations[friend.ID] = l
+		}
+	}
+	for _, l := range seen.locations {
+		if _, ok := seen.worldNames[l.WorldID]; !l.IsInstance() || ok {
 			continue
 		}
-		if currentLocation == "" {
+		name, err := worldName(vrcClient, l.WorldID)
+		if err != nil {
+			log.Errorw("look up world", "world_id", l.WorldID, "err", err)
+		}
+		seen.worldNames[l.WorldID] = name
+	}
+	for _, teacher := range rules.teachers {
+		current, listed := seen.locations[teacher.ID]
+		if !listed {
 			log.Errorw("could not get teacher location", "vrc_id", teacher.VrchatID, "display_name", teacher.VrchatDisplayName)
 			continue
 		}
-		for _, vrcfriend := range vrcfriends {
-			for _, student := range students {
-				if student.IntegrationID != integrationID {
-					continue
-				}
-				if student.VrchatID != vrcfriend.ID {
-					continue
-				}
-				if vrcfriend.Location == currentLocation {
-					record := &db.Attendance{
-						Timestamp:     time.Now().Unix(),
-						IntegrationID: null.Int64From(integrationID),
-						FriendID:      student.ID,
-						TeacherID:     teacher.ID,
-						Location:      currentLocation,
-					}
-					err = record.InsertG(boil.Infer())
-					if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-						return fmt.Errorf("insert attendance record: %v", err)
-					}
-				}
-			}
+		if !current.IsInstance() {
+			log.Infow("teacher is not in a known instance", "vrc_id", teacher.VrchatID, "display_name", teacher.VrchatDisplayName, "location", current.Raw)
 		}
 	}
 
+	now := time.Now()
+	seen.classes, seen.attendance, err = rules.classes(now, locations)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := beginTx()
+	if err != nil {
+		return nil, err
+	}
+	err = recordTick(tx, integrationID, now, locations, seen.attendance)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	return seen, tx.Commit()
+}
+
+// recordTick stores the location samples of the friends in an instance at the tick, and its attendance
+func recordTick(exec boil.Executor, integrationID int64, at time.Time, locations map[int64]Location, attendance db.AttendanceSlice) error {
+	for friendID, l := range locations {
+		if !l.IsInstance() {
+			continue
+		}
+		sample := &db.LocationSample{Timestamp: at.Unix(), IntegrationID: integrationID, FriendID: friendID, Location: l.Raw}
+		err := sample.Insert(exec, boil.Infer())
+		if err != nil {
+			return fmt.Errorf("insert location sample: %w", err)
+		}
+	}
+	for _, sample := range attendance {
+		err := sample.Insert(exec, boil.Infer())
+		if err != nil {
+			return fmt.Errorf("insert attendance record: %w", err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,53 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"encoding/json"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Actions of the audit log, the subject of each is the manual attendance or session it names
+const (
+	auditManualAttendanceCreated = "manual_attendance.created"
+	auditManualAttendanceUpdated = "manual_attendance.updated"
+	auditManualAttendanceDeleted = "manual_attendance.deleted"
+	auditSessionOverridden       = "session.overridden"
+	auditSessionOverrideRemoved  = "session.override_removed"
+)
+
+// auditActions in the order they are documented
+var auditActions = []string{
+	auditManualAttendanceCreated,
+	auditManualAttendanceUpdated,
+	auditManualAttendanceDeleted,
+	auditSessionOverridden,
+	auditSessionOverrideRemoved,
+}
+
+// audit records that the user changed attendance by hand, with the API representation of the subject before and after.
+// It is written in the transaction of the change, so neither is kept without the other.
+func audit(exec boil.Executor, u *db.User, integrationID int64, action string, subjectID int64, reason string, before, after interface{}) error {
+	entry := &db.AuditLog{
+		IntegrationID: integrationID,
+		UserID:        u.ID,
+		Action:        action,
+		SubjectID:     subjectID,
+		Reason:        reason,
+	}
+	for _, v := range []struct {
+		value interface{}
+		to    *null.String
+	}{{before, &entry.OldValue}, {after, &entry.NewValue}} {
+		if v.value == nil {
+			continue
+		}
+		b, err := json.Marshal(v.value)
+		if err != nil {
+			return err
+		}
+		*v.to = null.StringFrom(string(b))
+	}
+	return entry.Insert(exec, boil.Infer())
+}
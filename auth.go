@@ -3,7 +3,10 @@ package accumulator
 import (
 	"accumulator/db"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"net/http"
 	"strconv"
@@ -13,18 +16,22 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/go-chi/jwtauth"
-	"github.com/volatiletech/null"
 )
 
 // Auther to handle JWT authentication
 type Auther struct {
 	TokenAuth *jwtauth.JWTAuth
+	secret    []byte
 }
 
+// ErrInvalidSignature is returned for signed URLs that were tampered with or have expired
+var ErrInvalidSignature = errors.New("invalid or expired signature")
+
 // NewAuther for JWT and blacklisting
 func NewAuther(jwtsecret string) *Auther {
 	result := &Auther{
 		TokenAuth: jwtauth.New("HS256", []byte(jwtsecret), []byte(jwtsecret)),
+		secret:    []byte(jwtsecret),
 	}
 	return result
 }
@@ -47,7 +54,7 @@ func (a *Auther) FromContext(ctx context.Context) (*db.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	u, err := db.FindUserG(null.Int64From(int64(id)))
+	u, err := db.FindUserG(int64(id))
 	if err != nil {
 		return nil, err
 	}
@@ -98,3 +105,27 @@ func (a *Auther) ValidatePassword(email string, password string) error {
 
 	return nil
 }
+
+// SignBlob returns the signature granting read access to the blob until the expiry
+func (a *Auther) SignBlob(fileName string, expires time.Time) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(fileName + ":" + strconv.FormatInt(expires.Unix(), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyBlobSignature checks a signature made by SignBlob, expires is the unix timestamp it was signed with
+func (a *Auther) VerifyBlobSignature(fileName, expires, signature string) error {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	expiresAt := time.Unix(unix, 0)
+	if time.Now().After(expiresAt) {
+		return ErrInvalidSignature
+	}
+	expected := a.SignBlob(fileName, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package accumulator
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+)
+
+const (
+	backupPrefix        = "accumulator-"
+	backupTimeFormat    = "20060102T150405.000000Z"
+	backupExtension     = ".db"
+	backupGzipSuffix    = ".gz"
+	backupEncryptSuffix = ".enc"
+)
+
+// ErrBackupUnsupported is returned for databases other than SQLite, use the database's own tools for those
+var ErrBackupUnsupported = errors.New("backups are only supported for sqlite3, use pg_dump for postgres")
+
+// BackupConfig for scheduled and manual backups
+type BackupConfig struct {
+	Dir string `default:"./backups"`
+	// IntervalMinutes between scheduled backups, 0 disables them
+	IntervalMinutes int  `default:"1440"`
+	Retain          int  `default:"7"`
+	Compress        bool `default:"true"`
+	Encrypt         bool `default:"false"`
+	// BeforeMigrate takes a backup before migrations change an existing schema
+	BeforeMigrate bool `default:"true"`
+}
+
+// sqlitePath is the file behind a SQLite URL, which may be a plain path or a file: URI with parameters
+func (c *DatabaseConfig) sqlitePath() string {
+	path := strings.TrimPrefix(c.URL, "file:")
+	return strings.SplitN(path, "?", 2)[0]
+}
+
+// openSQLiteConn opens a connection directly on the driver, the backup API is not reachable through database/sql
+func openSQLiteConn(dsn string) (*sqlite3.SQLiteConn, error) {
+	conn, err := (&sqlite3.SQLiteDriver{}).Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return conn.(*sqlite3.SQLiteConn), nil
+}
+
+// snapshot copies a consistent view of the live database to dest with the SQLite backup API.
+// Copying every page in one step holds a read transaction for the duration, which in WAL mode does not block writers.
+func snapshot(c *DatabaseConfig, dest string) error {
+	src, err := openSQLiteConn(c.dsn(true))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := openSQLiteConn(dest)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	backup, err := dst.Backup("main", src, "main")
+	if err != nil {
+		return err
+	}
+	done, err := backup.Step(-1)
+	if err != nil {
+		backup.Finish()
+		return err
+	}
+	if !done {
+		backup.Finish()
+		return errors.New("backup did not complete")
+	}
+	return backup.Finish()
+}
+
+// Backup the database into the backup directory, returning the path of the new backup.
+// The file is gzipped and then encrypted with the master key when configured to.
+func Backup(c *DatabaseConfig, bc *BackupConfig, d *Darer) (string, error) {
+	if c.Driver != driverSQLite {
+		return "", ErrBackupUnsupported
+	}
+	if bc.Encrypt && d == nil {
+		return "", errors.New("encrypted backups need the master key")
+	}
+	err := os.MkdirAll(bc.Dir, 0700)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := ioutil.TempFile(bc.Dir, ".snapshot-")
+	if err != nil {
+		return "", err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	err = snapshot(c, tmp.Name())
+	if err != nil {
+		return "", fmt.Errorf("snapshot: %w", err)
+	}
+
+	name := backupPrefix + time.Now().UTC().Format(backupTimeFormat) + backupExtension
+	if bc.Compress {
+		name += backupGzipSuffix
+	}
+	if bc.Encrypt {
+		name += backupEncryptSuffix
+	}
+	path := filepath.Join(bc.Dir, name)
+	err = writeBackup(tmp.Name(), path, bc, d)
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+func writeBackup(snapshotPath, path string, bc *BackupConfig, d *Darer) error {
+	in, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// writers are closed innermost first, so each flushes into the next
+	var w io.Writer = out
+	closers := []io.Closer{}
+	if bc.Encrypt {
+		enc, err := d.encryptWriter(w)
+		if err != nil {
+			return err
+		}
+		w = enc
+		closers = append([]io.Closer{enc}, closers...)
+	}
+	if bc.Compress {
+		gz := gzip.NewWriter(w)
+		w = gz
+		closers = append([]io.Closer{gz}, closers...)
+	}
+	_, err = io.Copy(w, in)
+	if err != nil {
+		return err
+	}
+	for _, closer := range closers {
+		err = closer.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return out.Sync()
+}
+
+// Restore replaces the database with the backup. The server must be stopped first.
+// The backup is decoded next to the database and checked for integrity and a schema version this build can run
+// before it is swapped in, the replaced database is kept alongside with a .pre-restore suffix.
+func Restore(c *DatabaseConfig, backupPath string, d *Darer) error {
+	if c.Driver != driverSQLite {
+		return ErrBackupUnsupported
+	}
+	dbPath := c.sqlitePath()
+	tmp, err := ioutil.TempFile(filepath.Dir(dbPath), ".restore-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	err = readBackup(backupPath, tmp, d)
+	tmp.Close()
+	if err != nil {
+		return fmt.Errorf("read backup: %w", err)
+	}
+	err = verifyBackup(tmp.Name(), c.Driver)
+	if err != nil {
+		return fmt.Errorf("verify backup: %w", err)
+	}
+
+	_, err = os.Stat(dbPath)
+	if err == nil {
+		aside := dbPath + ".pre-restore-" + time.Now().UTC().Format(backupTimeFormat)
+		err = os.Rename(dbPath, aside)
+		if err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	// a WAL left behind by the replaced database must not be applied to the restored one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		err = os.Remove(dbPath + suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), dbPath)
+}
+
+func readBackup(backupPath string, dst io.Writer, d *Darer) error {
+	in, err := os.Open(backupPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	var r io.Reader = in
+	if strings.HasSuffix(backupPath, backupEncryptSuffix) {
+		if d == nil {
+			return errors.New("encrypted backups need the master key")
+		}
+		r, err = d.decryptReader(r)
+		if err != nil {
+			return err
+		}
+	}
+	if strings.HasSuffix(strings.TrimSuffix(backupPath, backupEncryptSuffix), backupGzipSuffix) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	_, err = io.Copy(dst, r)
+	return err
+}
+
+func verifyBackup(path, driver string) error {
+	conn, err := sqlx.Connect(driverSQLite, path)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	var result string
+	err = conn.Get(&result, "PRAGMA integrity_check")
+	if err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("integrity check failed: %s", result)
+	}
+	expected, err := ExpectedVersion(driver)
+	if err != nil {
+		return err
+	}
+	current := struct {
+		Version uint64 `db:"version"`
+		Dirty   bool   `db:"dirty"`
+	}{}
+	err = conn.Get(&current, "SELECT version, dirty FROM schema_migrations LIMIT 1")
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	if current.Dirty {
+		return fmt.Errorf("schema version %d is dirty", current.Version)
+	}
+	// older backups are fine, admin db migrate brings them up to date
+	if uint(current.Version) > expected {
+		return fmt.Errorf("schema version %d is newer than this build's %d", current.Version, expected)
+	}
+	return nil
+}
+
+// pruneBackups removes all but the newest retain backups, the timestamp in the name orders them
+func pruneBackups(dir string, retain int) (int, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.Mode().IsRegular() && strings.HasPrefix(entry.Name(), backupPrefix) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	removed := 0
+	for i := retain; i < len(names); i++ {
+		err = os.Remove(filepath.Join(dir, names[i]))
+		if err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// BackupBeforeMigrate is the hook backing up the database before it is migrated.
+// Postgres is not backed up, use pg_dump before migrating it.
+func BackupBeforeMigrate(c *DatabaseConfig, bc *BackupConfig, d *Darer, log *zap.SugaredLogger) MigrateHook {
+	return func() error {
+		if !bc.BeforeMigrate {
+			return nil
+		}
+		if c.Driver != driverSQLite {
+			log.Warnw("not backing up before migrating, back up postgres with pg_dump", "driver", c.Driver)
+			return nil
+		}
+		path, err := Backup(c, bc, d)
+		if err != nil {
+			return fmt.Errorf("backup before migrating: %w", err)
+		}
+		log.Infow("backed up before migrating", "path", path)
+		return nil
+	}
+}
+
+// RunBackups takes a backup every interval and prunes old ones
+func RunBackups(ctx context.Context, c *DatabaseConfig, bc *BackupConfig, d *Darer, log *zap.SugaredLogger) error {
+	log.Infow("start backups", "dir", bc.Dir, "interval_minutes", bc.IntervalMinutes, "retain", bc.Retain)
+	t := time.NewTicker(time.Duration(bc.IntervalMinutes) * time.Minute)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			path, err := Backup(c, bc, d)
+			if err != nil {
+				log.Errorw("backup", "err", err)
+				continue
+			}
+			removed, err := pruneBackups(bc.Dir, bc.Retain)
+			if err != nil {
+				log.Errorw("prune backups", "err", err)
+			}
+			log.Infow("backed up database", "path", path, "pruned", removed)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"go.uber.org/zap"
+)
+
+// blobGCGracePeriod keeps freshly inserted blobs around long enough for the friend row referencing them to be written
+const blobGCGracePeriod = 10 * time.Minute
+
+// blobSignedURLLifetime is how long a signed blob URL stays valid
+const blobSignedURLLifetime = 24 * time.Hour
+
+var avatarClient = &http.Client{Timeout: 30 * time.Second}
+
+// errBlobForbidden is returned when the user has no friend referencing the blob
+var errBlobForbidden = errors.New("blob is not used by any of your integrations")
+
+// blobKey is the content address of a blob, the hex encoded SHA-256 of its bytes
+func blobKey(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// refreshAvatar downloads the friend's thumbnail into a blob and points the friend at it.
+// The download is skipped when the thumbnail URL has not changed since the last fetch, and a conditional request
+// is made when the server gave us validators for it. Returns the changed columns, if any.
+func refreshAvatar(blobs *BlobStorage, friend *db.Friend, thumbnailURL string) ([]string, error) {
+	unchanged := friend.AvatarBlobFilename.Valid && friend.AvatarSourceURL.String == thumbnailURL
+	hasValidators := friend.AvatarEtag.Valid || friend.AvatarLastModified.Valid
+	if thumbnailURL == "" || (unchanged && !hasValidators) {
+		return nil, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, thumbnailURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged {
+		if friend.AvatarEtag.Valid {
+			req.Header.Set("If-None-Match", friend.AvatarEtag.String)
+		}
+		if friend.AvatarLastModified.Valid {
+			req.Header.Set("If-Modified-Since", friend.AvatarLastModified.String)
+		}
+	}
+	resp, err := avatarClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch avatar: non 200 response: %d", resp.StatusCode)
+	}
+	// one byte over the limit is enough for decodeImage to reject it, without reading the rest
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	blob, err := blobs.PutImage(b)
+	if err != nil {
+		return nil, err
+	}
+
+	friend.AvatarBlobFilename = null.StringFrom(blob.FileName)
+	friend.AvatarSourceURL = null.StringFrom(thumbnailURL)
+	friend.AvatarEtag = null.NewString(resp.Header.Get("ETag"), resp.Header.Get("ETag") != "")
+	friend.AvatarLastModified = null.NewString(resp.Header.Get("Last-Modified"), resp.Header.Get("Last-Modified") != "")
+	return []string{
+		db.FriendColumns.AvatarBlobFilename,
+		db.FriendColumns.AvatarSourceURL,
+		db.FriendColumns.AvatarEtag,
+		db.FriendColumns.AvatarLastModified,
+	}, nil
+}
+
+// canReadBlob allows admins, and users owning an integration with a friend referencing the blob
+func canReadBlob(fileName string, u *db.User) error {
+	if u.Role == roleAdmin {
+		return nil
+	}
+	exists, err := db.Friends(
+		qm.InnerJoin("integrations on integrations.id = friends.integration_id"),
+		db.FriendWhere.AvatarBlobFilename.EQ(null.StringFrom(fileName)),
+		qm.Where("integrations.user_id = ?", u.ID),
+	).ExistsG()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errBlobForbidden
+	}
+	return nil
+}
+
+// countBlobView increments the view counter in the database so concurrent requests are not lost
+func countBlobView(fileName string) error {
+	_, err := boil.GetDB().Exec("UPDATE blobs SET views = COALESCE(views, 0) + 1 WHERE file_name = ?", fileName)
+	return err
+}
+
+// blobNotModified evaluates the conditional request headers against the blob's validators
+func blobNotModified(r *http.Request, etag string, modtime time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		t, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !modtime.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// unreferencedBlob is true for blobs no friend's avatar_blob_filename points at, that aren't a rendition of another blob
+// and haven't been stored or reused since olderThan
+func unreferencedBlob(olderThan time.Time) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("NOT EXISTS (SELECT 1 FROM friends WHERE friends.avatar_blob_filename = blobs.file_name)"),
+		qm.Where("NOT EXISTS (SELECT 1 FROM blob_renditions WHERE blob_renditions.blob_file_name = blobs.file_name)"),
+		db.BlobWhere.UpdatedAt.LT(olderThan),
+	}
+}
+
+// collectBlobGarbage deletes blobs with a reference count of zero. Renditions of a deleted blob are collected on the next run.
+// The conditions are checked again by the statement deleting each blob, so one referenced or reused since they were
+// listed is kept.
+func collectBlobGarbage(blobs *BlobStorage, olderThan time.Time) (int, error) {
+	unreferenced, err := db.Blobs(append(unreferencedBlob(olderThan), qm.Select(blobMetadataColumns...))...).AllG()
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, blob := range unreferenced {
+		ok, err := blobs.deleteUnreferenced(blob, olderThan)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// RunBlobGarbageCollector periodically removes unreferenced blobs
+func RunBlobGarbageCollector(ctx context.Context, blobs *BlobStorage, intervalMinutes int, log *zap.SugaredLogger) error {
+	log.Infow("start blob garbage collector")
+	t := time.NewTicker(time.Duration(intervalMinutes) * time.Minute)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			deleted, err := collectBlobGarbage(blobs, time.Now().UTC().Add(-blobGCGracePeriod))
+			if err != nil {
+				log.Errorw(err.Error())
+				continue
+			}
+			log.Infow("collected blob garbage", "deleted", deleted)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	blobBackendSQLite     = "sqlite"
+	blobBackendFilesystem = "filesystem"
+	blobBackendS3         = "s3"
+)
+
+// ErrBlobNotFound is returned by a BlobStore when it holds no content for a key
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore holds the contents of blobs, keyed by the blob's file name.
+// Blob metadata always lives in the blobs table, only the bytes move between stores.
+type BlobStore interface {
+	// Name is the value stored in blobs.backend for blobs written to this store
+	Name() string
+	Put(key string, r io.Reader, size int64) error
+	// Open the content for reading, the returned reader also implements io.Seeker when the store supports it
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+// BlobStoreConfig selects and configures the blob storage backends
+type BlobStoreConfig struct {
+	Backend     string `default:"sqlite"`
+	Path        string `default:"./blobs"`
+	S3Endpoint  string `default:"http://localhost:9000"`
+	S3Region    string `default:"us-east-1"`
+	S3Bucket    string `default:"accumulator"`
+	S3AccessKey string
+	S3SecretKey string
+}
+
+// NewBlobStore for the named backend
+func NewBlobStore(backend string, c *BlobStoreConfig) (BlobStore, error) {
+	switch backend {
+	case blobBackendSQLite:
+		return &SQLiteBlobStore{}, nil
+	case blobBackendFilesystem:
+		return &FilesystemBlobStore{Root: c.Path}, nil
+	case blobBackendS3:
+		return NewS3BlobStore(c.S3Endpoint, c.S3Region, c.S3Bucket, c.S3AccessKey, c.S3SecretKey), nil
+	}
+	return nil, fmt.Errorf("unknown blob backend: %s", backend)
+}
+
+// BlobStorage writes new blobs to the configured store and reads existing blobs from whichever store they were written to
+type BlobStorage struct {
+	config  *BlobStoreConfig
+	current BlobStore
+
+	mu     sync.Mutex
+	stores map[string]BlobStore
+}
+
+// NewBlobStorage using the configured backend for new blobs
+func NewBlobStorage(c *BlobStoreConfig) (*BlobStorage, error) {
+	current, err := NewBlobStore(c.Backend, c)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobStorage{
+		config:  c,
+		current: current,
+		stores:  map[string]BlobStore{current.Name(): current},
+	}, nil
+}
+
+func (s *BlobStorage) store(backend string) (BlobStore, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	store, ok := s.stores[backend]
+	if ok {
+		return store, nil
+	}
+	store, err := NewBlobStore(backend, s.config)
+	if err != nil {
+		return nil, err
+	}
+	s.stores[backend] = store
+	return store, nil
+}
+
+// blobMetadataColumns are all blob columns except the file contents
+var blobMetadataColumns = []string{
+	db.BlobColumns.ID,
+	db.BlobColumns.FileName,
+	db.BlobColumns.MimeType,
+	db.BlobColumns.FileSizeBytes,
+	db.BlobColumns.EXTENSION,
+	db.BlobColumns.Views,
+	db.BlobColumns.Backend,
+	db.BlobColumns.Archived,
+	db.BlobColumns.ArchivedAt,
+	db.BlobColumns.UpdatedAt,
+	db.BlobColumns.CreatedAt,
+}
+
+// Find the blob's metadata without loading its contents
+func (s *BlobStorage) Find(fileName string) (*db.Blob, error) {
+	return db.Blobs(
+		qm.Select(blobMetadataColumns...),
+		db.BlobWhere.FileName.EQ(fileName),
+	).OneG()
+}
+
+// Put the bytes as a content addressed blob, reusing the existing blob if the content is already stored
+func (s *BlobStorage) Put(b []byte, mimeType, extension string) (*db.Blob, error) {
+	key := blobKey(b)
+	existing, err := s.Find(key)
+	if err == nil {
+		// reusing the blob keeps it from the garbage collector for its grace period, like a new one
+		existing.UpdatedAt = time.Now().UTC()
+		_, err = existing.UpdateG(boil.Whitelist(db.BlobColumns.UpdatedAt))
+		if err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+	blob := &db.Blob{
+		FileName:      key,
+		MimeType:      mimeType,
+		FileSizeBytes: int64(len(b)),
+		EXTENSION:     extension,
+		File:          []byte{},
+		Backend:       s.current.Name(),
+	}
+	err = blob.InsertG(boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	err = s.current.Put(key, bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		_, delErr := db.Blobs(db.BlobWhere.FileName.EQ(key)).DeleteAll(boil.GetDB())
+		if delErr != nil {
+			return nil, fmt.Errorf("put blob: %v (cleanup: %v)", err, delErr)
+		}
+		return nil, fmt.Errorf("put blob: %w", err)
+	}
+	return blob, nil
+}
+
+// Open the blob's contents from the store it was written to
+func (s *BlobStorage) Open(blob *db.Blob) (io.ReadCloser, error) {
+	store, err := s.store(blob.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return store.Open(blob.FileName)
+}
+
+// deleteUnreferenced deletes the blob's metadata if it is still unreferenced, then its contents, returning whether it did.
+// Its renditions are released and left for the garbage collector.
+func (s *BlobStorage) deleteUnreferenced(blob *db.Blob, olderThan time.Time) (bool, error) {
+	store, err := s.store(blob.Backend)
+	if err != nil {
+		return false, err
+	}
+	n, err := db.Blobs(append(unreferencedBlob(olderThan), db.BlobWhere.FileName.EQ(blob.FileName))...).DeleteAll(boil.GetDB())
+	if err != nil || n == 0 {
+		return false, err
+	}
+	_, err = db.BlobRenditions(db.BlobRenditionWhere.ParentFileName.EQ(blob.FileName)).DeleteAll(boil.GetDB())
+	if err != nil {
+		return true, err
+	}
+	err = store.Delete(blob.FileName)
+	if err != nil && err != ErrBlobNotFound {
+		return true, err
+	}
+	return true, nil
+}
+
+// Migrate copies every blob not yet in the target backend into it, then removes the original contents
+func (s *BlobStorage) Migrate(backend string, log *zap.SugaredLogger) (int, error) {
+	target, err := s.store(backend)
+	if err != nil {
+		return 0, err
+	}
+	blobs, err := db.Blobs(
+		qm.Select(blobMetadataColumns...),
+		db.BlobWhere.Backend.NEQ(target.Name()),
+	).AllG()
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, blob := range blobs {
+		err = s.move(blob, target)
+		if err != nil {
+			return moved, fmt.Errorf("migrate blob %s: %w", blob.FileName, err)
+		}
+		moved++
+		log.Infow("migrated blob", "file_name", blob.FileName, "from", blob.Backend, "to", target.Name())
+	}
+	return moved, nil
+}
+
+func (s *BlobStorage) move(blob *db.Blob, target BlobStore) error {
+	source, err := s.store(blob.Backend)
+	if err != nil {
+		return err
+	}
+	rdr, err := source.Open(blob.FileName)
+	if err != nil {
+		return err
+	}
+	defer rdr.Close()
+	err = target.Put(blob.FileName, rdr, blob.FileSizeBytes)
+	if err != nil {
+		return err
+	}
+	_, err = db.Blobs(db.BlobWhere.FileName.EQ(blob.FileName)).UpdateAll(boil.GetDB(), db.M{
+		db.BlobColumns.Backend: target.Name(),
+	})
+	if err != nil {
+		return err
+	}
+	err = source.Delete(blob.FileName)
+	if err != nil && err != ErrBlobNotFound {
+		return err
+	}
+	return nil
+}
+
+// SQLiteBlobStore keeps blob contents in the blobs.file column, of whichever database is configured
+type SQLiteBlobStore struct{}
+
+// Name of the backend
+func (s *SQLiteBlobStore) Name() string {
+	return blobBackendSQLite
+}
+
+// Put the contents into the existing blob row
+func (s *SQLiteBlobStore) Put(key string, r io.Reader, size int64) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	updated, err := db.Blobs(db.BlobWhere.FileName.EQ(key)).UpdateAll(boil.GetDB(), db.M{
+		db.BlobColumns.File: b,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrBlobNotFound
+	}
+	return nil
+}
+
+// Open loads the whole column, SQLite gives no streaming access to it
+func (s *SQLiteBlobStore) Open(key string) (io.ReadCloser, error) {
+	blob, err := db.Blobs(
+		qm.Select(db.BlobColumns.File),
+		db.BlobWhere.FileName.EQ(key),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nopSeekCloser{bytes.NewReader(blob.File)}, nil
+}
+
+// Delete clears the column, the row itself is metadata
+func (s *SQLiteBlobStore) Delete(key string) error {
+	_, err := db.Blobs(db.BlobWhere.FileName.EQ(key)).UpdateAll(boil.GetDB(), db.M{
+		db.BlobColumns.File: []byte{},
+	})
+	return err
+}
+
+type nopSeekCloser struct {
+	*bytes.Reader
+}
+
+func (n *nopSeekCloser) Close() error {
+	return nil
+}
+
+// FilesystemBlobStore keeps blob contents in a directory tree, sharded on the first characters of the key
+type FilesystemBlobStore struct {
+	Root string
+}
+
+// Name of the backend
+func (s *FilesystemBlobStore) Name() string {
+	return blobBackendFilesystem
+}
+
+func (s *FilesystemBlobStore) path(key string) string {
+	if len(key) < 4 {
+		return filepath.Join(s.Root, key)
+	}
+	return filepath.Join(s.Root, key[0:2], key[2:4], key)
+}
+
+// Put writes to a temporary file first so readers never see partial content
+func (s *FilesystemBlobStore) Put(key string, r io.Reader, size int64) error {
+	p := s.path(key)
+	err := os.MkdirAll(filepath.Dir(p), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(p), ".tmp-"+key)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+// Open the file, *os.File is seekable
+func (s *FilesystemBlobStore) Open(key string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Delete the file
+func (s *FilesystemBlobStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return ErrBlobNotFound
+	}
+	return err
+}
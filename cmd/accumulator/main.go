@@ -7,33 +7,25 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/jmoiron/sqlx"
-	_ "github.com/mattn/go-sqlite3"
-
 	"github.com/kelseyhightower/envconfig"
 	"github.com/oklog/run"
-	"github.com/volatiletech/sqlboiler/boil"
 )
 
-func connect() (*sqlx.DB, error) {
-	conn, err := sqlx.Connect("sqlite3", "./accumulator.db")
-	if err != nil {
-		return nil, err
-	}
-	return conn, nil
-}
-
 type Config struct {
 	MasterKey        string `default:"9A1F3DE2BB279CB966CC1167BC6C538FDE97268E3EE5F581D918309409520AE3"`
 	JWTSecret        string `default:"contractible-roasted-mollusk"`
 	StepMinutes      int    `default:"5"`
+	BlobGCMinutes    int    `default:"60"`
 	RootPath         string `default:"./web/dist"`
 	ServerAddr       string `default:":8081"`
 	LoadBalancerAddr string `default:":8080"`
+	Database         accumulator.DatabaseConfig
+	Blob             accumulator.BlobStoreConfig
+	Backup           accumulator.BackupConfig
+	Webhook          accumulator.WebhookConfig
 }
 
 func main() {
-	dbseed := flag.Bool("db-seed", false, "Seed fake data")
 	showConfig := flag.Bool("config", false, "Show config variables")
 
 	c := &Config{}
@@ -42,26 +34,40 @@ func main() {
 		log.Fatal(err.Error())
 	}
 	flag.Parse()
-	conn, err := connect()
+	conn, err := accumulator.Connect(&c.Database)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	boil.SetDB(conn)
 	if *showConfig {
 		envconfig.Usage("ACCUMULATOR", c)
 		return
 	}
-	if *dbseed {
-		fmt.Println("Seeding accumulator system...")
-		err = accumulator.Seed(c.MasterKey)
+	if c.Database.AutoMigrate {
+		d, err := accumulator.NewDarer(c.MasterKey)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		err = accumulator.Migrate(&c.Database, accumulator.BackupBeforeMigrate(&c.Database, &c.Backup, d, accumulator.NewLogToStdOut("migrate", "0.0.1", false)))
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
+	}
+	err = accumulator.CheckVersion(conn)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	blobs, err := accumulator.NewBlobStorage(&c.Blob)
+	if err != nil {
+		fmt.Println(err)
 		return
 	}
 
+	hub := accumulator.NewHub()
+
 	fmt.Println("Booting up accumulator system...")
 	g := &run.Group{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -70,7 +76,7 @@ func main() {
 		if err != nil {
 			return err
 		}
-		return accumulator.RunServer(ctx, conn, c.ServerAddr, c.JWTSecret, d, accumulator.NewLogToStdOut("server", "0.0.1", false))
+		return accumulator.RunServer(ctx, conn, c.ServerAddr, c.JWTSecret, d, blobs, hub, c.StepMinutes, accumulator.NewLogToStdOut("server", "0.0.1", false))
 	}, func(err error) {
 		fmt.Println(err)
 		cancel()
@@ -86,10 +92,44 @@ func main() {
 		if err != nil {
 			return err
 		}
-		return accumulator.RunAttendanceTracker(ctx, d, c.StepMinutes, accumulator.NewLogToStdOut("attendance", "0.0.1", false))
+		return accumulator.RunAttendanceTracker(ctx, d, blobs, hub, c.StepMinutes, accumulator.NewLogToStdOut("attendance", "0.0.1", false))
 	}, func(err error) {
 		fmt.Println(err)
 		cancel()
 	})
+	g.Add(func() error {
+		d, err := accumulator.NewDarer(c.MasterKey)
+		if err != nil {
+			return err
+		}
+		return accumulator.RunWebhookDeliveries(ctx, &c.Webhook, d, accumulator.NewLogToStdOut("webhooks", "0.0.1", false))
+	}, func(err error) {
+		fmt.Println(err)
+		cancel()
+	})
+	g.Add(func() error {
+		return accumulator.RunReprocessJobs(ctx, c.StepMinutes, accumulator.NewLogToStdOut("reprocess", "0.0.1", false))
+	}, func(err error) {
+		fmt.Println(err)
+		cancel()
+	})
+	g.Add(func() error {
+		return accumulator.RunBlobGarbageCollector(ctx, blobs, c.BlobGCMinutes, accumulator.NewLogToStdOut("blob-gc", "0.0.1", false))
+	}, func(err error) {
+		fmt.Println(err)
+		cancel()
+	})
+	if c.Backup.IntervalMinutes > 0 {
+		g.Add(func() error {
+			d, err := accumulator.NewDarer(c.MasterKey)
+			if err != nil {
+				return err
+			}
+			return accumulator.RunBackups(ctx, &c.Database, &c.Backup, d, accumulator.NewLogToStdOut("backup", "0.0.1", false))
+		}, func(err error) {
+			fmt.Println(err)
+			cancel()
+		})
+	}
 	log.Fatalln(g.Run())
 }
@@ -0,0 +1,587 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"accumulator"
+	"accumulator/db"
+
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+var commands = []*command{
+	{group: "db", name: "version", usage: "Print the schema version", flags: noFlags(dbVersion)},
+	{group: "db", name: "migrate", usage: "Migrate to the latest version", flags: noFlags(dbMigrate)},
+	{group: "db", name: "up", args: []string{"N"}, usage: "Apply the next N migrations", flags: noFlags(dbSteps(1))},
+	{group: "db", name: "down", args: []string{"N"}, usage: "Revert the newest N migrations", flags: noFlags(dbSteps(-1))},
+	{group: "db", name: "goto", args: []string{"VERSION"}, usage: "Migrate up or down to the version", flags: noFlags(dbGoto)},
+	{group: "db", name: "force", args: []string{"VERSION"}, usage: "Set the version and clear the dirty flag after fixing a failed migration by hand", flags: noFlags(dbForce)},
+	{group: "db", name: "drop", usage: "Drop everything in the database", flags: noFlags(dbDrop)},
+	{group: "db", name: "seed", usage: "Fill the database with generated users, friends and class attendance", models: true, flags: dbSeed},
+	{group: "db", name: "backup", usage: "Back up the database into the backup directory", flags: noFlags(dbBackup)},
+	{group: "db", name: "restore", args: []string{"PATH"}, usage: "Replace the database with a backup, stop the server first", flags: noFlags(dbRestore)},
+
+	{group: "blob", name: "migrate", args: []string{"BACKEND"}, usage: "Move all blobs to the backend (sqlite, filesystem, s3)", models: true, flags: noFlags(blobMigrate)},
+
+	{group: "user", name: "list", usage: "List users", models: true, flags: noFlags(userList)},
+	{group: "user", name: "show", args: []string{"EMAIL"}, usage: "Show a user and their integrations", models: true, flags: noFlags(userShow)},
+	{group: "user", name: "create", args: []string{"EMAIL"}, usage: "Create a user, a password is generated unless one is given", models: true, flags: userCreate},
+	{group: "user", name: "role", args: []string{"EMAIL", "ROLE"}, usage: "Set the role of a user (admin, user)", models: true, flags: noFlags(userRole)},
+	{group: "user", name: "password", args: []string{"EMAIL"}, usage: "Reset the password of a user, a password is generated unless one is given", models: true, flags: userPassword},
+
+	{group: "integration", name: "list", usage: "List integrations with counts of their friends and attendance", models: true, flags: integrationList},
+	{group: "integration", name: "show", args: []string{"ID"}, usage: "Show an integration with counts of its friends and attendance", models: true, flags: noFlags(integrationShow)},
+	{group: "integration", name: "refresh", args: []string{"ID"}, usage: "Refresh the friends and avatars of an integration from VRChat", models: true, flags: noFlags(integrationRefresh)},
+	{group: "integration", name: "reprocess", args: []string{"ID"}, usage: "Evaluate the classes of an integration again with its schedules, rosters and policy of now", models: true, flags: integrationReprocess},
+
+	{group: "friend", name: "list", args: []string{"INTEGRATION_ID"}, usage: "List the friends of an integration", models: true, flags: friendList},
+	{group: "friend", name: "promote", args: []string{"INTEGRATION_ID", "VRCHAT_ID"}, usage: "Make a friend a teacher", models: true, flags: noFlags(friendSetTeacher(true))},
+	{group: "friend", name: "demote", args: []string{"INTEGRATION_ID", "VRCHAT_ID"}, usage: "Make a teacher a student", models: true, flags: noFlags(friendSetTeacher(false))},
+}
+
+func noFlags(run func(a *app, args []string) error) func(fs *flag.FlagSet) func(a *app, args []string) error {
+	return func(fs *flag.FlagSet) func(a *app, args []string) error {
+		return run
+	}
+}
+
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %s", s)
+	}
+	return id, nil
+}
+
+type status struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
+
+func (a *app) done(format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	return a.output(&status{true, message}, func(w io.Writer) {
+		fmt.Fprintln(w, message)
+	})
+}
+
+func dbVersion(a *app, args []string) error {
+	v, dirty, err := accumulator.Version(&a.config.Database)
+	if err != nil {
+		return err
+	}
+	expected, err := accumulator.ExpectedVersion(a.config.Database.Driver)
+	if err != nil {
+		return err
+	}
+	result := struct {
+		Version  uint `json:"version"`
+		Dirty    bool `json:"dirty"`
+		Expected uint `json:"expected"`
+	}{v, dirty, expected}
+	return a.output(result, func(w io.Writer) {
+		fmt.Fprintf(w, "Version: %d, Dirty: %v, Expected: %d\n", v, dirty, expected)
+	})
+}
+
+// beforeMigrate backs up the database before migrations change it, unless ACCUMULATOR_BACKUP_BEFOREMIGRATE is false
+func (a *app) beforeMigrate() (accumulator.MigrateHook, error) {
+	d, err := accumulator.NewDarer(a.config.MasterKey)
+	if err != nil {
+		return nil, err
+	}
+	return accumulator.BackupBeforeMigrate(&a.config.Database, &a.config.Backup, d, accumulator.NewLogToStdOut("admin", "0.0.1", false)), nil
+}
+
+func dbMigrate(a *app, args []string) error {
+	hook, err := a.beforeMigrate()
+	if err != nil {
+		return err
+	}
+	err = accumulator.Migrate(&a.config.Database, hook)
+	if err != nil {
+		return err
+	}
+	return a.done("Migrated")
+}
+
+func dbSteps(direction int) func(a *app, args []string) error {
+	return func(a *app, args []string) error {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid number of migrations %s", args[0])
+		}
+		if direction < 0 {
+			err = a.confirm("Revert %d migrations? Data in the reverted tables and columns is lost.", n)
+			if err != nil {
+				return err
+			}
+		}
+		hook, err := a.beforeMigrate()
+		if err != nil {
+			return err
+		}
+		err = accumulator.MigrateSteps(&a.config.Database, direction*n, hook)
+		if err != nil {
+			return err
+		}
+		if direction < 0 {
+			return a.done("Reverted %d migrations", n)
+		}
+		return a.done("Applied %d migrations", n)
+	}
+}
+
+func parseVersion(s string) (uint, error) {
+	version, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %s", s)
+	}
+	return uint(version), nil
+}
+
+func dbGoto(a *app, args []string) error {
+	version, err := parseVersion(args[0])
+	if err != nil {
+		return err
+	}
+	err = a.confirm("Migrate to version %d? Data in reverted tables and columns is lost.", version)
+	if err != nil {
+		return err
+	}
+	hook, err := a.beforeMigrate()
+	if err != nil {
+		return err
+	}
+	err = accumulator.MigrateTo(&a.config.Database, version, hook)
+	if err != nil {
+		return err
+	}
+	return a.done("Migrated to version %d", version)
+}
+
+func dbForce(a *app, args []string) error {
+	version, err := parseVersion(args[0])
+	if err != nil {
+		return err
+	}
+	err = a.confirm("Mark the schema as clean at version %d? Only do this once the schema matches that version.", version)
+	if err != nil {
+		return err
+	}
+	err = accumulator.Force(&a.config.Database, version)
+	if err != nil {
+		return err
+	}
+	return a.done("Forced version %d", version)
+}
+
+func dbDrop(a *app, args []string) error {
+	err := a.confirm("Drop everything in %s?", a.config.Database.URL)
+	if err != nil {
+		return err
+	}
+	hook, err := a.beforeMigrate()
+	if err != nil {
+		return err
+	}
+	err = accumulator.Drop(&a.config.Database, hook)
+	if err != nil {
+		return err
+	}
+	return a.done("Dropped")
+}
+
+func dbSeed(fs *flag.FlagSet) func(a *app, args []string) error {
+	c := &accumulator.SeedConfig{}
+	fs.Int64Var(&c.Seed, "seed", 1, "Seed of the generator, the same seed and end date generate the same data")
+	fs.IntVar(&c.Users, "users", 3, "Users, including the admin")
+	fs.IntVar(&c.IntegrationsPerUser, "integrations", 1, "Integrations per user")
+	fs.IntVar(&c.Friends, "friends", 12, "Friends per integration, including teachers")
+	fs.IntVar(&c.Teachers, "teachers", 2, "Teachers per integration")
+	fs.IntVar(&c.Days, "days", 28, "Days of class history to simulate")
+	fs.IntVar(&c.StepMinutes, "step-minutes", 5, "Minutes between attendance records, like ACCUMULATOR_STEPMINUTES")
+	end := fs.String("end", "", "Date the history ends on as YYYY-MM-DD, today when empty")
+	fs.StringVar(&c.AdminEmail, "admin-email", "admin@example.com", "Email of the admin user")
+	password := passwordFlag(fs)
+	return func(a *app, args []string) error {
+		c.End = time.Now()
+		if *end != "" {
+			var err error
+			c.End, err = time.Parse("2006-01-02", *end)
+			if err != nil {
+				return fmt.Errorf("invalid end date %s", *end)
+			}
+		}
+		pw, generated, err := passwordOrRandom(*password)
+		if err != nil {
+			return err
+		}
+		c.AdminPassword = pw
+		blobs, err := accumulator.NewBlobStorage(&a.config.Blob)
+		if err != nil {
+			return err
+		}
+		err = accumulator.Seed(c, a.config.MasterKey, blobs)
+		if err != nil {
+			return err
+		}
+		admin, err := accumulator.FindUserByEmail(c.AdminEmail)
+		if err != nil {
+			return err
+		}
+		return printUserPassword(a, "Seeded, sign in as", admin, pw, generated)
+	}
+}
+
+func dbBackup(a *app, args []string) error {
+	d, err := accumulator.NewDarer(a.config.MasterKey)
+	if err != nil {
+		return err
+	}
+	path, err := accumulator.Backup(&a.config.Database, &a.config.Backup, d)
+	if err != nil {
+		return err
+	}
+	result := struct {
+		Path string `json:"path"`
+	}{path}
+	return a.output(result, func(w io.Writer) {
+		fmt.Fprintf(w, "Backed up to %s\n", path)
+	})
+}
+
+func dbRestore(a *app, args []string) error {
+	err := a.confirm("Replace %s with %s? The server must be stopped.", a.config.Database.URL, args[0])
+	if err != nil {
+		return err
+	}
+	d, err := accumulator.NewDarer(a.config.MasterKey)
+	if err != nil {
+		return err
+	}
+	err = accumulator.Restore(&a.config.Database, args[0], d)
+	if err != nil {
+		return err
+	}
+	return a.done("Restored from %s", args[0])
+}
+
+func blobMigrate(a *app, args []string) error {
+	blobs, err := accumulator.NewBlobStorage(&a.config.Blob)
+	if err != nil {
+		return err
+	}
+	moved, err := blobs.Migrate(args[0], accumulator.NewLogToStdOut("admin", "0.0.1", false))
+	if err != nil {
+		return err
+	}
+	return a.done("Migrated %d blobs", moved)
+}
+
+// withoutPassword keeps password hashes out of the output
+func withoutPassword(u *db.User) *db.User {
+	result := *u
+	result.PasswordHash = ""
+	return &result
+}
+
+func userList(a *app, args []string) error {
+	users, err := db.Users(qm.OrderBy(db.UserColumns.ID)).AllG()
+	if err != nil {
+		return err
+	}
+	result := db.UserSlice{}
+	for _, u := range users {
+		result = append(result, withoutPassword(u))
+	}
+	return a.output(result, func(w io.Writer) {
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tEMAIL\tROLE\tARCHIVED\tCREATED")
+		for _, u := range result {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%v\t%s\n", u.ID, u.Email, u.Role, u.Archived, u.CreatedAt.Format("2006-01-02"))
+		}
+		tw.Flush()
+	})
+}
+
+func userShow(a *app, args []string) error {
+	u, err := accumulator.FindUserByEmail(args[0])
+	if err != nil {
+		return err
+	}
+	integrations, err := accumulator.ListIntegrations(u.ID)
+	if err != nil {
+		return err
+	}
+	result := struct {
+		User         *db.User                         `json:"user"`
+		Integrations []*accumulator.IntegrationDetail `json:"integrations"`
+	}{withoutPassword(u), integrations}
+	return a.output(result, func(w io.Writer) {
+		fmt.Fprintf(w, "ID:       %d\nEmail:    %s\nRole:     %s\nArchived: %v\nCreated:  %s\n\n", u.ID, u.Email, u.Role, u.Archived, u.CreatedAt.Format("2006-01-02 15:04:05"))
+		printIntegrations(w, integrations)
+	})
+}
+
+// passwordFlag is shared by the commands that set a password
+func passwordFlag(fs *flag.FlagSet) *string {
+	return fs.String("password", "", "Password to set, a random one is generated and printed when empty")
+}
+
+func passwordOrRandom(password string) (string, bool, error) {
+	if password != "" {
+		return password, false, nil
+	}
+	password, err := accumulator.RandomPassword()
+	return password, true, err
+}
+
+type userPasswordResult struct {
+	User     *db.User `json:"user"`
+	Password string   `json:"password,omitempty"`
+}
+
+func printUserPassword(a *app, verb string, u *db.User, password string, generated bool) error {
+	result := &userPasswordResult{User: withoutPassword(u)}
+	if generated {
+		result.Password = password
+	}
+	return a.output(result, func(w io.Writer) {
+		fmt.Fprintf(w, "%s %s (id %d, role %s)\n", verb, u.Email, u.ID, u.Role)
+		if generated {
+			fmt.Fprintf(w, "Password: %s\n", password)
+		}
+	})
+}
+
+func userCreate(fs *flag.FlagSet) func(a *app, args []string) error {
+	role := fs.String("role", "user", "Role of the user (admin, user)")
+	password := passwordFlag(fs)
+	return func(a *app, args []string) error {
+		pw, generated, err := passwordOrRandom(*password)
+		if err != nil {
+			return err
+		}
+		u, err := accumulator.CreateUser(args[0], pw, *role)
+		if err != nil {
+			return err
+		}
+		return printUserPassword(a, "Created", u, pw, generated)
+	}
+}
+
+func userRole(a *app, args []string) error {
+	u, err := accumulator.SetRole(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return a.output(withoutPassword(u), func(w io.Writer) {
+		fmt.Fprintf(w, "%s is now %s\n", u.Email, u.Role)
+	})
+}
+
+func userPassword(fs *flag.FlagSet) func(a *app, args []string) error {
+	password := passwordFlag(fs)
+	return func(a *app, args []string) error {
+		pw, generated, err := passwordOrRandom(*password)
+		if err != nil {
+			return err
+		}
+		u, err := accumulator.SetPassword(args[0], pw)
+		if err != nil {
+			return err
+		}
+		return printUserPassword(a, "Reset the password of", u, pw, generated)
+	}
+}
+
+func printIntegrations(w io.Writer, integrations []*accumulator.IntegrationDetail) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSERNAME\tOWNER\tFRIENDS\tTEACHERS\tARCHIVED FRIENDS\tATTENDANCE\tLAST ATTENDANCE")
+	for _, i := range integrations {
+		last := "never"
+		if i.LastAttendance.Valid {
+			last = i.LastAttendance.Time.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n", i.ID, i.Username, i.UserEmail, i.Friends, i.Teachers, i.ArchivedFriends, i.Attendance, last)
+	}
+	tw.Flush()
+}
+
+func integrationList(fs *flag.FlagSet) func(a *app, args []string) error {
+	email := fs.String("user", "", "Only list the integrations of the user with this email")
+	return func(a *app, args []string) error {
+		var userID int64
+		if *email != "" {
+			u, err := accumulator.FindUserByEmail(*email)
+			if err != nil {
+				return err
+			}
+			userID = u.ID
+		}
+		integrations, err := accumulator.ListIntegrations(userID)
+		if err != nil {
+			return err
+		}
+		return a.output(integrations, func(w io.Writer) {
+			printIntegrations(w, integrations)
+		})
+	}
+}
+
+func integrationShow(a *app, args []string) error {
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	detail, err := accumulator.InspectIntegration(id)
+	if err != nil {
+		return err
+	}
+	return a.output(detail, func(w io.Writer) {
+		printIntegrations(w, []*accumulator.IntegrationDetail{detail})
+	})
+}
+
+func integrationRefresh(a *app, args []string) error {
+	id, err := parseID(args[0])
+	if err != nil {
+		return err
+	}
+	d, err := accumulator.NewDarer(a.config.MasterKey)
+	if err != nil {
+		return err
+	}
+	blobs, err := accumulator.NewBlobStorage(&a.config.Blob)
+	if err != nil {
+		return err
+	}
+	err = accumulator.RefreshFriends(d, blobs, id, accumulator.NewLogToStdOut("admin", "0.0.1", false))
+	if err != nil {
+		return err
+	}
+	return a.done("Refreshed the friends of integration %d", id)
+}
+
+// parseTime of a reprocess flag, a date or an RFC 3339 time
+func parseTime(name, s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %s, want YYYY-MM-DD or an RFC 3339 time", name, s)
+	}
+	return t, nil
+}
+
+func integrationReprocess(fs *flag.FlagSet) func(a *app, args []string) error {
+	from := fs.String("from", "", "Date or RFC 3339 time the classes end from, 30 days ago when empty")
+	to := fs.String("to", "", "Date or RFC 3339 time the classes end until, now when empty")
+	queue := fs.Bool("queue", false, "Queue the job for the server to run in the background instead of running it now")
+	return func(a *app, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		start, end := time.Now().AddDate(0, 0, -30), time.Now()
+		if *from != "" {
+			start, err = parseTime("from", *from)
+			if err != nil {
+				return err
+			}
+		}
+		if *to != "" {
+			end, err = parseTime("to", *to)
+			if err != nil {
+				return err
+			}
+		}
+		if _, err := db.FindIntegrationG(id); err != nil {
+			return fmt.Errorf("integration %d: %w", id, err)
+		}
+		job, err := accumulator.QueueReprocess(id, nil, start, end)
+		if err != nil {
+			return err
+		}
+		if !*queue {
+			// progress goes to stderr so JSON output stays parseable
+			err = accumulator.RunReprocessJob(context.Background(), job, time.Duration(a.config.StepMinutes)*time.Minute, func(job *db.ReprocessJob) {
+				fmt.Fprintf(os.Stderr, "\r%d/%d classes, %d sessions created, %d changed, %d deleted",
+					job.ProcessedClasses, job.TotalClasses, job.SessionsCreated, job.SessionsChanged, job.SessionsDeleted)
+			})
+			fmt.Fprintln(os.Stderr)
+			if err != nil {
+				return err
+			}
+		}
+		return a.output(job, func(w io.Writer) {
+			tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "JOB\tSTATUS\tFROM\tTO\tCLASSES\tCREATED\tCHANGED\tDELETED\tERROR")
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d/%d\t%d\t%d\t%d\t%s\n", job.ID, job.Status,
+				job.PeriodStart.Format(time.RFC3339), job.PeriodEnd.Format(time.RFC3339), job.ProcessedClasses, job.TotalClasses,
+				job.SessionsCreated, job.SessionsChanged, job.SessionsDeleted, job.ErrorMessage.String)
+			tw.Flush()
+		})
+	}
+}
+
+func printFriends(w io.Writer, friends db.FriendSlice) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tVRCHAT ID\tDISPLAY NAME\tTEACHER\tARCHIVED\tLOCATION")
+	for _, f := range friends {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%v\t%v\t%s\n", f.ID, f.VrchatID, f.VrchatDisplayName, f.IsTeacher, f.Archived, f.VrchatLocation)
+	}
+	tw.Flush()
+}
+
+func friendList(fs *flag.FlagSet) func(a *app, args []string) error {
+	archived := fs.Bool("archived", false, "Include archived friends")
+	return func(a *app, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		mods := []qm.QueryMod{
+			db.FriendWhere.IntegrationID.EQ(id),
+			qm.OrderBy(db.FriendColumns.ID),
+		}
+		if !*archived {
+			mods = append(mods, db.FriendWhere.Archived.EQ(false))
+		}
+		friends, err := db.Friends(mods...).AllG()
+		if err != nil {
+			return err
+		}
+		if friends == nil {
+			friends = db.FriendSlice{}
+		}
+		return a.output(friends, func(w io.Writer) {
+			printFriends(w, friends)
+		})
+	}
+}
+
+func friendSetTeacher(isTeacher bool) func(a *app, args []string) error {
+	return func(a *app, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		friend, err := accumulator.SetTeacher(id, args[1], isTeacher)
+		if err != nil {
+			return err
+		}
+		return a.output(friend, func(w io.Writer) {
+			printFriends(w, db.FriendSlice{friend})
+		})
+	}
+}
@@ -1,117 +1,163 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
 
-	"accumulator/bindata"
+	"accumulator"
 
-	"github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/sqlite3"
-	migrate_bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
-	"github.com/jmoiron/sqlx"
-	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/kelseyhightower/envconfig"
 )
 
-func connect() (*sqlx.DB, error) {
-	conn, err := sqlx.Connect("sqlite3", "./accumulator.db")
-	if err != nil {
-		return nil, err
-	}
-	return conn, nil
+type Config struct {
+	MasterKey   string `default:"9A1F3DE2BB279CB966CC1167BC6C538FDE97268E3EE5F581D918309409520AE3"`
+	StepMinutes int    `default:"5"`
+	Database    accumulator.DatabaseConfig
+	Blob        accumulator.BlobStoreConfig
+	Backup      accumulator.BackupConfig
+}
+
+var errAborted = errors.New("aborted")
+
+// command is run as admin <group> <name> [flags] [args]. flags registers the command's own flags
+// and returns the function that runs it once they are parsed.
+type command struct {
+	group string
+	name  string
+	args  []string
+	usage string
+	// models commands need the generated models connected to the database
+	models bool
+	flags  func(fs *flag.FlagSet) func(a *app, args []string) error
 }
-func main() {
-	dbversion := flag.Bool("db-version", false, "Get the DB version")
-	dbmigrate := flag.Bool("db-migrate", false, "Migrate DB")
-	dbdrop := flag.Bool("db-drop", false, "Drop DB")
-	flag.Parse()
 
-	conn, err := connect()
+// app holds what every command shares
+type app struct {
+	config *Config
+	json   bool
+	yes    bool
+	in     *bufio.Reader
+	out    io.Writer
+}
+
+func main() {
+	c := &Config{}
+	err := envconfig.Process("ACCUMULATOR", c)
 	if err != nil {
-		fmt.Println(err)
-		return
+		log.Fatal(err.Error())
 	}
-	boil.SetDB(conn)
-	if *dbversion {
-		fmt.Println("Getting DB version...")
-		v, d, err := Version(conn)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Printf("Version: %d, Dirty: %v\n", v, d)
-		return
+	if len(os.Args) < 3 {
+		usage(os.Stderr)
+		os.Exit(2)
 	}
-	if *dbmigrate {
-		fmt.Println("Migrating accumulator system...")
-		err = Migrate(conn)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		return
-	}
-	if *dbdrop {
-		fmt.Println("Dropping accumulator system...")
-		err = Drop(conn)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		return
+	cmd := findCommand(os.Args[1], os.Args[2])
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "unknown command: %s %s\n\n", os.Args[1], os.Args[2])
+		usage(os.Stderr)
+		os.Exit(2)
 	}
 
-}
-
-func newMigrateInstance(conn *sqlx.DB) (*migrate.Migrate, error) {
-	s := migrate_bindata.Resource(bindata.AssetNames(),
-		func(name string) ([]byte, error) {
-			return bindata.Asset(name)
-		})
-	d, err := migrate_bindata.WithInstance(s)
-	if err != nil {
-		return nil, fmt.Errorf("bindata instance: %w", err)
+	a := &app{config: c, in: bufio.NewReader(os.Stdin), out: os.Stdout}
+	fs := flag.NewFlagSet(cmd.group+" "+cmd.name, flag.ExitOnError)
+	fs.BoolVar(&a.json, "json", false, "Print the result as JSON")
+	fs.BoolVar(&a.yes, "yes", false, "Do not ask for confirmation")
+	run := cmd.flags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: admin %s %s [flags] %s\n\n%s\n\n", cmd.group, cmd.name, strings.Join(cmd.args, " "), cmd.usage)
+		fs.PrintDefaults()
 	}
-	dbDriver, err := sqlite3.WithInstance(conn.DB, &sqlite3.Config{})
+	args, err := parseInterspersed(fs, os.Args[3:])
 	if err != nil {
-		return nil, fmt.Errorf("db instance: %w", err)
+		os.Exit(2)
 	}
-	m, err := migrate.NewWithInstance("go-bindata", d, "sqlite", dbDriver)
-	if err != nil {
-		return nil, fmt.Errorf("migrate instance: %w", err)
+	if len(args) != len(cmd.args) {
+		fs.Usage()
+		os.Exit(2)
 	}
-	return m, nil
-}
-func Migrate(conn *sqlx.DB) error {
-	m, err := newMigrateInstance(conn)
-	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+
+	if cmd.models {
+		_, err = accumulator.Connect(&c.Database)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 	}
-	err = m.Up()
+	err = run(a, args)
 	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func findCommand(group, name string) *command {
+	for _, cmd := range commands {
+		if cmd.group == group && cmd.name == name {
+			return cmd
+		}
 	}
 	return nil
 }
-func Drop(conn *sqlx.DB) error {
-	m, err := newMigrateInstance(conn)
-	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: admin <group> <command> [flags] [args]")
+	fmt.Fprintln(w, "Every command takes -json to print JSON and -yes to skip confirmation prompts.")
+	fmt.Fprintln(w)
+	sorted := append([]*command{}, commands...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].group < sorted[j].group })
+	for _, cmd := range sorted {
+		fmt.Fprintf(w, "  %-40s %s\n", strings.TrimSpace(cmd.group+" "+cmd.name+" "+strings.Join(cmd.args, " ")), cmd.usage)
 	}
-	err = m.Drop()
-	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+}
+
+// parseInterspersed lets flags come after the positional arguments, which the flag package stops at
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
 	}
-	return nil
 }
-func Version(conn *sqlx.DB) (uint, bool, error) {
-	m, err := newMigrateInstance(conn)
-	if err != nil {
-		return 0, false, fmt.Errorf("migrate: %w", err)
+
+// output prints v as JSON with -json, and calls text otherwise
+func (a *app) output(v interface{}, text func(w io.Writer)) error {
+	if !a.json {
+		text(a.out)
+		return nil
 	}
-	v, d, err := m.Version()
-	if err != nil {
-		return 0, false, fmt.Errorf("migrate: %w", err)
+	enc := json.NewEncoder(a.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// confirm asks before destructive operations. The prompt goes to stderr so JSON output stays parseable.
+func (a *app) confirm(format string, args ...interface{}) error {
+	if a.yes {
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, format+" [y/N] ", args...)
+	answer, err := a.in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
 	}
-	return v, d, nil
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer != "y" && answer != "yes" {
+		return errAborted
+	}
+	return nil
 }
@@ -0,0 +1,138 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// maxCoverageRange is the longest period coverage is reported for
+const maxCoverageRange = 92 * 24 * time.Hour
+
+// gap is a time the tracker wasn't recording an integration's attendance, because it wasn't running or its ticks failed
+type gap struct {
+	start       time.Time
+	end         time.Time
+	failedTicks int
+	lastError   null.String
+}
+
+// recordHeartbeat of a tick of the tracker for the integration that started at ticked, with how many friends VRChat
+// listed and the error it failed with
+func recordHeartbeat(integrationID int64, ticked time.Time, friends int, err error) error {
+	heartbeat := &db.TrackerHeartbeat{
+		IntegrationID: integrationID,
+		TickedAt:      ticked.UTC(),
+		Succeeded:     err == nil,
+		Friends:       int64(friends),
+		DurationMS:    int64(time.Since(ticked) / time.Millisecond),
+	}
+	if err != nil {
+		heartbeat.ErrorMessage = null.StringFrom(err.Error())
+	}
+	return heartbeat.InsertG(boil.Infer())
+}
+
+// firstHeartbeat of the integration, null when the tracker hasn't ticked for it yet
+func firstHeartbeat(integrationID int64) (null.Time, error) {
+	first, err := db.TrackerHeartbeats(
+		qm.Select(db.TrackerHeartbeatColumns.TickedAt),
+		db.TrackerHeartbeatWhere.IntegrationID.EQ(integrationID),
+		qm.OrderBy(db.TrackerHeartbeatColumns.TickedAt),
+		qm.Limit(1),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return null.Time{}, nil
+	}
+	if err != nil {
+		return null.Time{}, err
+	}
+	return null.TimeFrom(first.TickedAt), nil
+}
+
+// coverageGaps of the integration from until to in time order, with its heartbeats in that time.
+// A successful tick covers the step after it, and the next may be up to half a step late before the time between them
+// is a gap. A failed tick starts a gap where the last successful one stops covering.
+// The time before the integration's first heartbeat, from before heartbeats were recorded or the integration was added,
+// wasn't seen to be covered, so it is a gap like any other.
+func coverageGaps(integrationID int64, from, to time.Time, step time.Duration) ([]*gap, db.TrackerHeartbeatSlice, error) {
+	heartbeats, err := db.TrackerHeartbeats(
+		db.TrackerHeartbeatWhere.IntegrationID.EQ(integrationID),
+		db.TrackerHeartbeatWhere.TickedAt.GT(from.Add(-step).UTC()),
+		db.TrackerHeartbeatWhere.TickedAt.LT(to.UTC()),
+		qm.OrderBy(db.TrackerHeartbeatColumns.TickedAt),
+	).AllG()
+	if err != nil {
+		return nil, nil, err
+	}
+	slack := step / 2
+	gaps := []*gap{}
+	ticks := db.TrackerHeartbeatSlice{}
+	// coveredUntil is when the last successful tick stops covering
+	coveredUntil := from
+	var current *gap
+	for _, heartbeat := range heartbeats {
+		at := heartbeat.TickedAt
+		if !at.Before(from) {
+			ticks = append(ticks, heartbeat)
+		}
+		if current == nil && (!heartbeat.Succeeded || at.After(coveredUntil.Add(slack))) {
+			current = &gap{start: coveredUntil}
+		}
+		if !heartbeat.Succeeded {
+			if !at.Before(from) {
+				current.failedTicks++
+				current.lastError = heartbeat.ErrorMessage
+			}
+			continue
+		}
+		if current != nil {
+			current.end = at
+			if current.end.After(current.start) {
+				gaps = append(gaps, current)
+			}
+			current = nil
+		}
+		if at.Add(step).After(coveredUntil) {
+			coveredUntil = at.Add(step)
+		}
+	}
+	if current == nil && to.After(coveredUntil.Add(slack)) {
+		current = &gap{start: coveredUntil}
+	}
+	if current != nil && to.After(current.start) {
+		current.end = to
+		gaps = append(gaps, current)
+	}
+	return gaps, ticks, nil
+}
+
+// gapMinutes of from until to that the gaps cover
+func gapMinutes(gaps []*gap, from, to time.Time) int64 {
+	intervals := []interval{}
+	for _, g := range gaps {
+		intervals = append(intervals, interval{g.start, g.end})
+	}
+	total, _, _ := covered(intervals, from, to)
+	return int64(total / time.Minute)
+}
+
+// classGapMinutes of the class the tracker missed, up to now for a class still going
+func classGapMinutes(integrationID int64, o *occurrence, step time.Duration) (int64, error) {
+	end := o.end
+	if now := time.Now(); end.After(now) {
+		end = now
+	}
+	if !end.After(o.start) {
+		return 0, nil
+	}
+	gaps, _, err := coverageGaps(integrationID, o.start, end, step)
+	if err != nil {
+		return 0, err
+	}
+	return gapMinutes(gaps, o.start, end), nil
+}
@@ -64,3 +64,51 @@ func (d *Darer) decrypt(inputB []byte, nonce []byte) ([]byte, error) {
 	}
 	return output.Bytes(), nil
 }
+
+func (d *Darer) streamKey(nonce []byte) ([]byte, error) {
+	key := make([]byte, 32)
+	kdf := hkdf.New(sha256.New, d.MasterKey, nonce, nil)
+	_, err := io.ReadFull(kdf, key)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to derive encryption key: %w", err)
+	}
+	return key, nil
+}
+
+// encryptWriter writes a fresh nonce to dst and returns a writer that encrypts everything written to it after the nonce.
+// Closing the returned writer flushes it but does not close dst.
+func (d *Darer) encryptWriter(dst io.Writer) (io.WriteCloser, error) {
+	nonce := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read random data: %w", err)
+	}
+	key, err := d.streamKey(nonce)
+	if err != nil {
+		return nil, err
+	}
+	_, err = dst.Write(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return sio.EncryptWriter(writerOnly{dst}, sio.Config{Key: key})
+}
+
+// decryptReader reads the nonce written by encryptWriter and returns a reader of the plaintext that follows it
+func (d *Darer) decryptReader(src io.Reader) (io.Reader, error) {
+	nonce := make([]byte, 32)
+	_, err := io.ReadFull(src, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read nonce: %w", err)
+	}
+	key, err := d.streamKey(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return sio.DecryptReader(src, sio.Config{Key: key})
+}
+
+// writerOnly hides Close, sio closes the underlying writer when it is closed
+type writerOnly struct {
+	io.Writer
+}
@@ -0,0 +1,190 @@
+package accumulator
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/boil"
+
+	// database/sql drivers
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	driverSQLite   = "sqlite3"
+	driverPostgres = "postgres"
+)
+
+// DatabaseConfig selects the database driver and its data source name
+type DatabaseConfig struct {
+	Driver string `default:"sqlite3"`
+	// URL is a file path or file: URI for SQLite and a connection string for Postgres
+	URL                    string `default:"./accumulator.db"`
+	BusyTimeoutMS          int    `default:"5000"`
+	MaxOpenConns           int    `default:"10"`
+	MaxIdleConns           int    `default:"5"`
+	ConnMaxLifetimeMinutes int    `default:"30"`
+	// AutoMigrate migrates the schema on boot instead of refusing to start until admin db migrate is run
+	AutoMigrate bool `default:"false"`
+}
+
+// dsn adds the SQLite connection settings to the URL, unless it already sets them.
+// WAL lets the HTTP handlers read while the tracker writes, the busy timeout makes concurrent writers wait instead of failing.
+func (c *DatabaseConfig) dsn(foreignKeys bool) string {
+	if c.Driver != driverSQLite {
+		return c.URL
+	}
+	params := [][2]string{
+		{"_journal_mode", "WAL"},
+		{"_busy_timeout", strconv.Itoa(c.BusyTimeoutMS)},
+		{"_foreign_keys", "0"},
+	}
+	if foreignKeys {
+		params[2][1] = "1"
+	}
+	dsn := c.URL
+	for _, param := range params {
+		if strings.Contains(dsn, param[0]+"=") {
+			continue
+		}
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn += sep + param[0] + "=" + param[1]
+	}
+	return dsn
+}
+
+func (c *DatabaseConfig) open(foreignKeys bool) (*sqlx.DB, error) {
+	if c.Driver != driverSQLite && c.Driver != driverPostgres {
+		return nil, fmt.Errorf("unknown database driver: %s", c.Driver)
+	}
+	return sqlx.Connect(c.Driver, c.dsn(foreignKeys))
+}
+
+// Connect to the configured database with foreign keys enforced, and point the generated models at it
+func Connect(c *DatabaseConfig) (*sqlx.DB, error) {
+	conn, err := c.open(true)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(c.MaxOpenConns)
+	conn.SetMaxIdleConns(c.MaxIdleConns)
+	conn.SetConnMaxLifetime(time.Duration(c.ConnMaxLifetimeMinutes) * time.Minute)
+	boil.SetDB(Executor(conn))
+	return conn, nil
+}
+
+// Executor adapts the connection for the generated models, which are generated from the SQLite schema
+func Executor(conn *sqlx.DB) boil.Executor {
+	if conn.DriverName() == driverPostgres {
+		return &postgresExecutor{conn}
+	}
+	return conn
+}
+
+// beginTx starts a transaction on the connection the generated models use
+func beginTx() (boil.Transactor, error) {
+	switch exec := boil.GetDB().(type) {
+	case *postgresExecutor:
+		tx, err := exec.ext.(*sqlx.DB).Beginx()
+		if err != nil {
+			return nil, err
+		}
+		return &postgresTx{postgresExecutor{tx}, tx}, nil
+	case boil.Beginner:
+		return exec.Begin()
+	}
+	return nil, errors.New("database does not support transactions")
+}
+
+// rollback a transaction after an error, keeping the original error
+func rollback(tx boil.Transactor, err error) error {
+	rbErr := tx.Rollback()
+	if rbErr != nil {
+		return fmt.Errorf("%v (rollback: %v)", err, rbErr)
+	}
+	return err
+}
+
+type postgresExt interface {
+	sqlx.Ext
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// postgresExecutor rewrites the ? placeholders used by the models and raw queries to $n,
+// and reads inserted IDs back with RETURNING as Postgres has no LastInsertId
+type postgresExecutor struct {
+	ext postgresExt
+}
+
+func (e *postgresExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	query = e.ext.Rebind(query)
+	if !strings.HasPrefix(strings.TrimSpace(strings.ToUpper(query)), "INSERT") {
+		return e.ext.Exec(query, args...)
+	}
+	rows, err := e.ext.Queryx(query+" RETURNING *", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &insertResult{}
+	for rows.Next() {
+		row := map[string]interface{}{}
+		err = rows.MapScan(row)
+		if err != nil {
+			return nil, err
+		}
+		if id, ok := row["id"].(int64); ok {
+			result.lastInsertID = id
+			result.hasID = true
+		}
+		result.rowsAffected++
+	}
+	return result, rows.Err()
+}
+
+func (e *postgresExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return e.ext.Query(e.ext.Rebind(query), args...)
+}
+
+func (e *postgresExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return e.ext.QueryRow(e.ext.Rebind(query), args...)
+}
+
+type postgresTx struct {
+	postgresExecutor
+	tx *sqlx.Tx
+}
+
+func (t *postgresTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *postgresTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+type insertResult struct {
+	lastInsertID int64
+	hasID        bool
+	rowsAffected int64
+}
+
+func (r *insertResult) LastInsertId() (int64, error) {
+	if !r.hasID {
+		return 0, errors.New("inserted row has no id column")
+	}
+	return r.lastInsertID, nil
+}
+
+func (r *insertResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
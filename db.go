@@ -1,241 +1,381 @@
 package accumulator
 
 import (
-	"accumulator/bindata"
 	"accumulator/db"
+	"bytes"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/bxcodec/faker/v3"
-	"github.com/gofrs/uuid"
-	"github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/sqlite3"
-	migrate_bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
-	"github.com/jmoiron/sqlx"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
 )
 
-func randomAvatar() ([]byte, error) {
-	resp, err := http.Get("https://i.pravatar.cc/300")
-	if err != nil {
-		return nil, err
-	}
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return b, err
+// SeedConfig controls the size and shape of the generated data. The same seed and end date always generate the same data.
+type SeedConfig struct {
+	Seed                int64
+	Users               int
+	IntegrationsPerUser int
+	// Friends per integration, Teachers of which are teachers and the rest students
+	Friends  int
+	Teachers int
+	// Days of class history to simulate, ending the day before End
+	Days        int
+	End         time.Time
+	StepMinutes int
+
+	AdminEmail    string
+	AdminPassword string
 }
 
-func newMigrateInstance(conn *sqlx.DB) (*migrate.Migrate, error) {
-	s := migrate_bindata.Resource(bindata.AssetNames(),
-		func(name string) ([]byte, error) {
-			return bindata.Asset(name)
-		})
-	d, err := migrate_bindata.WithInstance(s)
-	if err != nil {
-		return nil, fmt.Errorf("bindata instance: %w", err)
+// seedClass is a weekly class a teacher holds in one world instance
+type seedClass struct {
+	teacher  *db.Friend
+	weekday  time.Weekday
+	start    time.Duration
+	duration time.Duration
+	location string
+}
+
+// seedStudent attends some of the classes, with a chance of turning up to each one
+type seedStudent struct {
+	friend      *db.Friend
+	classes     []*seedClass
+	reliability float64
+}
+
+type seeder struct {
+	rng    *rand.Rand
+	c      *SeedConfig
+	d      *Darer
+	blobs  *BlobStorage
+	nextID int
+}
+
+var (
+	seedFirstNames = []string{"Akira", "Bea", "Cass", "Dmitri", "Emi", "Felix", "Gwen", "Hiro", "Ines", "Jun", "Kai", "Luca", "Mira", "Noor", "Oskar", "Pia", "Quinn", "Rei", "Sami", "Tove", "Uma", "Vik", "Wren", "Yuki", "Zane"}
+	seedLastNames  = []string{"Abe", "Berg", "Chen", "Dahl", "Endo", "Fox", "Gray", "Holm", "Ito", "Jansen", "Kato", "Lund", "Mori", "Nash", "Ono", "Park", "Quist", "Reyes", "Sato", "Tran", "Ueda", "Vale", "Wolfe", "Yang", "Zito"}
+)
+
+// Seed the database with users, integrations, friends and the attendance of simulated classes.
+// Nothing is downloaded, avatars are generated locally.
+func Seed(c *SeedConfig, masterKeyHex string, blobs *BlobStorage) error {
+	if c.AdminEmail == "" || c.AdminPassword == "" {
+		return errors.New("seeding needs an admin email and password")
 	}
-	dbDriver, err := sqlite3.WithInstance(conn.DB, &sqlite3.Config{})
-	if err != nil {
-		return nil, fmt.Errorf("db instance: %w", err)
+	if c.Users < 1 || c.Days < 0 || c.StepMinutes < 1 {
+		return fmt.Errorf("need at least 1 user and a step of at least 1 minute, got %d users and %d minutes", c.Users, c.StepMinutes)
 	}
-	m, err := migrate.NewWithInstance("go-bindata", d, "sqlite", dbDriver)
-	if err != nil {
-		return nil, fmt.Errorf("migrate instance: %w", err)
+	if c.Teachers < 1 || c.Teachers >= c.Friends {
+		return fmt.Errorf("need at least 1 teacher and 1 student, got %d teachers of %d friends", c.Teachers, c.Friends)
 	}
-	return m, nil
-}
-func Migrate(conn *sqlx.DB) error {
-	m, err := newMigrateInstance(conn)
+	d, err := NewDarer(masterKeyHex)
 	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+		return err
+	}
+	s := &seeder{
+		rng:   rand.New(rand.NewSource(c.Seed)),
+		c:     c,
+		d:     d,
+		blobs: blobs,
 	}
-	err = m.Up()
+
+	users := []*db.User{}
+	admin, err := CreateUser(c.AdminEmail, c.AdminPassword, roleAdmin)
 	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+		return err
+	}
+	users = append(users, admin)
+	for i := 1; i < c.Users; i++ {
+		first, last := s.name()
+		u, err := CreateUser(fmt.Sprintf("%s.%s.%d@example.com", strings.ToLower(first), strings.ToLower(last), i), c.AdminPassword, roleUser)
+		if err != nil {
+			return err
+		}
+		users = append(users, u)
+	}
+
+	for _, u := range users {
+		for i := 0; i < c.IntegrationsPerUser; i++ {
+			integration, err := s.integration(u.ID)
+			if err != nil {
+				return err
+			}
+			err = s.classes(integration)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
-func Drop(conn *sqlx.DB) error {
-	m, err := newMigrateInstance(conn)
+
+func (s *seeder) name() (string, string) {
+	return seedFirstNames[s.rng.Intn(len(seedFirstNames))], seedLastNames[s.rng.Intn(len(seedLastNames))]
+}
+
+// uuid formats 16 random bytes like the version 4 UUIDs VRChat uses in its IDs
+func (s *seeder) uuid() string {
+	b := make([]byte, 16)
+	s.rng.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (s *seeder) integration(userID int64) (*db.Integration, error) {
+	encrypted, nonce, err := s.d.encrypt([]byte("SAMPLE AUTH TOKEN"))
 	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+		return nil, err
 	}
-	err = m.Drop()
+	s.nextID++
+	integration := &db.Integration{
+		UserID:         userID,
+		Username:       fmt.Sprintf("vrchat-seed-%d", s.nextID),
+		APIKey:         "SAMPLE API KEY",
+		AuthToken:      encrypted,
+		AuthTokenNonce: nonce,
+	}
+	err = integration.InsertG(boil.Infer())
 	if err != nil {
-		return fmt.Errorf("migrate: %w", err)
+		return nil, err
 	}
-	return nil
+	return integration, nil
 }
-func Version(conn *sqlx.DB) (uint, bool, error) {
-	m, err := newMigrateInstance(conn)
+
+func (s *seeder) friend(integrationID int64, isTeacher bool) (*db.Friend, error) {
+	first, last := s.name()
+	avatar, err := s.blobs.PutImage(s.avatar())
 	if err != nil {
-		return 0, false, fmt.Errorf("migrate: %w", err)
+		return nil, err
+	}
+	friend := &db.Friend{
+		IntegrationID:                 integrationID,
+		IsTeacher:                     isTeacher,
+		VrchatID:                      "usr_" + s.uuid(),
+		VrchatUsername:                strings.ToLower(first + last),
+		VrchatDisplayName:             first + " " + last,
+		VrchatAvatarImageURL:          "",
+		VrchatAvatarThumbnailImageURL: "",
+		VrchatLocation:                "offline",
+		AvatarBlobFilename:            null.StringFrom(avatar.FileName),
 	}
-	v, d, err := m.Version()
+	err = friend.InsertG(boil.Infer())
 	if err != nil {
-		return 0, false, fmt.Errorf("migrate: %w", err)
+		return nil, err
 	}
-	return v, d, nil
+	return friend, nil
 }
-func Seed(masterKeyHex string) error {
-	u := userFactory()
-	u.Email = "jtnguyen236@gmail.com"
-	u.PasswordHash = HashPassword("password")
-	u.Role = roleAdmin
-	err := u.InsertG(boil.Infer())
-	if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-		return err
-	}
-	for i := 0; i < 2; i++ {
-		u := userFactory()
-		err = u.InsertG(boil.Infer())
-		if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-			return err
+
+// avatar draws a symmetric 5x5 identicon as a PNG
+func (s *seeder) avatar() []byte {
+	const cells, cellSize = 5, 64
+	fg := color.RGBA{uint8(64 + s.rng.Intn(160)), uint8(64 + s.rng.Intn(160)), uint8(64 + s.rng.Intn(160)), 255}
+	bg := color.RGBA{240, 240, 240, 255}
+	img := image.NewRGBA(image.Rect(0, 0, cells*cellSize, cells*cellSize))
+	for col := 0; col < (cells+1)/2; col++ {
+		for row := 0; row < cells; row++ {
+			c := bg
+			if s.rng.Intn(2) == 0 {
+				c = fg
+			}
+			for _, x := range []int{col, cells - 1 - col} {
+				for px := x * cellSize; px < (x+1)*cellSize; px++ {
+					for py := row * cellSize; py < (row+1)*cellSize; py++ {
+						img.SetRGBA(px, py, c)
+					}
+				}
+			}
 		}
 	}
-	users, err := db.Users().AllG()
+	buf := &bytes.Buffer{}
+	err := png.Encode(buf, img)
 	if err != nil {
-		return err
+		panic(err)
 	}
-	for _, user := range users {
-		integration := integrationFactory(masterKeyHex, user.ID.Int64)
-		err = integration.InsertG(boil.Infer())
-		if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
+	return buf.Bytes()
+}
+
+// classes creates the friends of the integration and simulates a weekly timetable of classes over the configured days,
+// with a schedule for each class and a roster for each teacher with the students of their classes.
+// Students turn up to their classes with their own reliability, sometimes late and sometimes leaving early,
+// and the tracker sees where they and their teachers are every step, recording the students in their teacher's instance.
+// The tracker's heartbeats are recorded every step too, apart from an outage of a few hours one evening when VRChat
+// rate limited it and nothing was recorded. The classes are then evaluated with the integration's attendance policy.
+func (s *seeder) classes(integration *db.Integration) error {
+	classes := []*seedClass{}
+	schedules := db.ScheduleSlice{}
+	rosters := map[int64]*db.Roster{}
+	for i := 0; i < s.c.Teachers; i++ {
+		teacher, err := s.friend(integration.ID, true)
+		if err != nil {
+			return err
+		}
+		roster := &db.Roster{IntegrationID: integration.ID, TeacherID: teacher.ID, Name: teacher.VrchatDisplayName + "'s class"}
+		err = roster.InsertG(boil.Infer())
+		if err != nil {
 			return err
 		}
+		rosters[teacher.ID] = roster
+		world := "wrld_" + s.uuid()
+		perWeek := 2 + s.rng.Intn(2)
+		for j := 0; j < perWeek; j++ {
+			classes = append(classes, &seedClass{
+				teacher:  teacher,
+				weekday:  time.Weekday(s.rng.Intn(7)),
+				start:    time.Duration(16+s.rng.Intn(6))*time.Hour + time.Duration(s.rng.Intn(2)*30)*time.Minute,
+				duration: time.Duration(45+s.rng.Intn(3)*15) * time.Minute,
+				location: fmt.Sprintf("%s:%05d", world, s.rng.Intn(100000)),
+			})
+		}
 	}
-	integations, err := db.Integrations().AllG()
-	if err != nil {
-		return err
+	for i, class := range classes {
+		start, end := class.start, class.start+class.duration
+		schedule := &db.Schedule{
+			IntegrationID: integration.ID,
+			TeacherID:     class.teacher.ID,
+			Name:          fmt.Sprintf("Class %d", i+1),
+			Weekdays:      weekdayNames[class.weekday],
+			StartTime:     fmt.Sprintf("%02d:%02d", int(start.Hours())%24, int(start.Minutes())%60),
+			EndTime:       fmt.Sprintf("%02d:%02d", int(end.Hours())%24, int(end.Minutes())%60),
+			TimeZone:      "UTC",
+			WorldID:       null.StringFrom(ParseLocation(class.location).WorldID),
+			RosterID:      null.Int64From(rosters[class.teacher.ID].ID),
+		}
+		err := schedule.InsertG(boil.Infer())
+		if err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
 	}
-	for _, integration := range integations {
-		for i := 0; i < 5; i++ {
-			b, err := randomAvatar()
+	students := []*seedStudent{}
+	for i := s.c.Teachers; i < s.c.Friends; i++ {
+		friend, err := s.friend(integration.ID, false)
+		if err != nil {
+			return err
+		}
+		student := &seedStudent{friend: friend, reliability: 0.5 + s.rng.Float64()*0.45}
+		for _, j := range s.rng.Perm(len(classes))[:1+s.rng.Intn(2)] {
+			student.classes = append(student.classes, classes[j])
+			_, err = Enrol(rosters[classes[j].teacher.ID], friend, nil, "")
 			if err != nil {
 				return err
 			}
-			blobFilename := uuid.Must(uuid.NewV4()).String()
-			blob := &db.Blob{
-				FileName:      blobFilename,
-				MimeType:      "image/jpg",
-				FileSizeBytes: int64(len(b)),
-				EXTENSION:     "jpg",
-				File:          b,
-			}
-			err = blob.InsertG(boil.Infer())
-			if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-				return err
-			}
-
-			friend := friendFactory(integration.ID.Int64)
-			if i == 0 {
-				friend.IsTeacher = true
-			}
-
-			friend.AvatarBlobFilename = null.StringFrom(blobFilename)
-
-			err = friend.InsertG(boil.Infer())
-			if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-				return err
-			}
 		}
+		students = append(students, student)
 	}
 
-	integations, err = db.Integrations().AllG()
+	rules, err := loadTrackingRules(integration)
 	if err != nil {
 		return err
 	}
-	for _, integration := range integations {
-		friends, err := db.Friends(db.FriendWhere.IntegrationID.EQ(integration.ID.Int64)).AllG()
-		if err != nil {
-			return err
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	step := time.Duration(s.c.StepMinutes) * time.Minute
+	end := s.c.End.UTC().Truncate(24 * time.Hour)
+	// the outage is over a class, on a day it was held
+	outage := interval{}
+	if s.c.Days > 0 && len(classes) > 0 {
+		class := classes[s.rng.Intn(len(classes))]
+		day := end.AddDate(0, 0, -1-s.rng.Intn(s.c.Days))
+		for i := 0; i < 7 && day.Weekday() != class.weekday; i++ {
+			day = day.AddDate(0, 0, 1)
 		}
-		teacherID := null.NewInt64(0, false)
-		for _, friend := range friends {
-			if friend.IsTeacher {
-				teacherID = friend.ID
-			}
+		if day.Before(end) {
+			outage.start = day.Add(class.start).Add(-time.Duration(s.rng.Intn(60)) * time.Minute)
+			outage.end = outage.start.Add(3 * time.Hour)
+		}
+	}
+	// where the tracker saw the teachers and students at each tick
+	ticks := []time.Time{}
+	locations := map[time.Time]map[int64]Location{}
+	see := func(t time.Time, friend *db.Friend, location string) {
+		if !t.Before(outage.start) && t.Before(outage.end) {
+			return
 		}
-		if !teacherID.Valid {
-			return errors.New("no teacher in integration")
+		if locations[t] == nil {
+			ticks = append(ticks, t)
+			locations[t] = map[int64]Location{}
 		}
-		for _, friend := range friends {
-			if friend.IsTeacher {
+		// a friend in two classes at once is only in one instance, the first
+		if _, ok := locations[t][friend.ID]; !ok {
+			locations[t][friend.ID] = ParseLocation(location)
+		}
+	}
+	for day := end.AddDate(0, 0, -s.c.Days); day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, class := range classes {
+			if class.weekday != day.Weekday() {
 				continue
 			}
-
-			for i := 0; i < 10; i++ {
-				attendance := attendanceFactory(integration.ID.Int64, friend.ID.Int64, teacherID.Int64)
-				err = attendance.InsertG(boil.Infer())
-				if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-					return err
+			for t := day.Add(class.start); t.Before(day.Add(class.start + class.duration)); t = t.Add(step) {
+				see(t, class.teacher, class.location)
+			}
+		}
+		for _, student := range students {
+			for _, class := range student.classes {
+				if class.weekday != day.Weekday() || s.rng.Float64() > student.reliability {
+					continue
+				}
+				join := day.Add(class.start).Add(time.Duration(s.rng.Intn(3)) * step)
+				if s.rng.Intn(6) == 0 {
+					join = join.Add(time.Duration(1+s.rng.Intn(2)) * step)
+				}
+				leave := day.Add(class.start + class.duration)
+				if s.rng.Intn(4) == 0 {
+					leave = leave.Add(-time.Duration(1+s.rng.Intn(3)) * step)
+				}
+				for t := join; t.Before(leave); t = t.Add(step) {
+					see(t, student.friend, class.location)
 				}
 			}
 		}
 	}
-	return nil
-}
-func integrationFactory(masterKey string, userID int64) *db.Integration {
-
-	d, err := NewDarer(masterKey)
-	if err != nil {
-		panic(err)
-	}
-	encrypted, nonce, err := d.encrypt([]byte("SAMPLE AUTH TOKEN"))
-	if err != nil {
-		panic(err)
+	sort.Slice(ticks, func(i, j int) bool { return ticks[i].Before(ticks[j]) })
+	for _, t := range ticks {
+		_, attendance, err := rules.classes(t, locations[t])
+		if err != nil {
+			return rollback(tx, err)
+		}
+		err = recordTick(tx, integration.ID, t, locations[t], attendance)
+		if err != nil {
+			return rollback(tx, err)
+		}
 	}
-	data := &db.Integration{
-		UserID:         userID,
-		Username:       faker.Email(),
-		APIKey:         "SAMPLE API KEY",
-		AuthToken:      encrypted,
-		AuthTokenNonce: nonce,
+	for t := end.AddDate(0, 0, -s.c.Days); t.Before(end); t = t.Add(step) {
+		heartbeat := &db.TrackerHeartbeat{
+			IntegrationID: integration.ID,
+			TickedAt:      t,
+			Succeeded:     true,
+			Friends:       int64(s.c.Friends),
+			DurationMS:    int64(200 + s.rng.Intn(1800)),
+		}
+		if !t.Before(outage.start) && t.Before(outage.end) {
+			heartbeat.Succeeded = false
+			heartbeat.Friends = 0
+			heartbeat.ErrorMessage = null.StringFrom("could not refresh friend cache: 429 Too Many Requests")
+		}
+		err = heartbeat.Insert(tx, boil.Infer())
+		if err != nil {
+			return rollback(tx, err)
+		}
 	}
-
-	return data
-}
-func userFactory() *db.User {
-
-	data := &db.User{
-		Email:        faker.Email(),
-		PasswordHash: HashPassword("password"),
+	err = tx.Commit()
+	if err != nil {
+		return err
 	}
-
-	return data
-}
-func friendFactory(integrationID int64) *db.Friend {
-	data := &db.Friend{
-		IntegrationID:                 integrationID,
-		IsTeacher:                     false,
-		VrchatID:                      faker.Username(),
-		VrchatUsername:                faker.Username(),
-		VrchatDisplayName:             faker.Name(),
-		VrchatAvatarImageURL:          faker.URL(),
-		VrchatAvatarThumbnailImageURL: faker.URL(),
-		VrchatLocation:                fmt.Sprintf("%f-%f", faker.Latitude(), faker.Longitude()),
+	for _, schedule := range schedules {
+		_, err = evaluateSessions(integration, schedule, end.AddDate(0, 0, -s.c.Days), end, step)
+		if err != nil {
+			return err
+		}
 	}
-
-	return data
-}
-func attendanceFactory(
-	integrationID int64,
-	friendID int64,
-	teacherID int64,
-) *db.Attendance {
-	data := &db.Attendance{
-		Timestamp:     faker.RandomUnixTime(),
-		IntegrationID: null.Int64From(integrationID),
-		FriendID:      null.Int64From(friendID),
-		TeacherID:     null.Int64From(teacherID),
-		Location:      fmt.Sprintf("%f-%f", faker.Latitude(), faker.Longitude()),
-	}
-
-	return data
+	return nil
 }
@@ -31,6 +31,11 @@ type Attendance struct {
 	ArchivedAt    null.Time  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
 	UpdatedAt     time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	CreatedAt     time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	WorldID       string     `boil:"world_id" json:"world_id" toml:"world_id" yaml:"world_id"`
+	InstanceID    string     `boil:"instance_id" json:"instance_id" toml:"instance_id" yaml:"instance_id"`
+	Region        string     `boil:"region" json:"region" toml:"region" yaml:"region"`
+	AccessType    string     `boil:"access_type" json:"access_type" toml:"access_type" yaml:"access_type"`
+	OwnerID       string     `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
 
 	R *attendanceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L attendanceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +51,11 @@ var AttendanceColumns = struct {
 	ArchivedAt    string
 	UpdatedAt     string
 	CreatedAt     string
+	WorldID       string
+	InstanceID    string
+	Region        string
+	AccessType    string
+	OwnerID       string
 }{
 	Timestamp:     "timestamp",
 	IntegrationID: "integration_id",
@@ -56,6 +66,11 @@ var AttendanceColumns = struct {
 	ArchivedAt:    "archived_at",
 	UpdatedAt:     "updated_at",
 	CreatedAt:     "created_at",
+	WorldID:       "world_id",
+	InstanceID:    "instance_id",
+	Region:        "region",
+	AccessType:    "access_type",
+	OwnerID:       "owner_id",
 }
 
 // Generated where
@@ -171,6 +186,11 @@ var AttendanceWhere = struct {
 	ArchivedAt    whereHelpernull_Time
 	UpdatedAt     whereHelpertime_Time
 	CreatedAt     whereHelpertime_Time
+	WorldID       whereHelperstring
+	InstanceID    whereHelperstring
+	Region        whereHelperstring
+	AccessType    whereHelperstring
+	OwnerID       whereHelperstring
 }{
 	Timestamp:     whereHelperint64{field: "\"attendance\".\"timestamp\""},
 	IntegrationID: whereHelpernull_Int64{field: "\"attendance\".\"integration_id\""},
@@ -181,6 +201,11 @@ var AttendanceWhere = struct {
 	ArchivedAt:    whereHelpernull_Time{field: "\"attendance\".\"archived_at\""},
 	UpdatedAt:     whereHelpertime_Time{field: "\"attendance\".\"updated_at\""},
 	CreatedAt:     whereHelpertime_Time{field: "\"attendance\".\"created_at\""},
+	WorldID:       whereHelperstring{field: "\"attendance\".\"world_id\""},
+	InstanceID:    whereHelperstring{field: "\"attendance\".\"instance_id\""},
+	Region:        whereHelperstring{field: "\"attendance\".\"region\""},
+	AccessType:    whereHelperstring{field: "\"attendance\".\"access_type\""},
+	OwnerID:       whereHelperstring{field: "\"attendance\".\"owner_id\""},
 }
 
 // AttendanceRels is where relationship names are stored.
@@ -210,10 +235,10 @@ func (*attendanceR) NewStruct() *attendanceR {
 type attendanceL struct{}
 
 var (
-	attendanceAllColumns            = []string{"timestamp", "integration_id", "friend_id", "teacher_id", "location", "archived", "archived_at", "updated_at", "created_at"}
+	attendanceAllColumns            = []string{"timestamp", "integration_id", "friend_id", "teacher_id", "location", "archived", "archived_at", "updated_at", "created_at", "world_id", "instance_id", "region", "access_type", "owner_id"}
 	attendanceColumnsWithoutDefault = []string{"timestamp", "integration_id", "friend_id", "teacher_id", "location", "archived_at"}
-	attendanceColumnsWithDefault    = []string{"archived", "updated_at", "created_at"}
-	attendancePrimaryKeyColumns     = []string{"timestamp", "integration_id", "friend_id"}
+	attendanceColumnsWithDefault    = []string{"archived", "updated_at", "created_at", "world_id", "instance_id", "region", "access_type", "owner_id"}
+	attendancePrimaryKeyColumns     = []string{"timestamp", "integration_id", "friend_id", "teacher_id"}
 )
 
 type (
@@ -602,7 +627,7 @@ func (attendanceL) LoadTeacher(e boil.Executor, singular bool, maybeAttendance i
 		if foreign.R == nil {
 			foreign.R = &friendR{}
 		}
-		foreign.R.TeacherAttendances = append(foreign.R.TeacherAttendances, object)
+		foreign.R.TeacherAttendance = object
 		return nil
 	}
 
@@ -613,7 +638,7 @@ func (attendanceL) LoadTeacher(e boil.Executor, singular bool, maybeAttendance i
 				if foreign.R == nil {
 					foreign.R = &friendR{}
 				}
-				foreign.R.TeacherAttendances = append(foreign.R.TeacherAttendances, local)
+				foreign.R.TeacherAttendance = local
 				break
 			}
 		}
@@ -834,7 +859,7 @@ func (attendanceL) LoadIntegration(e boil.Executor, singular bool, maybeAttendan
 
 // SetTeacherG of the attendance to the related item.
 // Sets o.R.Teacher to related.
-// Adds o to related.R.TeacherAttendances.
+// Adds o to related.R.TeacherAttendance.
 // Uses the global database handle.
 func (o *Attendance) SetTeacherG(insert bool, related *Friend) error {
 	return o.SetTeacher(boil.GetDB(), insert, related)
@@ -842,7 +867,7 @@ func (o *Attendance) SetTeacherG(insert bool, related *Friend) error {
 
 // SetTeacher of the attendance to the related item.
 // Sets o.R.Teacher to related.
-// Adds o to related.R.TeacherAttendances.
+// Adds o to related.R.TeacherAttendance.
 func (o *Attendance) SetTeacher(exec boil.Executor, insert bool, related *Friend) error {
 	var err error
 	if insert {
@@ -856,7 +881,7 @@ func (o *Attendance) SetTeacher(exec boil.Executor, insert bool, related *Friend
 		strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
 		strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID}
+	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID, o.TeacherID}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -878,10 +903,10 @@ func (o *Attendance) SetTeacher(exec boil.Executor, insert bool, related *Friend
 
 	if related.R == nil {
 		related.R = &friendR{
-			TeacherAttendances: AttendanceSlice{o},
+			TeacherAttendance: o,
 		}
 	} else {
-		related.R.TeacherAttendances = append(related.R.TeacherAttendances, o)
+		related.R.TeacherAttendance = o
 	}
 
 	return nil
@@ -911,18 +936,7 @@ func (o *Attendance) RemoveTeacher(exec boil.Executor, related *Friend) error {
 		return nil
 	}
 
-	for i, ri := range related.R.TeacherAttendances {
-		if queries.Equal(o.TeacherID, ri.TeacherID) {
-			continue
-		}
-
-		ln := len(related.R.TeacherAttendances)
-		if ln > 1 && i < ln-1 {
-			related.R.TeacherAttendances[i] = related.R.TeacherAttendances[ln-1]
-		}
-		related.R.TeacherAttendances = related.R.TeacherAttendances[:ln-1]
-		break
-	}
+	related.R.TeacherAttendance = nil
 	return nil
 }
 
@@ -950,7 +964,7 @@ func (o *Attendance) SetFriend(exec boil.Executor, insert bool, related *Friend)
 		strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
 		strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID}
+	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID, o.TeacherID}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -1033,7 +1047,7 @@ func (o *Attendance) SetIntegration(exec boil.Executor, insert bool, related *In
 		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
 		strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID}
+	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID, o.TeacherID}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -1099,13 +1113,13 @@ func Attendances(mods ...qm.QueryMod) attendanceQuery {
 }
 
 // FindAttendanceG retrieves a single record by ID.
-func FindAttendanceG(timestamp int64, integrationID null.Int64, friendID null.Int64, selectCols ...string) (*Attendance, error) {
-	return FindAttendance(boil.GetDB(), timestamp, integrationID, friendID, selectCols...)
+func FindAttendanceG(timestamp int64, integrationID null.Int64, friendID null.Int64, teacherID null.Int64, selectCols ...string) (*Attendance, error) {
+	return FindAttendance(boil.GetDB(), timestamp, integrationID, friendID, teacherID, selectCols...)
 }
 
 // FindAttendance retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindAttendance(exec boil.Executor, timestamp int64, integrationID null.Int64, friendID null.Int64, selectCols ...string) (*Attendance, error) {
+func FindAttendance(exec boil.Executor, timestamp int64, integrationID null.Int64, friendID null.Int64, teacherID null.Int64, selectCols ...string) (*Attendance, error) {
 	attendanceObj := &Attendance{}
 
 	sel := "*"
@@ -1113,10 +1127,10 @@ func FindAttendance(exec boil.Executor, timestamp int64, integrationID null.Int6
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"attendance\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=?", sel,
+		"select %s from \"attendance\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=? AND \"teacher_id\"=?", sel,
 	)
 
-	q := queries.Raw(query, timestamp, integrationID, friendID)
+	q := queries.Raw(query, timestamp, integrationID, friendID, teacherID)
 
 	err := q.Bind(nil, exec, attendanceObj)
 	if err != nil {
@@ -1217,6 +1231,7 @@ func (o *Attendance) Insert(exec boil.Executor, columns boil.Columns) error {
 		o.Timestamp,
 		o.IntegrationID,
 		o.FriendID,
+		o.TeacherID,
 	}
 
 	if boil.DebugMode {
@@ -1405,7 +1420,7 @@ func (o *Attendance) Delete(exec boil.Executor) (int64, error) {
 	}
 
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attendancePrimaryKeyMapping)
-	sql := "DELETE FROM \"attendance\" WHERE \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=?"
+	sql := "DELETE FROM \"attendance\" WHERE \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=? AND \"teacher_id\"=?"
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
@@ -1516,7 +1531,7 @@ func (o *Attendance) ReloadG() error {
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *Attendance) Reload(exec boil.Executor) error {
-	ret, err := FindAttendance(exec, o.Timestamp, o.IntegrationID, o.FriendID)
+	ret, err := FindAttendance(exec, o.Timestamp, o.IntegrationID, o.FriendID, o.TeacherID)
 	if err != nil {
 		return err
 	}
@@ -1565,21 +1580,21 @@ func (o *AttendanceSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // AttendanceExistsG checks if the Attendance row exists.
-func AttendanceExistsG(timestamp int64, integrationID null.Int64, friendID null.Int64) (bool, error) {
-	return AttendanceExists(boil.GetDB(), timestamp, integrationID, friendID)
+func AttendanceExistsG(timestamp int64, integrationID null.Int64, friendID null.Int64, teacherID null.Int64) (bool, error) {
+	return AttendanceExists(boil.GetDB(), timestamp, integrationID, friendID, teacherID)
 }
 
 // AttendanceExists checks if the Attendance row exists.
-func AttendanceExists(exec boil.Executor, timestamp int64, integrationID null.Int64, friendID null.Int64) (bool, error) {
+func AttendanceExists(exec boil.Executor, timestamp int64, integrationID null.Int64, friendID null.Int64, teacherID null.Int64) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"attendance\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=? limit 1)"
+	sql := "select exists(select 1 from \"attendance\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=? AND \"teacher_id\"=? limit 1)"
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, timestamp, integrationID, friendID)
+		fmt.Fprintln(boil.DebugWriter, timestamp, integrationID, friendID, teacherID)
 	}
 
-	row := exec.QueryRow(sql, timestamp, integrationID, friendID)
+	row := exec.QueryRow(sql, timestamp, integrationID, friendID, teacherID)
 
 	err := row.Scan(&exists)
 	if err != nil {
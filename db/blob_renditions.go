@@ -0,0 +1,855 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// BlobRendition is an object representing the database table.
+type BlobRendition struct {
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ParentFileName string    `boil:"parent_file_name" json:"parent_file_name" toml:"parent_file_name" yaml:"parent_file_name"`
+	Size           int64     `boil:"size" json:"size" toml:"size" yaml:"size"`
+	BlobFileName   string    `boil:"blob_file_name" json:"blob_file_name" toml:"blob_file_name" yaml:"blob_file_name"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *blobRenditionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L blobRenditionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BlobRenditionColumns = struct {
+	ID             string
+	ParentFileName string
+	Size           string
+	BlobFileName   string
+	UpdatedAt      string
+	CreatedAt      string
+}{
+	ID:             "id",
+	ParentFileName: "parent_file_name",
+	Size:           "size",
+	BlobFileName:   "blob_file_name",
+	UpdatedAt:      "updated_at",
+	CreatedAt:      "created_at",
+}
+
+// Generated where
+
+var BlobRenditionWhere = struct {
+	ID             whereHelperint64
+	ParentFileName whereHelperstring
+	Size           whereHelperint64
+	BlobFileName   whereHelperstring
+	UpdatedAt      whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint64{field: "\"blob_renditions\".\"id\""},
+	ParentFileName: whereHelperstring{field: "\"blob_renditions\".\"parent_file_name\""},
+	Size:           whereHelperint64{field: "\"blob_renditions\".\"size\""},
+	BlobFileName:   whereHelperstring{field: "\"blob_renditions\".\"blob_file_name\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"blob_renditions\".\"updated_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"blob_renditions\".\"created_at\""},
+}
+
+// BlobRenditionRels is where relationship names are stored.
+var BlobRenditionRels = struct {
+}{}
+
+// blobRenditionR is where relationships are stored.
+type blobRenditionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*blobRenditionR) NewStruct() *blobRenditionR {
+	return &blobRenditionR{}
+}
+
+// blobRenditionL is where Load methods for each relationship are stored.
+type blobRenditionL struct{}
+
+var (
+	blobRenditionAllColumns            = []string{"id", "parent_file_name", "size", "blob_file_name", "updated_at", "created_at"}
+	blobRenditionColumnsWithoutDefault = []string{"parent_file_name", "size", "blob_file_name"}
+	blobRenditionColumnsWithDefault    = []string{"id", "updated_at", "created_at"}
+	blobRenditionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BlobRenditionSlice is an alias for a slice of pointers to BlobRendition.
+	// This should generally be used opposed to []BlobRendition.
+	BlobRenditionSlice []*BlobRendition
+	// BlobRenditionHook is the signature for custom BlobRendition hook methods
+	BlobRenditionHook func(boil.Executor, *BlobRendition) error
+
+	blobRenditionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	blobRenditionType                 = reflect.TypeOf(&BlobRendition{})
+	blobRenditionMapping              = queries.MakeStructMapping(blobRenditionType)
+	blobRenditionPrimaryKeyMapping, _ = queries.BindMapping(blobRenditionType, blobRenditionMapping, blobRenditionPrimaryKeyColumns)
+	blobRenditionInsertCacheMut       sync.RWMutex
+	blobRenditionInsertCache          = make(map[string]insertCache)
+	blobRenditionUpdateCacheMut       sync.RWMutex
+	blobRenditionUpdateCache          = make(map[string]updateCache)
+	blobRenditionUpsertCacheMut       sync.RWMutex
+	blobRenditionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var blobRenditionBeforeInsertHooks []BlobRenditionHook
+var blobRenditionBeforeUpdateHooks []BlobRenditionHook
+var blobRenditionBeforeDeleteHooks []BlobRenditionHook
+var blobRenditionBeforeUpsertHooks []BlobRenditionHook
+
+var blobRenditionAfterInsertHooks []BlobRenditionHook
+var blobRenditionAfterSelectHooks []BlobRenditionHook
+var blobRenditionAfterUpdateHooks []BlobRenditionHook
+var blobRenditionAfterDeleteHooks []BlobRenditionHook
+var blobRenditionAfterUpsertHooks []BlobRenditionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BlobRendition) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BlobRendition) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BlobRendition) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BlobRendition) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BlobRendition) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BlobRendition) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BlobRendition) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BlobRendition) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BlobRendition) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range blobRenditionAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBlobRenditionHook registers your hook function for all future operations.
+func AddBlobRenditionHook(hookPoint boil.HookPoint, blobRenditionHook BlobRenditionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		blobRenditionBeforeInsertHooks = append(blobRenditionBeforeInsertHooks, blobRenditionHook)
+	case boil.BeforeUpdateHook:
+		blobRenditionBeforeUpdateHooks = append(blobRenditionBeforeUpdateHooks, blobRenditionHook)
+	case boil.BeforeDeleteHook:
+		blobRenditionBeforeDeleteHooks = append(blobRenditionBeforeDeleteHooks, blobRenditionHook)
+	case boil.BeforeUpsertHook:
+		blobRenditionBeforeUpsertHooks = append(blobRenditionBeforeUpsertHooks, blobRenditionHook)
+	case boil.AfterInsertHook:
+		blobRenditionAfterInsertHooks = append(blobRenditionAfterInsertHooks, blobRenditionHook)
+	case boil.AfterSelectHook:
+		blobRenditionAfterSelectHooks = append(blobRenditionAfterSelectHooks, blobRenditionHook)
+	case boil.AfterUpdateHook:
+		blobRenditionAfterUpdateHooks = append(blobRenditionAfterUpdateHooks, blobRenditionHook)
+	case boil.AfterDeleteHook:
+		blobRenditionAfterDeleteHooks = append(blobRenditionAfterDeleteHooks, blobRenditionHook)
+	case boil.AfterUpsertHook:
+		blobRenditionAfterUpsertHooks = append(blobRenditionAfterUpsertHooks, blobRenditionHook)
+	}
+}
+
+// OneG returns a single blobRendition record from the query using the global executor.
+func (q blobRenditionQuery) OneG() (*BlobRendition, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single blobRendition record from the query.
+func (q blobRenditionQuery) One(exec boil.Executor) (*BlobRendition, error) {
+	o := &BlobRendition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for blob_renditions")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all BlobRendition records from the query using the global executor.
+func (q blobRenditionQuery) AllG() (BlobRenditionSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all BlobRendition records from the query.
+func (q blobRenditionQuery) All(exec boil.Executor) (BlobRenditionSlice, error) {
+	var o []*BlobRendition
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to BlobRendition slice")
+	}
+
+	if len(blobRenditionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all BlobRendition records in the query, and panics on error.
+func (q blobRenditionQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all BlobRendition records in the query.
+func (q blobRenditionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count blob_renditions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q blobRenditionQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q blobRenditionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if blob_renditions exists")
+	}
+
+	return count > 0, nil
+}
+
+// BlobRenditions retrieves all the records using an executor.
+func BlobRenditions(mods ...qm.QueryMod) blobRenditionQuery {
+	mods = append(mods, qm.From("\"blob_renditions\""))
+	return blobRenditionQuery{NewQuery(mods...)}
+}
+
+// FindBlobRenditionG retrieves a single record by ID.
+func FindBlobRenditionG(iD int64, selectCols ...string) (*BlobRendition, error) {
+	return FindBlobRendition(boil.GetDB(), iD, selectCols...)
+}
+
+// FindBlobRendition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBlobRendition(exec boil.Executor, iD int64, selectCols ...string) (*BlobRendition, error) {
+	blobRenditionObj := &BlobRendition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"blob_renditions\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, blobRenditionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from blob_renditions")
+	}
+
+	return blobRenditionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *BlobRendition) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BlobRendition) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no blob_renditions provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(blobRenditionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	blobRenditionInsertCacheMut.RLock()
+	cache, cached := blobRenditionInsertCache[key]
+	blobRenditionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			blobRenditionAllColumns,
+			blobRenditionColumnsWithDefault,
+			blobRenditionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(blobRenditionType, blobRenditionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(blobRenditionType, blobRenditionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"blob_renditions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"blob_renditions\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"blob_renditions\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, blobRenditionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into blob_renditions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == blobRenditionMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for blob_renditions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		blobRenditionInsertCacheMut.Lock()
+		blobRenditionInsertCache[key] = cache
+		blobRenditionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single BlobRendition record using the global executor.
+// See Update for more documentation.
+func (o *BlobRendition) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the BlobRendition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BlobRendition) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	blobRenditionUpdateCacheMut.RLock()
+	cache, cached := blobRenditionUpdateCache[key]
+	blobRenditionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			blobRenditionAllColumns,
+			blobRenditionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update blob_renditions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"blob_renditions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, blobRenditionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(blobRenditionType, blobRenditionMapping, append(wl, blobRenditionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update blob_renditions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for blob_renditions")
+	}
+
+	if !cached {
+		blobRenditionUpdateCacheMut.Lock()
+		blobRenditionUpdateCache[key] = cache
+		blobRenditionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q blobRenditionQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q blobRenditionQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for blob_renditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for blob_renditions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o BlobRenditionSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BlobRenditionSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blobRenditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"blob_renditions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blobRenditionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in blobRendition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all blobRendition")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single BlobRendition record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *BlobRendition) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single BlobRendition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BlobRendition) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no BlobRendition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), blobRenditionPrimaryKeyMapping)
+	sql := "DELETE FROM \"blob_renditions\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from blob_renditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for blob_renditions")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q blobRenditionQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no blobRenditionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from blob_renditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for blob_renditions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o BlobRenditionSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BlobRenditionSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(blobRenditionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blobRenditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"blob_renditions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blobRenditionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from blobRendition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for blob_renditions")
+	}
+
+	if len(blobRenditionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *BlobRendition) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no BlobRendition provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BlobRendition) Reload(exec boil.Executor) error {
+	ret, err := FindBlobRendition(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BlobRenditionSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty BlobRenditionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BlobRenditionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BlobRenditionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), blobRenditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"blob_renditions\".* FROM \"blob_renditions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, blobRenditionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in BlobRenditionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BlobRenditionExistsG checks if the BlobRendition row exists.
+func BlobRenditionExistsG(iD int64) (bool, error) {
+	return BlobRenditionExists(boil.GetDB(), iD)
+}
+
+// BlobRenditionExists checks if the BlobRendition row exists.
+func BlobRenditionExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"blob_renditions\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if blob_renditions exists")
+	}
+
+	return exists, nil
+}
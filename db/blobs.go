@@ -22,7 +22,7 @@ import (
 
 // Blob is an object representing the database table.
 type Blob struct {
-	ID            null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ID            int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
 	FileName      string     `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
 	MimeType      string     `boil:"mime_type" json:"mime_type" toml:"mime_type" yaml:"mime_type"`
 	FileSizeBytes int64      `boil:"file_size_bytes" json:"file_size_bytes" toml:"file_size_bytes" yaml:"file_size_bytes"`
@@ -33,6 +33,7 @@ type Blob struct {
 	ArchivedAt    null.Time  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
 	UpdatedAt     time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	CreatedAt     time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Backend       string     `boil:"backend" json:"backend" toml:"backend" yaml:"backend"`
 
 	R *blobR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L blobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var BlobColumns = struct {
 	ArchivedAt    string
 	UpdatedAt     string
 	CreatedAt     string
+	Backend       string
 }{
 	ID:            "id",
 	FileName:      "file_name",
@@ -62,6 +64,7 @@ var BlobColumns = struct {
 	ArchivedAt:    "archived_at",
 	UpdatedAt:     "updated_at",
 	CreatedAt:     "created_at",
+	Backend:       "backend",
 }
 
 // Generated where
@@ -76,7 +79,7 @@ func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.f
 func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var BlobWhere = struct {
-	ID            whereHelpernull_Int64
+	ID            whereHelperint64
 	FileName      whereHelperstring
 	MimeType      whereHelperstring
 	FileSizeBytes whereHelperint64
@@ -87,8 +90,9 @@ var BlobWhere = struct {
 	ArchivedAt    whereHelpernull_Time
 	UpdatedAt     whereHelpertime_Time
 	CreatedAt     whereHelpertime_Time
+	Backend       whereHelperstring
 }{
-	ID:            whereHelpernull_Int64{field: "\"blobs\".\"id\""},
+	ID:            whereHelperint64{field: "\"blobs\".\"id\""},
 	FileName:      whereHelperstring{field: "\"blobs\".\"file_name\""},
 	MimeType:      whereHelperstring{field: "\"blobs\".\"mime_type\""},
 	FileSizeBytes: whereHelperint64{field: "\"blobs\".\"file_size_bytes\""},
@@ -99,6 +103,7 @@ var BlobWhere = struct {
 	ArchivedAt:    whereHelpernull_Time{field: "\"blobs\".\"archived_at\""},
 	UpdatedAt:     whereHelpertime_Time{field: "\"blobs\".\"updated_at\""},
 	CreatedAt:     whereHelpertime_Time{field: "\"blobs\".\"created_at\""},
+	Backend:       whereHelperstring{field: "\"blobs\".\"backend\""},
 }
 
 // BlobRels is where relationship names are stored.
@@ -118,9 +123,9 @@ func (*blobR) NewStruct() *blobR {
 type blobL struct{}
 
 var (
-	blobAllColumns            = []string{"id", "file_name", "mime_type", "file_size_bytes", "EXTENSION", "file", "views", "archived", "archived_at", "updated_at", "created_at"}
+	blobAllColumns            = []string{"id", "file_name", "mime_type", "file_size_bytes", "EXTENSION", "file", "views", "archived", "archived_at", "updated_at", "created_at", "backend"}
 	blobColumnsWithoutDefault = []string{"file_name", "mime_type", "file_size_bytes", "EXTENSION", "file", "archived_at"}
-	blobColumnsWithDefault    = []string{"id", "views", "archived", "updated_at", "created_at"}
+	blobColumnsWithDefault    = []string{"id", "views", "archived", "updated_at", "created_at", "backend"}
 	blobPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -390,13 +395,13 @@ func Blobs(mods ...qm.QueryMod) blobQuery {
 }
 
 // FindBlobG retrieves a single record by ID.
-func FindBlobG(iD null.Int64, selectCols ...string) (*Blob, error) {
+func FindBlobG(iD int64, selectCols ...string) (*Blob, error) {
 	return FindBlob(boil.GetDB(), iD, selectCols...)
 }
 
 // FindBlob retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindBlob(exec boil.Executor, iD null.Int64, selectCols ...string) (*Blob, error) {
+func FindBlob(exec boil.Executor, iD int64, selectCols ...string) (*Blob, error) {
 	blobObj := &Blob{}
 
 	sel := "*"
@@ -492,18 +497,29 @@ func (o *Blob) Insert(exec boil.Executor, columns boil.Columns) error {
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
 
-	_, err = exec.Exec(cache.query, vals...)
+	result, err := exec.Exec(cache.query, vals...)
 
 	if err != nil {
 		return errors.Wrap(err, "db: unable to insert into blobs")
 	}
 
+	var lastID int64
 	var identifierCols []interface{}
 
 	if len(cache.retMapping) == 0 {
 		goto CacheNoHooks
 	}
 
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == blobMapping["ID"] {
+		goto CacheNoHooks
+	}
+
 	identifierCols = []interface{}{
 		o.ID,
 	}
@@ -854,12 +870,12 @@ func (o *BlobSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // BlobExistsG checks if the Blob row exists.
-func BlobExistsG(iD null.Int64) (bool, error) {
+func BlobExistsG(iD int64) (bool, error) {
 	return BlobExists(boil.GetDB(), iD)
 }
 
 // BlobExists checks if the Blob row exists.
-func BlobExists(exec boil.Executor, iD null.Int64) (bool, error) {
+func BlobExists(exec boil.Executor, iD int64) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"blobs\" where \"id\"=? limit 1)"
 
@@ -4,15 +4,41 @@
 package db
 
 var TableNames = struct {
-	Attendance   string
-	Blobs        string
-	Friends      string
-	Integrations string
-	Users        string
+	Attendance        string
+	AuditLog          string
+	BlobRenditions    string
+	Blobs             string
+	ClassSessions     string
+	Enrolments        string
+	Friends           string
+	Integrations      string
+	LocationSamples   string
+	ManualAttendance  string
+	ReprocessJobs     string
+	Rosters           string
+	Schedules         string
+	TrackerHeartbeats string
+	Users             string
+	WebhookDeliveries string
+	Webhooks          string
+	Worlds            string
 }{
-	Attendance:   "attendance",
-	Blobs:        "blobs",
-	Friends:      "friends",
-	Integrations: "integrations",
-	Users:        "users",
+	Attendance:        "attendance",
+	AuditLog:          "audit_log",
+	BlobRenditions:    "blob_renditions",
+	Blobs:             "blobs",
+	ClassSessions:     "class_sessions",
+	Enrolments:        "enrolments",
+	Friends:           "friends",
+	Integrations:      "integrations",
+	LocationSamples:   "location_samples",
+	ManualAttendance:  "manual_attendance",
+	ReprocessJobs:     "reprocess_jobs",
+	Rosters:           "rosters",
+	Schedules:         "schedules",
+	TrackerHeartbeats: "tracker_heartbeats",
+	Users:             "users",
+	WebhookDeliveries: "webhook_deliveries",
+	Webhooks:          "webhooks",
+	Worlds:            "worlds",
 }
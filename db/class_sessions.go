@@ -0,0 +1,1855 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// ClassSession is an object representing the database table.
+type ClassSession struct {
+	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID      int64       `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	ScheduleID         int64       `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	TeacherID          int64       `boil:"teacher_id" json:"teacher_id" toml:"teacher_id" yaml:"teacher_id"`
+	FriendID           int64       `boil:"friend_id" json:"friend_id" toml:"friend_id" yaml:"friend_id"`
+	StartsAt           time.Time   `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt             time.Time   `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	Status             string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	FirstSeenAt        null.Time   `boil:"first_seen_at" json:"first_seen_at,omitempty" toml:"first_seen_at" yaml:"first_seen_at,omitempty"`
+	LastSeenAt         null.Time   `boil:"last_seen_at" json:"last_seen_at,omitempty" toml:"last_seen_at" yaml:"last_seen_at,omitempty"`
+	Samples            int64       `boil:"samples" json:"samples" toml:"samples" yaml:"samples"`
+	MinutesPresent     int64       `boil:"minutes_present" json:"minutes_present" toml:"minutes_present" yaml:"minutes_present"`
+	MinPresencePercent int64       `boil:"min_presence_percent" json:"min_presence_percent" toml:"min_presence_percent" yaml:"min_presence_percent"`
+	LateAfterMinutes   int64       `boil:"late_after_minutes" json:"late_after_minutes" toml:"late_after_minutes" yaml:"late_after_minutes"`
+	LeftEarlyMinutes   int64       `boil:"left_early_minutes" json:"left_early_minutes" toml:"left_early_minutes" yaml:"left_early_minutes"`
+	EvaluatedAt        time.Time   `boil:"evaluated_at" json:"evaluated_at" toml:"evaluated_at" yaml:"evaluated_at"`
+	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ManualMinutes      int64       `boil:"manual_minutes" json:"manual_minutes" toml:"manual_minutes" yaml:"manual_minutes"`
+	OverrideStatus     null.String `boil:"override_status" json:"override_status,omitempty" toml:"override_status" yaml:"override_status,omitempty"`
+	OverrideReason     null.String `boil:"override_reason" json:"override_reason,omitempty" toml:"override_reason" yaml:"override_reason,omitempty"`
+	OverrideAuthorID   null.Int64  `boil:"override_author_id" json:"override_author_id,omitempty" toml:"override_author_id" yaml:"override_author_id,omitempty"`
+	OverriddenAt       null.Time   `boil:"overridden_at" json:"overridden_at,omitempty" toml:"overridden_at" yaml:"overridden_at,omitempty"`
+	GapMinutes         int64       `boil:"gap_minutes" json:"gap_minutes" toml:"gap_minutes" yaml:"gap_minutes"`
+
+	R *classSessionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L classSessionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClassSessionColumns = struct {
+	ID                 string
+	IntegrationID      string
+	ScheduleID         string
+	TeacherID          string
+	FriendID           string
+	StartsAt           string
+	EndsAt             string
+	Status             string
+	FirstSeenAt        string
+	LastSeenAt         string
+	Samples            string
+	MinutesPresent     string
+	MinPresencePercent string
+	LateAfterMinutes   string
+	LeftEarlyMinutes   string
+	EvaluatedAt        string
+	UpdatedAt          string
+	CreatedAt          string
+	ManualMinutes      string
+	OverrideStatus     string
+	OverrideReason     string
+	OverrideAuthorID   string
+	OverriddenAt       string
+	GapMinutes         string
+}{
+	ID:                 "id",
+	IntegrationID:      "integration_id",
+	ScheduleID:         "schedule_id",
+	TeacherID:          "teacher_id",
+	FriendID:           "friend_id",
+	StartsAt:           "starts_at",
+	EndsAt:             "ends_at",
+	Status:             "status",
+	FirstSeenAt:        "first_seen_at",
+	LastSeenAt:         "last_seen_at",
+	Samples:            "samples",
+	MinutesPresent:     "minutes_present",
+	MinPresencePercent: "min_presence_percent",
+	LateAfterMinutes:   "late_after_minutes",
+	LeftEarlyMinutes:   "left_early_minutes",
+	EvaluatedAt:        "evaluated_at",
+	UpdatedAt:          "updated_at",
+	CreatedAt:          "created_at",
+	ManualMinutes:      "manual_minutes",
+	OverrideStatus:     "override_status",
+	OverrideReason:     "override_reason",
+	OverrideAuthorID:   "override_author_id",
+	OverriddenAt:       "overridden_at",
+	GapMinutes:         "gap_minutes",
+}
+
+// Generated where
+
+var ClassSessionWhere = struct {
+	ID                 whereHelperint64
+	IntegrationID      whereHelperint64
+	ScheduleID         whereHelperint64
+	TeacherID          whereHelperint64
+	FriendID           whereHelperint64
+	StartsAt           whereHelpertime_Time
+	EndsAt             whereHelpertime_Time
+	Status             whereHelperstring
+	FirstSeenAt        whereHelpernull_Time
+	LastSeenAt         whereHelpernull_Time
+	Samples            whereHelperint64
+	MinutesPresent     whereHelperint64
+	MinPresencePercent whereHelperint64
+	LateAfterMinutes   whereHelperint64
+	LeftEarlyMinutes   whereHelperint64
+	EvaluatedAt        whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	CreatedAt          whereHelpertime_Time
+	ManualMinutes      whereHelperint64
+	OverrideStatus     whereHelpernull_String
+	OverrideReason     whereHelpernull_String
+	OverrideAuthorID   whereHelpernull_Int64
+	OverriddenAt       whereHelpernull_Time
+	GapMinutes         whereHelperint64
+}{
+	ID:                 whereHelperint64{field: "\"class_sessions\".\"id\""},
+	IntegrationID:      whereHelperint64{field: "\"class_sessions\".\"integration_id\""},
+	ScheduleID:         whereHelperint64{field: "\"class_sessions\".\"schedule_id\""},
+	TeacherID:          whereHelperint64{field: "\"class_sessions\".\"teacher_id\""},
+	FriendID:           whereHelperint64{field: "\"class_sessions\".\"friend_id\""},
+	StartsAt:           whereHelpertime_Time{field: "\"class_sessions\".\"starts_at\""},
+	EndsAt:             whereHelpertime_Time{field: "\"class_sessions\".\"ends_at\""},
+	Status:             whereHelperstring{field: "\"class_sessions\".\"status\""},
+	FirstSeenAt:        whereHelpernull_Time{field: "\"class_sessions\".\"first_seen_at\""},
+	LastSeenAt:         whereHelpernull_Time{field: "\"class_sessions\".\"last_seen_at\""},
+	Samples:            whereHelperint64{field: "\"class_sessions\".\"samples\""},
+	MinutesPresent:     whereHelperint64{field: "\"class_sessions\".\"minutes_present\""},
+	MinPresencePercent: whereHelperint64{field: "\"class_sessions\".\"min_presence_percent\""},
+	LateAfterMinutes:   whereHelperint64{field: "\"class_sessions\".\"late_after_minutes\""},
+	LeftEarlyMinutes:   whereHelperint64{field: "\"class_sessions\".\"left_early_minutes\""},
+	EvaluatedAt:        whereHelpertime_Time{field: "\"class_sessions\".\"evaluated_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"class_sessions\".\"updated_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"class_sessions\".\"created_at\""},
+	ManualMinutes:      whereHelperint64{field: "\"class_sessions\".\"manual_minutes\""},
+	OverrideStatus:     whereHelpernull_String{field: "\"class_sessions\".\"override_status\""},
+	OverrideReason:     whereHelpernull_String{field: "\"class_sessions\".\"override_reason\""},
+	OverrideAuthorID:   whereHelpernull_Int64{field: "\"class_sessions\".\"override_author_id\""},
+	OverriddenAt:       whereHelpernull_Time{field: "\"class_sessions\".\"overridden_at\""},
+	GapMinutes:         whereHelperint64{field: "\"class_sessions\".\"gap_minutes\""},
+}
+
+// ClassSessionRels is where relationship names are stored.
+var ClassSessionRels = struct {
+	OverrideAuthor string
+	Friend         string
+	Teacher        string
+	Schedule       string
+	Integration    string
+}{
+	OverrideAuthor: "OverrideAuthor",
+	Friend:         "Friend",
+	Teacher:        "Teacher",
+	Schedule:       "Schedule",
+	Integration:    "Integration",
+}
+
+// classSessionR is where relationships are stored.
+type classSessionR struct {
+	OverrideAuthor *User
+	Friend         *Friend
+	Teacher        *Friend
+	Schedule       *Schedule
+	Integration    *Integration
+}
+
+// NewStruct creates a new relationship struct
+func (*classSessionR) NewStruct() *classSessionR {
+	return &classSessionR{}
+}
+
+// classSessionL is where Load methods for each relationship are stored.
+type classSessionL struct{}
+
+var (
+	classSessionAllColumns            = []string{"id", "integration_id", "schedule_id", "teacher_id", "friend_id", "starts_at", "ends_at", "status", "first_seen_at", "last_seen_at", "samples", "minutes_present", "min_presence_percent", "late_after_minutes", "left_early_minutes", "evaluated_at", "updated_at", "created_at", "manual_minutes", "override_status", "override_reason", "override_author_id", "overridden_at", "gap_minutes"}
+	classSessionColumnsWithoutDefault = []string{"integration_id", "schedule_id", "teacher_id", "friend_id", "starts_at", "ends_at", "status", "first_seen_at", "last_seen_at", "min_presence_percent", "late_after_minutes", "left_early_minutes", "override_status", "override_reason", "override_author_id", "overridden_at"}
+	classSessionColumnsWithDefault    = []string{"id", "samples", "minutes_present", "evaluated_at", "updated_at", "created_at", "manual_minutes", "gap_minutes"}
+	classSessionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ClassSessionSlice is an alias for a slice of pointers to ClassSession.
+	// This should generally be used opposed to []ClassSession.
+	ClassSessionSlice []*ClassSession
+	// ClassSessionHook is the signature for custom ClassSession hook methods
+	ClassSessionHook func(boil.Executor, *ClassSession) error
+
+	classSessionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	classSessionType                 = reflect.TypeOf(&ClassSession{})
+	classSessionMapping              = queries.MakeStructMapping(classSessionType)
+	classSessionPrimaryKeyMapping, _ = queries.BindMapping(classSessionType, classSessionMapping, classSessionPrimaryKeyColumns)
+	classSessionInsertCacheMut       sync.RWMutex
+	classSessionInsertCache          = make(map[string]insertCache)
+	classSessionUpdateCacheMut       sync.RWMutex
+	classSessionUpdateCache          = make(map[string]updateCache)
+	classSessionUpsertCacheMut       sync.RWMutex
+	classSessionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var classSessionBeforeInsertHooks []ClassSessionHook
+var classSessionBeforeUpdateHooks []ClassSessionHook
+var classSessionBeforeDeleteHooks []ClassSessionHook
+var classSessionBeforeUpsertHooks []ClassSessionHook
+
+var classSessionAfterInsertHooks []ClassSessionHook
+var classSessionAfterSelectHooks []ClassSessionHook
+var classSessionAfterUpdateHooks []ClassSessionHook
+var classSessionAfterDeleteHooks []ClassSessionHook
+var classSessionAfterUpsertHooks []ClassSessionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ClassSession) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ClassSession) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ClassSession) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ClassSession) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ClassSession) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ClassSession) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ClassSession) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ClassSession) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ClassSession) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range classSessionAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddClassSessionHook registers your hook function for all future operations.
+func AddClassSessionHook(hookPoint boil.HookPoint, classSessionHook ClassSessionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		classSessionBeforeInsertHooks = append(classSessionBeforeInsertHooks, classSessionHook)
+	case boil.BeforeUpdateHook:
+		classSessionBeforeUpdateHooks = append(classSessionBeforeUpdateHooks, classSessionHook)
+	case boil.BeforeDeleteHook:
+		classSessionBeforeDeleteHooks = append(classSessionBeforeDeleteHooks, classSessionHook)
+	case boil.BeforeUpsertHook:
+		classSessionBeforeUpsertHooks = append(classSessionBeforeUpsertHooks, classSessionHook)
+	case boil.AfterInsertHook:
+		classSessionAfterInsertHooks = append(classSessionAfterInsertHooks, classSessionHook)
+	case boil.AfterSelectHook:
+		classSessionAfterSelectHooks = append(classSessionAfterSelectHooks, classSessionHook)
+	case boil.AfterUpdateHook:
+		classSessionAfterUpdateHooks = append(classSessionAfterUpdateHooks, classSessionHook)
+	case boil.AfterDeleteHook:
+		classSessionAfterDeleteHooks = append(classSessionAfterDeleteHooks, classSessionHook)
+	case boil.AfterUpsertHook:
+		classSessionAfterUpsertHooks = append(classSessionAfterUpsertHooks, classSessionHook)
+	}
+}
+
+// OneG returns a single classSession record from the query using the global executor.
+func (q classSessionQuery) OneG() (*ClassSession, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single classSession record from the query.
+func (q classSessionQuery) One(exec boil.Executor) (*ClassSession, error) {
+	o := &ClassSession{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for class_sessions")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ClassSession records from the query using the global executor.
+func (q classSessionQuery) AllG() (ClassSessionSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all ClassSession records from the query.
+func (q classSessionQuery) All(exec boil.Executor) (ClassSessionSlice, error) {
+	var o []*ClassSession
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to ClassSession slice")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ClassSession records in the query, and panics on error.
+func (q classSessionQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all ClassSession records in the query.
+func (q classSessionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count class_sessions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q classSessionQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q classSessionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if class_sessions exists")
+	}
+
+	return count > 0, nil
+}
+
+// OverrideAuthor pointed to by the foreign key.
+func (o *ClassSession) OverrideAuthor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OverrideAuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Friend pointed to by the foreign key.
+func (o *ClassSession) Friend(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FriendID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Teacher pointed to by the foreign key.
+func (o *ClassSession) Teacher(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeacherID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Schedule pointed to by the foreign key.
+func (o *ClassSession) Schedule(mods ...qm.QueryMod) scheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Schedules(queryMods...)
+	queries.SetFrom(query.Query, "\"schedules\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *ClassSession) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// LoadOverrideAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classSessionL) LoadOverrideAuthor(e boil.Executor, singular bool, maybeClassSession interface{}, mods queries.Applicator) error {
+	var slice []*ClassSession
+	var object *ClassSession
+
+	if singular {
+		object = maybeClassSession.(*ClassSession)
+	} else {
+		slice = *maybeClassSession.(*[]*ClassSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classSessionR{}
+		}
+		if !queries.IsNil(object.OverrideAuthorID) {
+			args = append(args, object.OverrideAuthorID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classSessionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.OverrideAuthorID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.OverrideAuthorID) {
+				args = append(args, obj.OverrideAuthorID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`users`), qm.WhereIn(`users.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OverrideAuthor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OverrideAuthorClassSessions = append(foreign.R.OverrideAuthorClassSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OverrideAuthorID, foreign.ID) {
+				local.R.OverrideAuthor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OverrideAuthorClassSessions = append(foreign.R.OverrideAuthorClassSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFriend allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classSessionL) LoadFriend(e boil.Executor, singular bool, maybeClassSession interface{}, mods queries.Applicator) error {
+	var slice []*ClassSession
+	var object *ClassSession
+
+	if singular {
+		object = maybeClassSession.(*ClassSession)
+	} else {
+		slice = *maybeClassSession.(*[]*ClassSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classSessionR{}
+		}
+		args = append(args, object.FriendID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FriendID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FriendID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Friend = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.ClassSession = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FriendID == foreign.ID {
+				local.R.Friend = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.ClassSession = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacher allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classSessionL) LoadTeacher(e boil.Executor, singular bool, maybeClassSession interface{}, mods queries.Applicator) error {
+	var slice []*ClassSession
+	var object *ClassSession
+
+	if singular {
+		object = maybeClassSession.(*ClassSession)
+	} else {
+		slice = *maybeClassSession.(*[]*ClassSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classSessionR{}
+		}
+		args = append(args, object.TeacherID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TeacherID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TeacherID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Teacher = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.TeacherClassSessions = append(foreign.R.TeacherClassSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeacherID == foreign.ID {
+				local.R.Teacher = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.TeacherClassSessions = append(foreign.R.TeacherClassSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classSessionL) LoadSchedule(e boil.Executor, singular bool, maybeClassSession interface{}, mods queries.Applicator) error {
+	var slice []*ClassSession
+	var object *ClassSession
+
+	if singular {
+		object = maybeClassSession.(*ClassSession)
+	} else {
+		slice = *maybeClassSession.(*[]*ClassSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classSessionR{}
+		}
+		args = append(args, object.ScheduleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ScheduleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ScheduleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`schedules`), qm.WhereIn(`schedules.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Schedule")
+	}
+
+	var resultSlice []*Schedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Schedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for schedules")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &scheduleR{}
+		}
+		foreign.R.ClassSession = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &scheduleR{}
+				}
+				foreign.R.ClassSession = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (classSessionL) LoadIntegration(e boil.Executor, singular bool, maybeClassSession interface{}, mods queries.Applicator) error {
+	var slice []*ClassSession
+	var object *ClassSession
+
+	if singular {
+		object = maybeClassSession.(*ClassSession)
+	} else {
+		slice = *maybeClassSession.(*[]*ClassSession)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &classSessionR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &classSessionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.ClassSessions = append(foreign.R.ClassSessions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.ClassSessions = append(foreign.R.ClassSessions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOverrideAuthorG of the classSession to the related item.
+// Sets o.R.OverrideAuthor to related.
+// Adds o to related.R.OverrideAuthorClassSessions.
+// Uses the global database handle.
+func (o *ClassSession) SetOverrideAuthorG(insert bool, related *User) error {
+	return o.SetOverrideAuthor(boil.GetDB(), insert, related)
+}
+
+// SetOverrideAuthor of the classSession to the related item.
+// Sets o.R.OverrideAuthor to related.
+// Adds o to related.R.OverrideAuthorClassSessions.
+func (o *ClassSession) SetOverrideAuthor(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"override_author_id"}),
+		strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OverrideAuthorID, related.ID)
+	if o.R == nil {
+		o.R = &classSessionR{
+			OverrideAuthor: related,
+		}
+	} else {
+		o.R.OverrideAuthor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OverrideAuthorClassSessions: ClassSessionSlice{o},
+		}
+	} else {
+		related.R.OverrideAuthorClassSessions = append(related.R.OverrideAuthorClassSessions, o)
+	}
+
+	return nil
+}
+
+// RemoveOverrideAuthorG relationship.
+// Sets o.R.OverrideAuthor to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ClassSession) RemoveOverrideAuthorG(related *User) error {
+	return o.RemoveOverrideAuthor(boil.GetDB(), related)
+}
+
+// RemoveOverrideAuthor relationship.
+// Sets o.R.OverrideAuthor to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ClassSession) RemoveOverrideAuthor(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.OverrideAuthorID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("override_author_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.OverrideAuthor = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OverrideAuthorClassSessions {
+		if queries.Equal(o.OverrideAuthorID, ri.OverrideAuthorID) {
+			continue
+		}
+
+		ln := len(related.R.OverrideAuthorClassSessions)
+		if ln > 1 && i < ln-1 {
+			related.R.OverrideAuthorClassSessions[i] = related.R.OverrideAuthorClassSessions[ln-1]
+		}
+		related.R.OverrideAuthorClassSessions = related.R.OverrideAuthorClassSessions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetFriendG of the classSession to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.ClassSession.
+// Uses the global database handle.
+func (o *ClassSession) SetFriendG(insert bool, related *Friend) error {
+	return o.SetFriend(boil.GetDB(), insert, related)
+}
+
+// SetFriend of the classSession to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.ClassSession.
+func (o *ClassSession) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+		strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FriendID = related.ID
+	if o.R == nil {
+		o.R = &classSessionR{
+			Friend: related,
+		}
+	} else {
+		o.R.Friend = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			ClassSession: o,
+		}
+	} else {
+		related.R.ClassSession = o
+	}
+
+	return nil
+}
+
+// SetTeacherG of the classSession to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherClassSessions.
+// Uses the global database handle.
+func (o *ClassSession) SetTeacherG(insert bool, related *Friend) error {
+	return o.SetTeacher(boil.GetDB(), insert, related)
+}
+
+// SetTeacher of the classSession to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherClassSessions.
+func (o *ClassSession) SetTeacher(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+		strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeacherID = related.ID
+	if o.R == nil {
+		o.R = &classSessionR{
+			Teacher: related,
+		}
+	} else {
+		o.R.Teacher = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			TeacherClassSessions: ClassSessionSlice{o},
+		}
+	} else {
+		related.R.TeacherClassSessions = append(related.R.TeacherClassSessions, o)
+	}
+
+	return nil
+}
+
+// SetScheduleG of the classSession to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ClassSession.
+// Uses the global database handle.
+func (o *ClassSession) SetScheduleG(insert bool, related *Schedule) error {
+	return o.SetSchedule(boil.GetDB(), insert, related)
+}
+
+// SetSchedule of the classSession to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ClassSession.
+func (o *ClassSession) SetSchedule(exec boil.Executor, insert bool, related *Schedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &classSessionR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &scheduleR{
+			ClassSession: o,
+		}
+	} else {
+		related.R.ClassSession = o
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the classSession to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ClassSessions.
+// Uses the global database handle.
+func (o *ClassSession) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the classSession to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ClassSessions.
+func (o *ClassSession) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &classSessionR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			ClassSessions: ClassSessionSlice{o},
+		}
+	} else {
+		related.R.ClassSessions = append(related.R.ClassSessions, o)
+	}
+
+	return nil
+}
+
+// ClassSessions retrieves all the records using an executor.
+func ClassSessions(mods ...qm.QueryMod) classSessionQuery {
+	mods = append(mods, qm.From("\"class_sessions\""))
+	return classSessionQuery{NewQuery(mods...)}
+}
+
+// FindClassSessionG retrieves a single record by ID.
+func FindClassSessionG(iD int64, selectCols ...string) (*ClassSession, error) {
+	return FindClassSession(boil.GetDB(), iD, selectCols...)
+}
+
+// FindClassSession retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClassSession(exec boil.Executor, iD int64, selectCols ...string) (*ClassSession, error) {
+	classSessionObj := &ClassSession{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"class_sessions\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, classSessionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from class_sessions")
+	}
+
+	return classSessionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ClassSession) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ClassSession) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no class_sessions provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(classSessionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	classSessionInsertCacheMut.RLock()
+	cache, cached := classSessionInsertCache[key]
+	classSessionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			classSessionAllColumns,
+			classSessionColumnsWithDefault,
+			classSessionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(classSessionType, classSessionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(classSessionType, classSessionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"class_sessions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"class_sessions\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"class_sessions\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into class_sessions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == classSessionMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for class_sessions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		classSessionInsertCacheMut.Lock()
+		classSessionInsertCache[key] = cache
+		classSessionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single ClassSession record using the global executor.
+// See Update for more documentation.
+func (o *ClassSession) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the ClassSession.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ClassSession) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	classSessionUpdateCacheMut.RLock()
+	cache, cached := classSessionUpdateCache[key]
+	classSessionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			classSessionAllColumns,
+			classSessionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update class_sessions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"class_sessions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(classSessionType, classSessionMapping, append(wl, classSessionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update class_sessions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for class_sessions")
+	}
+
+	if !cached {
+		classSessionUpdateCacheMut.Lock()
+		classSessionUpdateCache[key] = cache
+		classSessionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q classSessionQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q classSessionQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for class_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for class_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ClassSessionSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClassSessionSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"class_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, classSessionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in classSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all classSession")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single ClassSession record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ClassSession) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single ClassSession record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ClassSession) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no ClassSession provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), classSessionPrimaryKeyMapping)
+	sql := "DELETE FROM \"class_sessions\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from class_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for class_sessions")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q classSessionQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no classSessionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from class_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for class_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ClassSessionSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClassSessionSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(classSessionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"class_sessions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, classSessionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from classSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for class_sessions")
+	}
+
+	if len(classSessionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ClassSession) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no ClassSession provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ClassSession) Reload(exec boil.Executor) error {
+	ret, err := FindClassSession(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassSessionSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty ClassSessionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClassSessionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClassSessionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), classSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"class_sessions\".* FROM \"class_sessions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, classSessionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in ClassSessionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClassSessionExistsG checks if the ClassSession row exists.
+func ClassSessionExistsG(iD int64) (bool, error) {
+	return ClassSessionExists(boil.GetDB(), iD)
+}
+
+// ClassSessionExists checks if the ClassSession row exists.
+func ClassSessionExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"class_sessions\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if class_sessions exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1207 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// Enrolment is an object representing the database table.
+type Enrolment struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RosterID  int64     `boil:"roster_id" json:"roster_id" toml:"roster_id" yaml:"roster_id"`
+	FriendID  int64     `boil:"friend_id" json:"friend_id" toml:"friend_id" yaml:"friend_id"`
+	Tags      string    `boil:"tags" json:"tags" toml:"tags" yaml:"tags"`
+	Notes     string    `boil:"notes" json:"notes" toml:"notes" yaml:"notes"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *enrolmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L enrolmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EnrolmentColumns = struct {
+	ID        string
+	RosterID  string
+	FriendID  string
+	Tags      string
+	Notes     string
+	UpdatedAt string
+	CreatedAt string
+}{
+	ID:        "id",
+	RosterID:  "roster_id",
+	FriendID:  "friend_id",
+	Tags:      "tags",
+	Notes:     "notes",
+	UpdatedAt: "updated_at",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var EnrolmentWhere = struct {
+	ID        whereHelperint64
+	RosterID  whereHelperint64
+	FriendID  whereHelperint64
+	Tags      whereHelperstring
+	Notes     whereHelperstring
+	UpdatedAt whereHelpertime_Time
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"enrolments\".\"id\""},
+	RosterID:  whereHelperint64{field: "\"enrolments\".\"roster_id\""},
+	FriendID:  whereHelperint64{field: "\"enrolments\".\"friend_id\""},
+	Tags:      whereHelperstring{field: "\"enrolments\".\"tags\""},
+	Notes:     whereHelperstring{field: "\"enrolments\".\"notes\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"enrolments\".\"updated_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"enrolments\".\"created_at\""},
+}
+
+// EnrolmentRels is where relationship names are stored.
+var EnrolmentRels = struct {
+	Friend string
+	Roster string
+}{
+	Friend: "Friend",
+	Roster: "Roster",
+}
+
+// enrolmentR is where relationships are stored.
+type enrolmentR struct {
+	Friend *Friend
+	Roster *Roster
+}
+
+// NewStruct creates a new relationship struct
+func (*enrolmentR) NewStruct() *enrolmentR {
+	return &enrolmentR{}
+}
+
+// enrolmentL is where Load methods for each relationship are stored.
+type enrolmentL struct{}
+
+var (
+	enrolmentAllColumns            = []string{"id", "roster_id", "friend_id", "tags", "notes", "updated_at", "created_at"}
+	enrolmentColumnsWithoutDefault = []string{"roster_id", "friend_id"}
+	enrolmentColumnsWithDefault    = []string{"id", "tags", "notes", "updated_at", "created_at"}
+	enrolmentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// EnrolmentSlice is an alias for a slice of pointers to Enrolment.
+	// This should generally be used opposed to []Enrolment.
+	EnrolmentSlice []*Enrolment
+	// EnrolmentHook is the signature for custom Enrolment hook methods
+	EnrolmentHook func(boil.Executor, *Enrolment) error
+
+	enrolmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	enrolmentType                 = reflect.TypeOf(&Enrolment{})
+	enrolmentMapping              = queries.MakeStructMapping(enrolmentType)
+	enrolmentPrimaryKeyMapping, _ = queries.BindMapping(enrolmentType, enrolmentMapping, enrolmentPrimaryKeyColumns)
+	enrolmentInsertCacheMut       sync.RWMutex
+	enrolmentInsertCache          = make(map[string]insertCache)
+	enrolmentUpdateCacheMut       sync.RWMutex
+	enrolmentUpdateCache          = make(map[string]updateCache)
+	enrolmentUpsertCacheMut       sync.RWMutex
+	enrolmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var enrolmentBeforeInsertHooks []EnrolmentHook
+var enrolmentBeforeUpdateHooks []EnrolmentHook
+var enrolmentBeforeDeleteHooks []EnrolmentHook
+var enrolmentBeforeUpsertHooks []EnrolmentHook
+
+var enrolmentAfterInsertHooks []EnrolmentHook
+var enrolmentAfterSelectHooks []EnrolmentHook
+var enrolmentAfterUpdateHooks []EnrolmentHook
+var enrolmentAfterDeleteHooks []EnrolmentHook
+var enrolmentAfterUpsertHooks []EnrolmentHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Enrolment) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Enrolment) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Enrolment) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Enrolment) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Enrolment) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Enrolment) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Enrolment) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Enrolment) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Enrolment) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range enrolmentAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEnrolmentHook registers your hook function for all future operations.
+func AddEnrolmentHook(hookPoint boil.HookPoint, enrolmentHook EnrolmentHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		enrolmentBeforeInsertHooks = append(enrolmentBeforeInsertHooks, enrolmentHook)
+	case boil.BeforeUpdateHook:
+		enrolmentBeforeUpdateHooks = append(enrolmentBeforeUpdateHooks, enrolmentHook)
+	case boil.BeforeDeleteHook:
+		enrolmentBeforeDeleteHooks = append(enrolmentBeforeDeleteHooks, enrolmentHook)
+	case boil.BeforeUpsertHook:
+		enrolmentBeforeUpsertHooks = append(enrolmentBeforeUpsertHooks, enrolmentHook)
+	case boil.AfterInsertHook:
+		enrolmentAfterInsertHooks = append(enrolmentAfterInsertHooks, enrolmentHook)
+	case boil.AfterSelectHook:
+		enrolmentAfterSelectHooks = append(enrolmentAfterSelectHooks, enrolmentHook)
+	case boil.AfterUpdateHook:
+		enrolmentAfterUpdateHooks = append(enrolmentAfterUpdateHooks, enrolmentHook)
+	case boil.AfterDeleteHook:
+		enrolmentAfterDeleteHooks = append(enrolmentAfterDeleteHooks, enrolmentHook)
+	case boil.AfterUpsertHook:
+		enrolmentAfterUpsertHooks = append(enrolmentAfterUpsertHooks, enrolmentHook)
+	}
+}
+
+// OneG returns a single enrolment record from the query using the global executor.
+func (q enrolmentQuery) OneG() (*Enrolment, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single enrolment record from the query.
+func (q enrolmentQuery) One(exec boil.Executor) (*Enrolment, error) {
+	o := &Enrolment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for enrolments")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Enrolment records from the query using the global executor.
+func (q enrolmentQuery) AllG() (EnrolmentSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Enrolment records from the query.
+func (q enrolmentQuery) All(exec boil.Executor) (EnrolmentSlice, error) {
+	var o []*Enrolment
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to Enrolment slice")
+	}
+
+	if len(enrolmentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Enrolment records in the query, and panics on error.
+func (q enrolmentQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Enrolment records in the query.
+func (q enrolmentQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count enrolments rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q enrolmentQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q enrolmentQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if enrolments exists")
+	}
+
+	return count > 0, nil
+}
+
+// Friend pointed to by the foreign key.
+func (o *Enrolment) Friend(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FriendID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Roster pointed to by the foreign key.
+func (o *Enrolment) Roster(mods ...qm.QueryMod) rosterQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RosterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Rosters(queryMods...)
+	queries.SetFrom(query.Query, "\"rosters\"")
+
+	return query
+}
+
+// LoadFriend allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (enrolmentL) LoadFriend(e boil.Executor, singular bool, maybeEnrolment interface{}, mods queries.Applicator) error {
+	var slice []*Enrolment
+	var object *Enrolment
+
+	if singular {
+		object = maybeEnrolment.(*Enrolment)
+	} else {
+		slice = *maybeEnrolment.(*[]*Enrolment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &enrolmentR{}
+		}
+		args = append(args, object.FriendID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &enrolmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FriendID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FriendID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(enrolmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Friend = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.Enrolment = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FriendID == foreign.ID {
+				local.R.Friend = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.Enrolment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoster allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (enrolmentL) LoadRoster(e boil.Executor, singular bool, maybeEnrolment interface{}, mods queries.Applicator) error {
+	var slice []*Enrolment
+	var object *Enrolment
+
+	if singular {
+		object = maybeEnrolment.(*Enrolment)
+	} else {
+		slice = *maybeEnrolment.(*[]*Enrolment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &enrolmentR{}
+		}
+		args = append(args, object.RosterID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &enrolmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RosterID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RosterID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`rosters`), qm.WhereIn(`rosters.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Roster")
+	}
+
+	var resultSlice []*Roster
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Roster")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for rosters")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rosters")
+	}
+
+	if len(enrolmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Roster = foreign
+		if foreign.R == nil {
+			foreign.R = &rosterR{}
+		}
+		foreign.R.Enrolment = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RosterID == foreign.ID {
+				local.R.Roster = foreign
+				if foreign.R == nil {
+					foreign.R = &rosterR{}
+				}
+				foreign.R.Enrolment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFriendG of the enrolment to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.Enrolment.
+// Uses the global database handle.
+func (o *Enrolment) SetFriendG(insert bool, related *Friend) error {
+	return o.SetFriend(boil.GetDB(), insert, related)
+}
+
+// SetFriend of the enrolment to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.Enrolment.
+func (o *Enrolment) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"enrolments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+		strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FriendID = related.ID
+	if o.R == nil {
+		o.R = &enrolmentR{
+			Friend: related,
+		}
+	} else {
+		o.R.Friend = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			Enrolment: o,
+		}
+	} else {
+		related.R.Enrolment = o
+	}
+
+	return nil
+}
+
+// SetRosterG of the enrolment to the related item.
+// Sets o.R.Roster to related.
+// Adds o to related.R.Enrolment.
+// Uses the global database handle.
+func (o *Enrolment) SetRosterG(insert bool, related *Roster) error {
+	return o.SetRoster(boil.GetDB(), insert, related)
+}
+
+// SetRoster of the enrolment to the related item.
+// Sets o.R.Roster to related.
+// Adds o to related.R.Enrolment.
+func (o *Enrolment) SetRoster(exec boil.Executor, insert bool, related *Roster) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"enrolments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"roster_id"}),
+		strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RosterID = related.ID
+	if o.R == nil {
+		o.R = &enrolmentR{
+			Roster: related,
+		}
+	} else {
+		o.R.Roster = related
+	}
+
+	if related.R == nil {
+		related.R = &rosterR{
+			Enrolment: o,
+		}
+	} else {
+		related.R.Enrolment = o
+	}
+
+	return nil
+}
+
+// Enrolments retrieves all the records using an executor.
+func Enrolments(mods ...qm.QueryMod) enrolmentQuery {
+	mods = append(mods, qm.From("\"enrolments\""))
+	return enrolmentQuery{NewQuery(mods...)}
+}
+
+// FindEnrolmentG retrieves a single record by ID.
+func FindEnrolmentG(iD int64, selectCols ...string) (*Enrolment, error) {
+	return FindEnrolment(boil.GetDB(), iD, selectCols...)
+}
+
+// FindEnrolment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEnrolment(exec boil.Executor, iD int64, selectCols ...string) (*Enrolment, error) {
+	enrolmentObj := &Enrolment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"enrolments\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, enrolmentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from enrolments")
+	}
+
+	return enrolmentObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Enrolment) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Enrolment) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no enrolments provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(enrolmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	enrolmentInsertCacheMut.RLock()
+	cache, cached := enrolmentInsertCache[key]
+	enrolmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			enrolmentAllColumns,
+			enrolmentColumnsWithDefault,
+			enrolmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(enrolmentType, enrolmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(enrolmentType, enrolmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"enrolments\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"enrolments\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"enrolments\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into enrolments")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == enrolmentMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for enrolments")
+	}
+
+CacheNoHooks:
+	if !cached {
+		enrolmentInsertCacheMut.Lock()
+		enrolmentInsertCache[key] = cache
+		enrolmentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Enrolment record using the global executor.
+// See Update for more documentation.
+func (o *Enrolment) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Enrolment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Enrolment) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	enrolmentUpdateCacheMut.RLock()
+	cache, cached := enrolmentUpdateCache[key]
+	enrolmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			enrolmentAllColumns,
+			enrolmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update enrolments, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"enrolments\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(enrolmentType, enrolmentMapping, append(wl, enrolmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update enrolments row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for enrolments")
+	}
+
+	if !cached {
+		enrolmentUpdateCacheMut.Lock()
+		enrolmentUpdateCache[key] = cache
+		enrolmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q enrolmentQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q enrolmentQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for enrolments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for enrolments")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o EnrolmentSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EnrolmentSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), enrolmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"enrolments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, enrolmentPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in enrolment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all enrolment")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single Enrolment record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Enrolment) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Enrolment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Enrolment) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no Enrolment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), enrolmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"enrolments\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from enrolments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for enrolments")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q enrolmentQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no enrolmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from enrolments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for enrolments")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o EnrolmentSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EnrolmentSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(enrolmentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), enrolmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"enrolments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, enrolmentPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from enrolment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for enrolments")
+	}
+
+	if len(enrolmentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Enrolment) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no Enrolment provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Enrolment) Reload(exec boil.Executor) error {
+	ret, err := FindEnrolment(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EnrolmentSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty EnrolmentSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EnrolmentSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EnrolmentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), enrolmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"enrolments\".* FROM \"enrolments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, enrolmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in EnrolmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EnrolmentExistsG checks if the Enrolment row exists.
+func EnrolmentExistsG(iD int64) (bool, error) {
+	return EnrolmentExists(boil.GetDB(), iD)
+}
+
+// EnrolmentExists checks if the Enrolment row exists.
+func EnrolmentExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"enrolments\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if enrolments exists")
+	}
+
+	return exists, nil
+}
@@ -22,7 +22,7 @@ import (
 
 // Friend is an object representing the database table.
 type Friend struct {
-	ID                            null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ID                            int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
 	IntegrationID                 int64       `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
 	IsTeacher                     bool        `boil:"is_teacher" json:"is_teacher" toml:"is_teacher" yaml:"is_teacher"`
 	VrchatID                      string      `boil:"vrchat_id" json:"vrchat_id" toml:"vrchat_id" yaml:"vrchat_id"`
@@ -36,6 +36,14 @@ type Friend struct {
 	ArchivedAt                    null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
 	UpdatedAt                     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	CreatedAt                     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	AvatarSourceURL               null.String `boil:"avatar_source_url" json:"avatar_source_url,omitempty" toml:"avatar_source_url" yaml:"avatar_source_url,omitempty"`
+	AvatarEtag                    null.String `boil:"avatar_etag" json:"avatar_etag,omitempty" toml:"avatar_etag" yaml:"avatar_etag,omitempty"`
+	AvatarLastModified            null.String `boil:"avatar_last_modified" json:"avatar_last_modified,omitempty" toml:"avatar_last_modified" yaml:"avatar_last_modified,omitempty"`
+	VrchatWorldID                 string      `boil:"vrchat_world_id" json:"vrchat_world_id" toml:"vrchat_world_id" yaml:"vrchat_world_id"`
+	VrchatInstanceID              string      `boil:"vrchat_instance_id" json:"vrchat_instance_id" toml:"vrchat_instance_id" yaml:"vrchat_instance_id"`
+	VrchatRegion                  string      `boil:"vrchat_region" json:"vrchat_region" toml:"vrchat_region" yaml:"vrchat_region"`
+	VrchatAccessType              string      `boil:"vrchat_access_type" json:"vrchat_access_type" toml:"vrchat_access_type" yaml:"vrchat_access_type"`
+	VrchatOwnerID                 string      `boil:"vrchat_owner_id" json:"vrchat_owner_id" toml:"vrchat_owner_id" yaml:"vrchat_owner_id"`
 
 	R *friendR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L friendL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +64,14 @@ var FriendColumns = struct {
 	ArchivedAt                    string
 	UpdatedAt                     string
 	CreatedAt                     string
+	AvatarSourceURL               string
+	AvatarEtag                    string
+	AvatarLastModified            string
+	VrchatWorldID                 string
+	VrchatInstanceID              string
+	VrchatRegion                  string
+	VrchatAccessType              string
+	VrchatOwnerID                 string
 }{
 	ID:                            "id",
 	IntegrationID:                 "integration_id",
@@ -71,35 +87,20 @@ var FriendColumns = struct {
 	ArchivedAt:                    "archived_at",
 	UpdatedAt:                     "updated_at",
 	CreatedAt:                     "created_at",
+	AvatarSourceURL:               "avatar_source_url",
+	AvatarEtag:                    "avatar_etag",
+	AvatarLastModified:            "avatar_last_modified",
+	VrchatWorldID:                 "vrchat_world_id",
+	VrchatInstanceID:              "vrchat_instance_id",
+	VrchatRegion:                  "vrchat_region",
+	VrchatAccessType:              "vrchat_access_type",
+	VrchatOwnerID:                 "vrchat_owner_id",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var FriendWhere = struct {
-	ID                            whereHelpernull_Int64
+	ID                            whereHelperint64
 	IntegrationID                 whereHelperint64
 	IsTeacher                     whereHelperbool
 	VrchatID                      whereHelperstring
@@ -113,8 +114,16 @@ var FriendWhere = struct {
 	ArchivedAt                    whereHelpernull_Time
 	UpdatedAt                     whereHelpertime_Time
 	CreatedAt                     whereHelpertime_Time
+	AvatarSourceURL               whereHelpernull_String
+	AvatarEtag                    whereHelpernull_String
+	AvatarLastModified            whereHelpernull_String
+	VrchatWorldID                 whereHelperstring
+	VrchatInstanceID              whereHelperstring
+	VrchatRegion                  whereHelperstring
+	VrchatAccessType              whereHelperstring
+	VrchatOwnerID                 whereHelperstring
 }{
-	ID:                            whereHelpernull_Int64{field: "\"friends\".\"id\""},
+	ID:                            whereHelperint64{field: "\"friends\".\"id\""},
 	IntegrationID:                 whereHelperint64{field: "\"friends\".\"integration_id\""},
 	IsTeacher:                     whereHelperbool{field: "\"friends\".\"is_teacher\""},
 	VrchatID:                      whereHelperstring{field: "\"friends\".\"vrchat_id\""},
@@ -128,24 +137,56 @@ var FriendWhere = struct {
 	ArchivedAt:                    whereHelpernull_Time{field: "\"friends\".\"archived_at\""},
 	UpdatedAt:                     whereHelpertime_Time{field: "\"friends\".\"updated_at\""},
 	CreatedAt:                     whereHelpertime_Time{field: "\"friends\".\"created_at\""},
+	AvatarSourceURL:               whereHelpernull_String{field: "\"friends\".\"avatar_source_url\""},
+	AvatarEtag:                    whereHelpernull_String{field: "\"friends\".\"avatar_etag\""},
+	AvatarLastModified:            whereHelpernull_String{field: "\"friends\".\"avatar_last_modified\""},
+	VrchatWorldID:                 whereHelperstring{field: "\"friends\".\"vrchat_world_id\""},
+	VrchatInstanceID:              whereHelperstring{field: "\"friends\".\"vrchat_instance_id\""},
+	VrchatRegion:                  whereHelperstring{field: "\"friends\".\"vrchat_region\""},
+	VrchatAccessType:              whereHelperstring{field: "\"friends\".\"vrchat_access_type\""},
+	VrchatOwnerID:                 whereHelperstring{field: "\"friends\".\"vrchat_owner_id\""},
 }
 
 // FriendRels is where relationship names are stored.
 var FriendRels = struct {
-	Integration        string
-	Attendance         string
-	TeacherAttendances string
+	Integration              string
+	TeacherAttendance        string
+	Attendance               string
+	ClassSession             string
+	Enrolment                string
+	LocationSample           string
+	TeacherClassSessions     string
+	ManualAttendances        string
+	TeacherManualAttendances string
+	TeacherRosters           string
+	TeacherSchedules         string
 }{
-	Integration:        "Integration",
-	Attendance:         "Attendance",
-	TeacherAttendances: "TeacherAttendances",
+	Integration:              "Integration",
+	TeacherAttendance:        "TeacherAttendance",
+	Attendance:               "Attendance",
+	ClassSession:             "ClassSession",
+	Enrolment:                "Enrolment",
+	LocationSample:           "LocationSample",
+	TeacherClassSessions:     "TeacherClassSessions",
+	ManualAttendances:        "ManualAttendances",
+	TeacherManualAttendances: "TeacherManualAttendances",
+	TeacherRosters:           "TeacherRosters",
+	TeacherSchedules:         "TeacherSchedules",
 }
 
 // friendR is where relationships are stored.
 type friendR struct {
-	Integration        *Integration
-	Attendance         *Attendance
-	TeacherAttendances AttendanceSlice
+	Integration              *Integration
+	TeacherAttendance        *Attendance
+	Attendance               *Attendance
+	ClassSession             *ClassSession
+	Enrolment                *Enrolment
+	LocationSample           *LocationSample
+	TeacherClassSessions     ClassSessionSlice
+	ManualAttendances        ManualAttendanceSlice
+	TeacherManualAttendances ManualAttendanceSlice
+	TeacherRosters           RosterSlice
+	TeacherSchedules         ScheduleSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -157,9 +198,9 @@ func (*friendR) NewStruct() *friendR {
 type friendL struct{}
 
 var (
-	friendAllColumns            = []string{"id", "integration_id", "is_teacher", "vrchat_id", "vrchat_username", "vrchat_display_name", "vrchat_avatar_image_url", "vrchat_avatar_thumbnail_image_url", "vrchat_location", "avatar_blob_filename", "archived", "archived_at", "updated_at", "created_at"}
-	friendColumnsWithoutDefault = []string{"integration_id", "vrchat_id", "vrchat_username", "vrchat_display_name", "vrchat_avatar_image_url", "vrchat_avatar_thumbnail_image_url", "vrchat_location", "avatar_blob_filename", "archived_at"}
-	friendColumnsWithDefault    = []string{"id", "is_teacher", "archived", "updated_at", "created_at"}
+	friendAllColumns            = []string{"id", "integration_id", "is_teacher", "vrchat_id", "vrchat_username", "vrchat_display_name", "vrchat_avatar_image_url", "vrchat_avatar_thumbnail_image_url", "vrchat_location", "avatar_blob_filename", "archived", "archived_at", "updated_at", "created_at", "avatar_source_url", "avatar_etag", "avatar_last_modified", "vrchat_world_id", "vrchat_instance_id", "vrchat_region", "vrchat_access_type", "vrchat_owner_id"}
+	friendColumnsWithoutDefault = []string{"integration_id", "vrchat_id", "vrchat_username", "vrchat_display_name", "vrchat_avatar_image_url", "vrchat_avatar_thumbnail_image_url", "vrchat_location", "avatar_blob_filename", "archived_at", "avatar_source_url", "avatar_etag", "avatar_last_modified"}
+	friendColumnsWithDefault    = []string{"id", "is_teacher", "archived", "updated_at", "created_at", "vrchat_world_id", "vrchat_instance_id", "vrchat_region", "vrchat_access_type", "vrchat_owner_id"}
 	friendPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -436,6 +477,20 @@ func (o *Friend) Integration(mods ...qm.QueryMod) integrationQuery {
 	return query
 }
 
+// TeacherAttendance pointed to by the foreign key.
+func (o *Friend) TeacherAttendance(mods ...qm.QueryMod) attendanceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"teacher_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Attendances(queryMods...)
+	queries.SetFrom(query.Query, "\"attendance\"")
+
+	return query
+}
+
 // Attendance pointed to by the foreign key.
 func (o *Friend) Attendance(mods ...qm.QueryMod) attendanceQuery {
 	queryMods := []qm.QueryMod{
@@ -450,22 +505,148 @@ func (o *Friend) Attendance(mods ...qm.QueryMod) attendanceQuery {
 	return query
 }
 
-// TeacherAttendances retrieves all the attendance's Attendances with an executor via teacher_id column.
-func (o *Friend) TeacherAttendances(mods ...qm.QueryMod) attendanceQuery {
+// ClassSession pointed to by the foreign key.
+func (o *Friend) ClassSession(mods ...qm.QueryMod) classSessionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"friend_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ClassSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"class_sessions\"")
+
+	return query
+}
+
+// Enrolment pointed to by the foreign key.
+func (o *Friend) Enrolment(mods ...qm.QueryMod) enrolmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"friend_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Enrolments(queryMods...)
+	queries.SetFrom(query.Query, "\"enrolments\"")
+
+	return query
+}
+
+// LocationSample pointed to by the foreign key.
+func (o *Friend) LocationSample(mods ...qm.QueryMod) locationSampleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"friend_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := LocationSamples(queryMods...)
+	queries.SetFrom(query.Query, "\"location_samples\"")
+
+	return query
+}
+
+// TeacherClassSessions retrieves all the class_session's ClassSessions with an executor via teacher_id column.
+func (o *Friend) TeacherClassSessions(mods ...qm.QueryMod) classSessionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"attendance\".\"teacher_id\"=?", o.ID),
+		qm.Where("\"class_sessions\".\"teacher_id\"=?", o.ID),
 	)
 
-	query := Attendances(queryMods...)
-	queries.SetFrom(query.Query, "\"attendance\"")
+	query := ClassSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"class_sessions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class_sessions\".*"})
+	}
+
+	return query
+}
+
+// ManualAttendances retrieves all the manual_attendance's ManualAttendances with an executor.
+func (o *Friend) ManualAttendances(mods ...qm.QueryMod) manualAttendanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"manual_attendance\".\"friend_id\"=?", o.ID),
+	)
+
+	query := ManualAttendances(queryMods...)
+	queries.SetFrom(query.Query, "\"manual_attendance\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"manual_attendance\".*"})
+	}
+
+	return query
+}
+
+// TeacherManualAttendances retrieves all the manual_attendance's ManualAttendances with an executor via teacher_id column.
+func (o *Friend) TeacherManualAttendances(mods ...qm.QueryMod) manualAttendanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"manual_attendance\".\"teacher_id\"=?", o.ID),
+	)
+
+	query := ManualAttendances(queryMods...)
+	queries.SetFrom(query.Query, "\"manual_attendance\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"manual_attendance\".*"})
+	}
+
+	return query
+}
+
+// TeacherRosters retrieves all the roster's Rosters with an executor via teacher_id column.
+func (o *Friend) TeacherRosters(mods ...qm.QueryMod) rosterQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rosters\".\"teacher_id\"=?", o.ID),
+	)
+
+	query := Rosters(queryMods...)
+	queries.SetFrom(query.Query, "\"rosters\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"attendance\".*"})
+		queries.SetSelect(query.Query, []string{"\"rosters\".*"})
+	}
+
+	return query
+}
+
+// TeacherSchedules retrieves all the schedule's Schedules with an executor via teacher_id column.
+func (o *Friend) TeacherSchedules(mods ...qm.QueryMod) scheduleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"schedules\".\"teacher_id\"=?", o.ID),
+	)
+
+	query := Schedules(queryMods...)
+	queries.SetFrom(query.Query, "\"schedules\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"schedules\".*"})
 	}
 
 	return query
@@ -488,9 +669,7 @@ func (friendL) LoadIntegration(e boil.Executor, singular bool, maybeFriend inter
 		if object.R == nil {
 			object.R = &friendR{}
 		}
-		if !queries.IsNil(object.IntegrationID) {
-			args = append(args, object.IntegrationID)
-		}
+		args = append(args, object.IntegrationID)
 
 	} else {
 	Outer:
@@ -500,14 +679,12 @@ func (friendL) LoadIntegration(e boil.Executor, singular bool, maybeFriend inter
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.IntegrationID) {
+				if a == obj.IntegrationID {
 					continue Outer
 				}
 			}
 
-			if !queries.IsNil(obj.IntegrationID) {
-				args = append(args, obj.IntegrationID)
-			}
+			args = append(args, obj.IntegrationID)
 
 		}
 	}
@@ -562,7 +739,7 @@ func (friendL) LoadIntegration(e boil.Executor, singular bool, maybeFriend inter
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.IntegrationID, foreign.ID) {
+			if local.IntegrationID == foreign.ID {
 				local.R.Integration = foreign
 				if foreign.R == nil {
 					foreign.R = &integrationR{}
@@ -576,9 +753,9 @@ func (friendL) LoadIntegration(e boil.Executor, singular bool, maybeFriend inter
 	return nil
 }
 
-// LoadAttendance allows an eager lookup of values, cached into the
+// LoadTeacherAttendance allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (friendL) LoadAttendance(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+func (friendL) LoadTeacherAttendance(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
 	var slice []*Friend
 	var object *Friend
 
@@ -615,7 +792,7 @@ func (friendL) LoadAttendance(e boil.Executor, singular bool, maybeFriend interf
 		return nil
 	}
 
-	query := NewQuery(qm.From(`attendance`), qm.WhereIn(`attendance.friend_id in ?`, args...))
+	query := NewQuery(qm.From(`attendance`), qm.WhereIn(`attendance.teacher_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -651,21 +828,21 @@ func (friendL) LoadAttendance(e boil.Executor, singular bool, maybeFriend interf
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Attendance = foreign
+		object.R.TeacherAttendance = foreign
 		if foreign.R == nil {
 			foreign.R = &attendanceR{}
 		}
-		foreign.R.Friend = object
+		foreign.R.Teacher = object
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.ID, foreign.FriendID) {
-				local.R.Attendance = foreign
+			if queries.Equal(local.ID, foreign.TeacherID) {
+				local.R.TeacherAttendance = foreign
 				if foreign.R == nil {
 					foreign.R = &attendanceR{}
 				}
-				foreign.R.Friend = local
+				foreign.R.Teacher = local
 				break
 			}
 		}
@@ -674,9 +851,9 @@ func (friendL) LoadAttendance(e boil.Executor, singular bool, maybeFriend interf
 	return nil
 }
 
-// LoadTeacherAttendances allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (friendL) LoadTeacherAttendances(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+// LoadAttendance allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (friendL) LoadAttendance(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
 	var slice []*Friend
 	var object *Friend
 
@@ -713,54 +890,57 @@ func (friendL) LoadTeacherAttendances(e boil.Executor, singular bool, maybeFrien
 		return nil
 	}
 
-	query := NewQuery(qm.From(`attendance`), qm.WhereIn(`attendance.teacher_id in ?`, args...))
+	query := NewQuery(qm.From(`attendance`), qm.WhereIn(`attendance.friend_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load attendance")
+		return errors.Wrap(err, "failed to eager load Attendance")
 	}
 
 	var resultSlice []*Attendance
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice attendance")
+		return errors.Wrap(err, "failed to bind eager loaded slice Attendance")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on attendance")
+		return errors.Wrap(err, "failed to close results of eager load for attendance")
 	}
 	if err = results.Err(); err != nil {
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attendance")
 	}
 
-	if len(attendanceAfterSelectHooks) != 0 {
+	if len(friendAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.TeacherAttendances = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &attendanceR{}
-			}
-			foreign.R.Teacher = object
+		foreign := resultSlice[0]
+		object.R.Attendance = foreign
+		if foreign.R == nil {
+			foreign.R = &attendanceR{}
 		}
-		return nil
+		foreign.R.Friend = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.TeacherID) {
-				local.R.TeacherAttendances = append(local.R.TeacherAttendances, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.FriendID) {
+				local.R.Attendance = foreign
 				if foreign.R == nil {
 					foreign.R = &attendanceR{}
 				}
-				foreign.R.Teacher = local
+				foreign.R.Friend = local
 				break
 			}
 		}
@@ -769,88 +949,1088 @@ func (friendL) LoadTeacherAttendances(e boil.Executor, singular bool, maybeFrien
 	return nil
 }
 
-// SetIntegrationG of the friend to the related item.
-// Sets o.R.Integration to related.
-// Adds o to related.R.Friend.
-// Uses the global database handle.
-func (o *Friend) SetIntegrationG(insert bool, related *Integration) error {
-	return o.SetIntegration(boil.GetDB(), insert, related)
-}
+// LoadClassSession allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (friendL) LoadClassSession(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
 
-// SetIntegration of the friend to the related item.
-// Sets o.R.Integration to related.
-// Adds o to related.R.Friend.
-func (o *Friend) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"friends\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
-		strmangle.WhereClause("\"", "\"", 0, friendPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
 	}
 
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if len(args) == 0 {
+		return nil
 	}
 
-	queries.Assign(&o.IntegrationID, related.ID)
-	if o.R == nil {
-		o.R = &friendR{
-			Integration: related,
-		}
-	} else {
-		o.R.Integration = related
+	query := NewQuery(qm.From(`class_sessions`), qm.WhereIn(`class_sessions.friend_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &integrationR{
-			Friend: o,
-		}
-	} else {
-		related.R.Friend = o
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ClassSession")
 	}
 
-	return nil
-}
+	var resultSlice []*ClassSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ClassSession")
+	}
 
-// SetAttendanceG of the friend to the related item.
-// Sets o.R.Attendance to related.
-// Adds o to related.R.Friend.
-// Uses the global database handle.
-func (o *Friend) SetAttendanceG(insert bool, related *Attendance) error {
-	return o.SetAttendance(boil.GetDB(), insert, related)
-}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_sessions")
+	}
 
-// SetAttendance of the friend to the related item.
-// Sets o.R.Attendance to related.
-// Adds o to related.R.Friend.
-func (o *Friend) SetAttendance(exec boil.Executor, insert bool, related *Attendance) error {
-	var err error
+	if len(friendAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ClassSession = foreign
+		if foreign.R == nil {
+			foreign.R = &classSessionR{}
+		}
+		foreign.R.Friend = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.FriendID {
+				local.R.ClassSession = foreign
+				if foreign.R == nil {
+					foreign.R = &classSessionR{}
+				}
+				foreign.R.Friend = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEnrolment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (friendL) LoadEnrolment(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`enrolments`), qm.WhereIn(`enrolments.friend_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Enrolment")
+	}
+
+	var resultSlice []*Enrolment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Enrolment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for enrolments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for enrolments")
+	}
+
+	if len(friendAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Enrolment = foreign
+		if foreign.R == nil {
+			foreign.R = &enrolmentR{}
+		}
+		foreign.R.Friend = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.FriendID {
+				local.R.Enrolment = foreign
+				if foreign.R == nil {
+					foreign.R = &enrolmentR{}
+				}
+				foreign.R.Friend = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLocationSample allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (friendL) LoadLocationSample(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`location_samples`), qm.WhereIn(`location_samples.friend_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LocationSample")
+	}
+
+	var resultSlice []*LocationSample
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LocationSample")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for location_samples")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location_samples")
+	}
+
+	if len(friendAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LocationSample = foreign
+		if foreign.R == nil {
+			foreign.R = &locationSampleR{}
+		}
+		foreign.R.Friend = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.FriendID {
+				local.R.LocationSample = foreign
+				if foreign.R == nil {
+					foreign.R = &locationSampleR{}
+				}
+				foreign.R.Friend = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacherClassSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (friendL) LoadTeacherClassSessions(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`class_sessions`), qm.WhereIn(`class_sessions.teacher_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class_sessions")
+	}
+
+	var resultSlice []*ClassSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class_sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_sessions")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeacherClassSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classSessionR{}
+			}
+			foreign.R.Teacher = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TeacherID {
+				local.R.TeacherClassSessions = append(local.R.TeacherClassSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &classSessionR{}
+				}
+				foreign.R.Teacher = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadManualAttendances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (friendL) LoadManualAttendances(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`manual_attendance`), qm.WhereIn(`manual_attendance.friend_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load manual_attendance")
+	}
+
+	var resultSlice []*ManualAttendance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice manual_attendance")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on manual_attendance")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for manual_attendance")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ManualAttendances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &manualAttendanceR{}
+			}
+			foreign.R.Friend = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FriendID {
+				local.R.ManualAttendances = append(local.R.ManualAttendances, foreign)
+				if foreign.R == nil {
+					foreign.R = &manualAttendanceR{}
+				}
+				foreign.R.Friend = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacherManualAttendances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (friendL) LoadTeacherManualAttendances(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`manual_attendance`), qm.WhereIn(`manual_attendance.teacher_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load manual_attendance")
+	}
+
+	var resultSlice []*ManualAttendance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice manual_attendance")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on manual_attendance")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for manual_attendance")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeacherManualAttendances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &manualAttendanceR{}
+			}
+			foreign.R.Teacher = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TeacherID {
+				local.R.TeacherManualAttendances = append(local.R.TeacherManualAttendances, foreign)
+				if foreign.R == nil {
+					foreign.R = &manualAttendanceR{}
+				}
+				foreign.R.Teacher = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacherRosters allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (friendL) LoadTeacherRosters(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`rosters`), qm.WhereIn(`rosters.teacher_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rosters")
+	}
+
+	var resultSlice []*Roster
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rosters")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rosters")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rosters")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeacherRosters = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rosterR{}
+			}
+			foreign.R.Teacher = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TeacherID {
+				local.R.TeacherRosters = append(local.R.TeacherRosters, foreign)
+				if foreign.R == nil {
+					foreign.R = &rosterR{}
+				}
+				foreign.R.Teacher = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacherSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (friendL) LoadTeacherSchedules(e boil.Executor, singular bool, maybeFriend interface{}, mods queries.Applicator) error {
+	var slice []*Friend
+	var object *Friend
+
+	if singular {
+		object = maybeFriend.(*Friend)
+	} else {
+		slice = *maybeFriend.(*[]*Friend)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &friendR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &friendR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`schedules`), qm.WhereIn(`schedules.teacher_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load schedules")
+	}
+
+	var resultSlice []*Schedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for schedules")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeacherSchedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scheduleR{}
+			}
+			foreign.R.Teacher = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TeacherID {
+				local.R.TeacherSchedules = append(local.R.TeacherSchedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &scheduleR{}
+				}
+				foreign.R.Teacher = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the friend to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Friend.
+// Uses the global database handle.
+func (o *Friend) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the friend to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Friend.
+func (o *Friend) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"friends\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, friendPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &friendR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			Friend: o,
+		}
+	} else {
+		related.R.Friend = o
+	}
+
+	return nil
+}
+
+// SetTeacherAttendanceG of the friend to the related item.
+// Sets o.R.TeacherAttendance to related.
+// Adds o to related.R.Teacher.
+// Uses the global database handle.
+func (o *Friend) SetTeacherAttendanceG(insert bool, related *Attendance) error {
+	return o.SetTeacherAttendance(boil.GetDB(), insert, related)
+}
+
+// SetTeacherAttendance of the friend to the related item.
+// Sets o.R.TeacherAttendance to related.
+// Adds o to related.R.Teacher.
+func (o *Friend) SetTeacherAttendance(exec boil.Executor, insert bool, related *Attendance) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.TeacherID, o.ID)
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"attendance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+			strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID, related.TeacherID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.TeacherID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &friendR{
+			TeacherAttendance: related,
+		}
+	} else {
+		o.R.TeacherAttendance = related
+	}
+
+	if related.R == nil {
+		related.R = &attendanceR{
+			Teacher: o,
+		}
+	} else {
+		related.R.Teacher = o
+	}
+	return nil
+}
+
+// RemoveTeacherAttendanceG relationship.
+// Sets o.R.TeacherAttendance to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Friend) RemoveTeacherAttendanceG(related *Attendance) error {
+	return o.RemoveTeacherAttendance(boil.GetDB(), related)
+}
+
+// RemoveTeacherAttendance relationship.
+// Sets o.R.TeacherAttendance to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Friend) RemoveTeacherAttendance(exec boil.Executor, related *Attendance) error {
+	var err error
+
+	queries.SetScanner(&related.TeacherID, nil)
+	if _, err = related.Update(exec, boil.Whitelist("teacher_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.TeacherAttendance = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Teacher = nil
+	return nil
+}
+
+// SetAttendanceG of the friend to the related item.
+// Sets o.R.Attendance to related.
+// Adds o to related.R.Friend.
+// Uses the global database handle.
+func (o *Friend) SetAttendanceG(insert bool, related *Attendance) error {
+	return o.SetAttendance(boil.GetDB(), insert, related)
+}
+
+// SetAttendance of the friend to the related item.
+// Sets o.R.Attendance to related.
+// Adds o to related.R.Friend.
+func (o *Friend) SetAttendance(exec boil.Executor, insert bool, related *Attendance) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.FriendID, o.ID)
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"attendance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+			strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID, related.TeacherID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.FriendID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &friendR{
+			Attendance: related,
+		}
+	} else {
+		o.R.Attendance = related
+	}
+
+	if related.R == nil {
+		related.R = &attendanceR{
+			Friend: o,
+		}
+	} else {
+		related.R.Friend = o
+	}
+	return nil
+}
+
+// RemoveAttendanceG relationship.
+// Sets o.R.Attendance to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Friend) RemoveAttendanceG(related *Attendance) error {
+	return o.RemoveAttendance(boil.GetDB(), related)
+}
+
+// RemoveAttendance relationship.
+// Sets o.R.Attendance to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Friend) RemoveAttendance(exec boil.Executor, related *Attendance) error {
+	var err error
+
+	queries.SetScanner(&related.FriendID, nil)
+	if _, err = related.Update(exec, boil.Whitelist("friend_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.Attendance = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Friend = nil
+	return nil
+}
+
+// SetClassSessionG of the friend to the related item.
+// Sets o.R.ClassSession to related.
+// Adds o to related.R.Friend.
+// Uses the global database handle.
+func (o *Friend) SetClassSessionG(insert bool, related *ClassSession) error {
+	return o.SetClassSession(boil.GetDB(), insert, related)
+}
+
+// SetClassSession of the friend to the related item.
+// Sets o.R.ClassSession to related.
+// Adds o to related.R.Friend.
+func (o *Friend) SetClassSession(exec boil.Executor, insert bool, related *ClassSession) error {
+	var err error
+
+	if insert {
+		related.FriendID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"class_sessions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+			strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.FriendID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &friendR{
+			ClassSession: related,
+		}
+	} else {
+		o.R.ClassSession = related
+	}
+
+	if related.R == nil {
+		related.R = &classSessionR{
+			Friend: o,
+		}
+	} else {
+		related.R.Friend = o
+	}
+	return nil
+}
+
+// SetEnrolmentG of the friend to the related item.
+// Sets o.R.Enrolment to related.
+// Adds o to related.R.Friend.
+// Uses the global database handle.
+func (o *Friend) SetEnrolmentG(insert bool, related *Enrolment) error {
+	return o.SetEnrolment(boil.GetDB(), insert, related)
+}
+
+// SetEnrolment of the friend to the related item.
+// Sets o.R.Enrolment to related.
+// Adds o to related.R.Friend.
+func (o *Friend) SetEnrolment(exec boil.Executor, insert bool, related *Enrolment) error {
+	var err error
 
 	if insert {
-		queries.Assign(&related.FriendID, o.ID)
+		related.FriendID = o.ID
 
 		if err = related.Insert(exec, boil.Infer()); err != nil {
 			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	} else {
 		updateQuery := fmt.Sprintf(
-			"UPDATE \"attendance\" SET %s WHERE %s",
+			"UPDATE \"enrolments\" SET %s WHERE %s",
 			strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
-			strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
+			strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns),
 		)
-		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID}
+		values := []interface{}{o.ID, related.ID}
 
 		if boil.DebugMode {
 			fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -861,19 +2041,20 @@ func (o *Friend) SetAttendance(exec boil.Executor, insert bool, related *Attenda
 			return errors.Wrap(err, "failed to update foreign table")
 		}
 
-		queries.Assign(&related.FriendID, o.ID)
+		related.FriendID = o.ID
+
 	}
 
 	if o.R == nil {
 		o.R = &friendR{
-			Attendance: related,
+			Enrolment: related,
 		}
 	} else {
-		o.R.Attendance = related
+		o.R.Enrolment = related
 	}
 
 	if related.R == nil {
-		related.R = &attendanceR{
+		related.R = &enrolmentR{
 			Friend: o,
 		}
 	} else {
@@ -882,62 +2063,93 @@ func (o *Friend) SetAttendance(exec boil.Executor, insert bool, related *Attenda
 	return nil
 }
 
-// RemoveAttendanceG relationship.
-// Sets o.R.Attendance to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
+// SetLocationSampleG of the friend to the related item.
+// Sets o.R.LocationSample to related.
+// Adds o to related.R.Friend.
 // Uses the global database handle.
-func (o *Friend) RemoveAttendanceG(related *Attendance) error {
-	return o.RemoveAttendance(boil.GetDB(), related)
+func (o *Friend) SetLocationSampleG(insert bool, related *LocationSample) error {
+	return o.SetLocationSample(boil.GetDB(), insert, related)
 }
 
-// RemoveAttendance relationship.
-// Sets o.R.Attendance to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *Friend) RemoveAttendance(exec boil.Executor, related *Attendance) error {
+// SetLocationSample of the friend to the related item.
+// Sets o.R.LocationSample to related.
+// Adds o to related.R.Friend.
+func (o *Friend) SetLocationSample(exec boil.Executor, insert bool, related *LocationSample) error {
 	var err error
 
-	queries.SetScanner(&related.FriendID, nil)
-	if _, err = related.Update(exec, boil.Whitelist("friend_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if insert {
+		related.FriendID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"location_samples\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+			strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.FriendID = o.ID
+
 	}
 
-	o.R.Attendance = nil
-	if related == nil || related.R == nil {
-		return nil
+	if o.R == nil {
+		o.R = &friendR{
+			LocationSample: related,
+		}
+	} else {
+		o.R.LocationSample = related
 	}
 
-	related.R.Friend = nil
+	if related.R == nil {
+		related.R = &locationSampleR{
+			Friend: o,
+		}
+	} else {
+		related.R.Friend = o
+	}
 	return nil
 }
 
-// AddTeacherAttendancesG adds the given related objects to the existing relationships
+// AddTeacherClassSessionsG adds the given related objects to the existing relationships
 // of the friend, optionally inserting them as new records.
-// Appends related to o.R.TeacherAttendances.
+// Appends related to o.R.TeacherClassSessions.
 // Sets related.R.Teacher appropriately.
 // Uses the global database handle.
-func (o *Friend) AddTeacherAttendancesG(insert bool, related ...*Attendance) error {
-	return o.AddTeacherAttendances(boil.GetDB(), insert, related...)
+func (o *Friend) AddTeacherClassSessionsG(insert bool, related ...*ClassSession) error {
+	return o.AddTeacherClassSessions(boil.GetDB(), insert, related...)
 }
 
-// AddTeacherAttendances adds the given related objects to the existing relationships
+// AddTeacherClassSessions adds the given related objects to the existing relationships
 // of the friend, optionally inserting them as new records.
-// Appends related to o.R.TeacherAttendances.
+// Appends related to o.R.TeacherClassSessions.
 // Sets related.R.Teacher appropriately.
-func (o *Friend) AddTeacherAttendances(exec boil.Executor, insert bool, related ...*Attendance) error {
+func (o *Friend) AddTeacherClassSessions(exec boil.Executor, insert bool, related ...*ClassSession) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.TeacherID, o.ID)
+			rel.TeacherID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"attendance\" SET %s WHERE %s",
+				"UPDATE \"class_sessions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
-				strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Timestamp, rel.IntegrationID, rel.FriendID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -948,21 +2160,21 @@ func (o *Friend) AddTeacherAttendances(exec boil.Executor, insert bool, related
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.TeacherID, o.ID)
+			rel.TeacherID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &friendR{
-			TeacherAttendances: related,
+			TeacherClassSessions: related,
 		}
 	} else {
-		o.R.TeacherAttendances = append(o.R.TeacherAttendances, related...)
+		o.R.TeacherClassSessions = append(o.R.TeacherClassSessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &attendanceR{
+			rel.R = &classSessionR{
 				Teacher: o,
 			}
 		} else {
@@ -972,92 +2184,251 @@ func (o *Friend) AddTeacherAttendances(exec boil.Executor, insert bool, related
 	return nil
 }
 
-// SetTeacherAttendancesG removes all previously related items of the
-// friend replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Teacher's TeacherAttendances accordingly.
-// Replaces o.R.TeacherAttendances with related.
-// Sets related.R.Teacher's TeacherAttendances accordingly.
+// AddManualAttendancesG adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.ManualAttendances.
+// Sets related.R.Friend appropriately.
 // Uses the global database handle.
-func (o *Friend) SetTeacherAttendancesG(insert bool, related ...*Attendance) error {
-	return o.SetTeacherAttendances(boil.GetDB(), insert, related...)
-}
-
-// SetTeacherAttendances removes all previously related items of the
-// friend replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Teacher's TeacherAttendances accordingly.
-// Replaces o.R.TeacherAttendances with related.
-// Sets related.R.Teacher's TeacherAttendances accordingly.
-func (o *Friend) SetTeacherAttendances(exec boil.Executor, insert bool, related ...*Attendance) error {
-	query := "update \"attendance\" set \"teacher_id\" = null where \"teacher_id\" = ?"
-	values := []interface{}{o.ID}
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
+func (o *Friend) AddManualAttendancesG(insert bool, related ...*ManualAttendance) error {
+	return o.AddManualAttendances(boil.GetDB(), insert, related...)
+}
+
+// AddManualAttendances adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.ManualAttendances.
+// Sets related.R.Friend appropriately.
+func (o *Friend) AddManualAttendances(exec boil.Executor, insert bool, related ...*ManualAttendance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FriendID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"manual_attendance\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+				strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FriendID = o.ID
+		}
 	}
 
-	_, err := exec.Exec(query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+	if o.R == nil {
+		o.R = &friendR{
+			ManualAttendances: related,
+		}
+	} else {
+		o.R.ManualAttendances = append(o.R.ManualAttendances, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &manualAttendanceR{
+				Friend: o,
+			}
+		} else {
+			rel.R.Friend = o
+		}
 	}
+	return nil
+}
+
+// AddTeacherManualAttendancesG adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherManualAttendances.
+// Sets related.R.Teacher appropriately.
+// Uses the global database handle.
+func (o *Friend) AddTeacherManualAttendancesG(insert bool, related ...*ManualAttendance) error {
+	return o.AddTeacherManualAttendances(boil.GetDB(), insert, related...)
+}
+
+// AddTeacherManualAttendances adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherManualAttendances.
+// Sets related.R.Teacher appropriately.
+func (o *Friend) AddTeacherManualAttendances(exec boil.Executor, insert bool, related ...*ManualAttendance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TeacherID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"manual_attendance\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+				strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
 
-	if o.R != nil {
-		for _, rel := range o.R.TeacherAttendances {
-			queries.SetScanner(&rel.TeacherID, nil)
-			if rel.R == nil {
-				continue
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.Teacher = nil
+			rel.TeacherID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &friendR{
+			TeacherManualAttendances: related,
 		}
+	} else {
+		o.R.TeacherManualAttendances = append(o.R.TeacherManualAttendances, related...)
+	}
 
-		o.R.TeacherAttendances = nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &manualAttendanceR{
+				Teacher: o,
+			}
+		} else {
+			rel.R.Teacher = o
+		}
 	}
-	return o.AddTeacherAttendances(exec, insert, related...)
+	return nil
 }
 
-// RemoveTeacherAttendancesG relationships from objects passed in.
-// Removes related items from R.TeacherAttendances (uses pointer comparison, removal does not keep order)
-// Sets related.R.Teacher.
+// AddTeacherRostersG adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherRosters.
+// Sets related.R.Teacher appropriately.
 // Uses the global database handle.
-func (o *Friend) RemoveTeacherAttendancesG(related ...*Attendance) error {
-	return o.RemoveTeacherAttendances(boil.GetDB(), related...)
+func (o *Friend) AddTeacherRostersG(insert bool, related ...*Roster) error {
+	return o.AddTeacherRosters(boil.GetDB(), insert, related...)
 }
 
-// RemoveTeacherAttendances relationships from objects passed in.
-// Removes related items from R.TeacherAttendances (uses pointer comparison, removal does not keep order)
-// Sets related.R.Teacher.
-func (o *Friend) RemoveTeacherAttendances(exec boil.Executor, related ...*Attendance) error {
+// AddTeacherRosters adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherRosters.
+// Sets related.R.Teacher appropriately.
+func (o *Friend) AddTeacherRosters(exec boil.Executor, insert bool, related ...*Roster) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.TeacherID, nil)
-		if rel.R != nil {
-			rel.R.Teacher = nil
-		}
-		if _, err = rel.Update(exec, boil.Whitelist("teacher_id")); err != nil {
-			return err
+		if insert {
+			rel.TeacherID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"rosters\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+				strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TeacherID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &friendR{
+			TeacherRosters: related,
+		}
+	} else {
+		o.R.TeacherRosters = append(o.R.TeacherRosters, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rosterR{
+				Teacher: o,
+			}
+		} else {
+			rel.R.Teacher = o
+		}
 	}
+	return nil
+}
+
+// AddTeacherSchedulesG adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherSchedules.
+// Sets related.R.Teacher appropriately.
+// Uses the global database handle.
+func (o *Friend) AddTeacherSchedulesG(insert bool, related ...*Schedule) error {
+	return o.AddTeacherSchedules(boil.GetDB(), insert, related...)
+}
 
+// AddTeacherSchedules adds the given related objects to the existing relationships
+// of the friend, optionally inserting them as new records.
+// Appends related to o.R.TeacherSchedules.
+// Sets related.R.Teacher appropriately.
+func (o *Friend) AddTeacherSchedules(exec boil.Executor, insert bool, related ...*Schedule) error {
+	var err error
 	for _, rel := range related {
-		for i, ri := range o.R.TeacherAttendances {
-			if rel != ri {
-				continue
+		if insert {
+			rel.TeacherID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"schedules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+				strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
 			}
 
-			ln := len(o.R.TeacherAttendances)
-			if ln > 1 && i < ln-1 {
-				o.R.TeacherAttendances[i] = o.R.TeacherAttendances[ln-1]
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
-			o.R.TeacherAttendances = o.R.TeacherAttendances[:ln-1]
-			break
+
+			rel.TeacherID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &friendR{
+			TeacherSchedules: related,
 		}
+	} else {
+		o.R.TeacherSchedules = append(o.R.TeacherSchedules, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scheduleR{
+				Teacher: o,
+			}
+		} else {
+			rel.R.Teacher = o
+		}
+	}
 	return nil
 }
 
@@ -1068,13 +2439,13 @@ func Friends(mods ...qm.QueryMod) friendQuery {
 }
 
 // FindFriendG retrieves a single record by ID.
-func FindFriendG(iD null.Int64, selectCols ...string) (*Friend, error) {
+func FindFriendG(iD int64, selectCols ...string) (*Friend, error) {
 	return FindFriend(boil.GetDB(), iD, selectCols...)
 }
 
 // FindFriend retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindFriend(exec boil.Executor, iD null.Int64, selectCols ...string) (*Friend, error) {
+func FindFriend(exec boil.Executor, iD int64, selectCols ...string) (*Friend, error) {
 	friendObj := &Friend{}
 
 	sel := "*"
@@ -1170,18 +2541,29 @@ func (o *Friend) Insert(exec boil.Executor, columns boil.Columns) error {
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
 
-	_, err = exec.Exec(cache.query, vals...)
+	result, err := exec.Exec(cache.query, vals...)
 
 	if err != nil {
 		return errors.Wrap(err, "db: unable to insert into friends")
 	}
 
+	var lastID int64
 	var identifierCols []interface{}
 
 	if len(cache.retMapping) == 0 {
 		goto CacheNoHooks
 	}
 
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == friendMapping["ID"] {
+		goto CacheNoHooks
+	}
+
 	identifierCols = []interface{}{
 		o.ID,
 	}
@@ -1532,12 +2914,12 @@ func (o *FriendSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // FriendExistsG checks if the Friend row exists.
-func FriendExistsG(iD null.Int64) (bool, error) {
+func FriendExistsG(iD int64) (bool, error) {
 	return FriendExists(boil.GetDB(), iD)
 }
 
 // FriendExists checks if the Friend row exists.
-func FriendExists(exec boil.Executor, iD null.Int64) (bool, error) {
+func FriendExists(exec boil.Executor, iD int64) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"friends\" where \"id\"=? limit 1)"
 
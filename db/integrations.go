@@ -22,87 +22,134 @@ import (
 
 // Integration is an object representing the database table.
 type Integration struct {
-	ID             null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
-	UserID         int64      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Username       string     `boil:"username" json:"username" toml:"username" yaml:"username"`
-	APIKey         string     `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
-	AuthToken      []byte     `boil:"auth_token" json:"auth_token" toml:"auth_token" yaml:"auth_token"`
-	AuthTokenNonce []byte     `boil:"auth_token_nonce" json:"auth_token_nonce" toml:"auth_token_nonce" yaml:"auth_token_nonce"`
-	Archived       bool       `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
-	ArchivedAt     null.Time  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
-	UpdatedAt      time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	CreatedAt      time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                 int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID             int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Username           string    `boil:"username" json:"username" toml:"username" yaml:"username"`
+	APIKey             string    `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	AuthToken          []byte    `boil:"auth_token" json:"auth_token" toml:"auth_token" yaml:"auth_token"`
+	AuthTokenNonce     []byte    `boil:"auth_token_nonce" json:"auth_token_nonce" toml:"auth_token_nonce" yaml:"auth_token_nonce"`
+	Archived           bool      `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	ArchivedAt         null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ScheduledOnly      bool      `boil:"scheduled_only" json:"scheduled_only" toml:"scheduled_only" yaml:"scheduled_only"`
+	MinPresencePercent int64     `boil:"min_presence_percent" json:"min_presence_percent" toml:"min_presence_percent" yaml:"min_presence_percent"`
+	LateAfterMinutes   int64     `boil:"late_after_minutes" json:"late_after_minutes" toml:"late_after_minutes" yaml:"late_after_minutes"`
+	LeftEarlyMinutes   int64     `boil:"left_early_minutes" json:"left_early_minutes" toml:"left_early_minutes" yaml:"left_early_minutes"`
 
 	R *integrationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L integrationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var IntegrationColumns = struct {
-	ID             string
-	UserID         string
-	Username       string
-	APIKey         string
-	AuthToken      string
-	AuthTokenNonce string
-	Archived       string
-	ArchivedAt     string
-	UpdatedAt      string
-	CreatedAt      string
+	ID                 string
+	UserID             string
+	Username           string
+	APIKey             string
+	AuthToken          string
+	AuthTokenNonce     string
+	Archived           string
+	ArchivedAt         string
+	UpdatedAt          string
+	CreatedAt          string
+	ScheduledOnly      string
+	MinPresencePercent string
+	LateAfterMinutes   string
+	LeftEarlyMinutes   string
 }{
-	ID:             "id",
-	UserID:         "user_id",
-	Username:       "username",
-	APIKey:         "api_key",
-	AuthToken:      "auth_token",
-	AuthTokenNonce: "auth_token_nonce",
-	Archived:       "archived",
-	ArchivedAt:     "archived_at",
-	UpdatedAt:      "updated_at",
-	CreatedAt:      "created_at",
+	ID:                 "id",
+	UserID:             "user_id",
+	Username:           "username",
+	APIKey:             "api_key",
+	AuthToken:          "auth_token",
+	AuthTokenNonce:     "auth_token_nonce",
+	Archived:           "archived",
+	ArchivedAt:         "archived_at",
+	UpdatedAt:          "updated_at",
+	CreatedAt:          "created_at",
+	ScheduledOnly:      "scheduled_only",
+	MinPresencePercent: "min_presence_percent",
+	LateAfterMinutes:   "late_after_minutes",
+	LeftEarlyMinutes:   "left_early_minutes",
 }
 
 // Generated where
 
 var IntegrationWhere = struct {
-	ID             whereHelpernull_Int64
-	UserID         whereHelperint64
-	Username       whereHelperstring
-	APIKey         whereHelperstring
-	AuthToken      whereHelper__byte
-	AuthTokenNonce whereHelper__byte
-	Archived       whereHelperbool
-	ArchivedAt     whereHelpernull_Time
-	UpdatedAt      whereHelpertime_Time
-	CreatedAt      whereHelpertime_Time
+	ID                 whereHelperint64
+	UserID             whereHelperint64
+	Username           whereHelperstring
+	APIKey             whereHelperstring
+	AuthToken          whereHelper__byte
+	AuthTokenNonce     whereHelper__byte
+	Archived           whereHelperbool
+	ArchivedAt         whereHelpernull_Time
+	UpdatedAt          whereHelpertime_Time
+	CreatedAt          whereHelpertime_Time
+	ScheduledOnly      whereHelperbool
+	MinPresencePercent whereHelperint64
+	LateAfterMinutes   whereHelperint64
+	LeftEarlyMinutes   whereHelperint64
 }{
-	ID:             whereHelpernull_Int64{field: "\"integrations\".\"id\""},
-	UserID:         whereHelperint64{field: "\"integrations\".\"user_id\""},
-	Username:       whereHelperstring{field: "\"integrations\".\"username\""},
-	APIKey:         whereHelperstring{field: "\"integrations\".\"api_key\""},
-	AuthToken:      whereHelper__byte{field: "\"integrations\".\"auth_token\""},
-	AuthTokenNonce: whereHelper__byte{field: "\"integrations\".\"auth_token_nonce\""},
-	Archived:       whereHelperbool{field: "\"integrations\".\"archived\""},
-	ArchivedAt:     whereHelpernull_Time{field: "\"integrations\".\"archived_at\""},
-	UpdatedAt:      whereHelpertime_Time{field: "\"integrations\".\"updated_at\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"integrations\".\"created_at\""},
+	ID:                 whereHelperint64{field: "\"integrations\".\"id\""},
+	UserID:             whereHelperint64{field: "\"integrations\".\"user_id\""},
+	Username:           whereHelperstring{field: "\"integrations\".\"username\""},
+	APIKey:             whereHelperstring{field: "\"integrations\".\"api_key\""},
+	AuthToken:          whereHelper__byte{field: "\"integrations\".\"auth_token\""},
+	AuthTokenNonce:     whereHelper__byte{field: "\"integrations\".\"auth_token_nonce\""},
+	Archived:           whereHelperbool{field: "\"integrations\".\"archived\""},
+	ArchivedAt:         whereHelpernull_Time{field: "\"integrations\".\"archived_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"integrations\".\"updated_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"integrations\".\"created_at\""},
+	ScheduledOnly:      whereHelperbool{field: "\"integrations\".\"scheduled_only\""},
+	MinPresencePercent: whereHelperint64{field: "\"integrations\".\"min_presence_percent\""},
+	LateAfterMinutes:   whereHelperint64{field: "\"integrations\".\"late_after_minutes\""},
+	LeftEarlyMinutes:   whereHelperint64{field: "\"integrations\".\"left_early_minutes\""},
 }
 
 // IntegrationRels is where relationship names are stored.
 var IntegrationRels = struct {
-	User       string
-	Attendance string
-	Friend     string
+	User              string
+	Attendance        string
+	Friend            string
+	LocationSample    string
+	AuditLogs         string
+	ClassSessions     string
+	ManualAttendances string
+	ReprocessJobs     string
+	Rosters           string
+	Schedules         string
+	TrackerHeartbeats string
+	Webhooks          string
 }{
-	User:       "User",
-	Attendance: "Attendance",
-	Friend:     "Friend",
+	User:              "User",
+	Attendance:        "Attendance",
+	Friend:            "Friend",
+	LocationSample:    "LocationSample",
+	AuditLogs:         "AuditLogs",
+	ClassSessions:     "ClassSessions",
+	ManualAttendances: "ManualAttendances",
+	ReprocessJobs:     "ReprocessJobs",
+	Rosters:           "Rosters",
+	Schedules:         "Schedules",
+	TrackerHeartbeats: "TrackerHeartbeats",
+	Webhooks:          "Webhooks",
 }
 
 // integrationR is where relationships are stored.
 type integrationR struct {
-	User       *User
-	Attendance *Attendance
-	Friend     *Friend
+	User              *User
+	Attendance        *Attendance
+	Friend            *Friend
+	LocationSample    *LocationSample
+	AuditLogs         AuditLogSlice
+	ClassSessions     ClassSessionSlice
+	ManualAttendances ManualAttendanceSlice
+	ReprocessJobs     ReprocessJobSlice
+	Rosters           RosterSlice
+	Schedules         ScheduleSlice
+	TrackerHeartbeats TrackerHeartbeatSlice
+	Webhooks          WebhookSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -114,9 +161,9 @@ func (*integrationR) NewStruct() *integrationR {
 type integrationL struct{}
 
 var (
-	integrationAllColumns            = []string{"id", "user_id", "username", "api_key", "auth_token", "auth_token_nonce", "archived", "archived_at", "updated_at", "created_at"}
+	integrationAllColumns            = []string{"id", "user_id", "username", "api_key", "auth_token", "auth_token_nonce", "archived", "archived_at", "updated_at", "created_at", "scheduled_only", "min_presence_percent", "late_after_minutes", "left_early_minutes"}
 	integrationColumnsWithoutDefault = []string{"user_id", "username", "api_key", "auth_token", "auth_token_nonce", "archived_at"}
-	integrationColumnsWithDefault    = []string{"id", "archived", "updated_at", "created_at"}
+	integrationColumnsWithDefault    = []string{"id", "archived", "updated_at", "created_at", "scheduled_only", "min_presence_percent", "late_after_minutes", "left_early_minutes"}
 	integrationPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -421,6 +468,188 @@ func (o *Integration) Friend(mods ...qm.QueryMod) friendQuery {
 	return query
 }
 
+// LocationSample pointed to by the foreign key.
+func (o *Integration) LocationSample(mods ...qm.QueryMod) locationSampleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"integration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := LocationSamples(queryMods...)
+	queries.SetFrom(query.Query, "\"location_samples\"")
+
+	return query
+}
+
+// AuditLogs retrieves all the audit_log's AuditLogs with an executor.
+func (o *Integration) AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_log\".\"integration_id\"=?", o.ID),
+	)
+
+	query := AuditLogs(queryMods...)
+	queries.SetFrom(query.Query, "\"audit_log\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"audit_log\".*"})
+	}
+
+	return query
+}
+
+// ClassSessions retrieves all the class_session's ClassSessions with an executor.
+func (o *Integration) ClassSessions(mods ...qm.QueryMod) classSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"class_sessions\".\"integration_id\"=?", o.ID),
+	)
+
+	query := ClassSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"class_sessions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class_sessions\".*"})
+	}
+
+	return query
+}
+
+// ManualAttendances retrieves all the manual_attendance's ManualAttendances with an executor.
+func (o *Integration) ManualAttendances(mods ...qm.QueryMod) manualAttendanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"manual_attendance\".\"integration_id\"=?", o.ID),
+	)
+
+	query := ManualAttendances(queryMods...)
+	queries.SetFrom(query.Query, "\"manual_attendance\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"manual_attendance\".*"})
+	}
+
+	return query
+}
+
+// ReprocessJobs retrieves all the reprocess_job's ReprocessJobs with an executor.
+func (o *Integration) ReprocessJobs(mods ...qm.QueryMod) reprocessJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reprocess_jobs\".\"integration_id\"=?", o.ID),
+	)
+
+	query := ReprocessJobs(queryMods...)
+	queries.SetFrom(query.Query, "\"reprocess_jobs\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reprocess_jobs\".*"})
+	}
+
+	return query
+}
+
+// Rosters retrieves all the roster's Rosters with an executor.
+func (o *Integration) Rosters(mods ...qm.QueryMod) rosterQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rosters\".\"integration_id\"=?", o.ID),
+	)
+
+	query := Rosters(queryMods...)
+	queries.SetFrom(query.Query, "\"rosters\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"rosters\".*"})
+	}
+
+	return query
+}
+
+// Schedules retrieves all the schedule's Schedules with an executor.
+func (o *Integration) Schedules(mods ...qm.QueryMod) scheduleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"schedules\".\"integration_id\"=?", o.ID),
+	)
+
+	query := Schedules(queryMods...)
+	queries.SetFrom(query.Query, "\"schedules\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"schedules\".*"})
+	}
+
+	return query
+}
+
+// TrackerHeartbeats retrieves all the tracker_heartbeat's TrackerHeartbeats with an executor.
+func (o *Integration) TrackerHeartbeats(mods ...qm.QueryMod) trackerHeartbeatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tracker_heartbeats\".\"integration_id\"=?", o.ID),
+	)
+
+	query := TrackerHeartbeats(queryMods...)
+	queries.SetFrom(query.Query, "\"tracker_heartbeats\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"tracker_heartbeats\".*"})
+	}
+
+	return query
+}
+
+// Webhooks retrieves all the webhook's Webhooks with an executor.
+func (o *Integration) Webhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhooks\".\"integration_id\"=?", o.ID),
+	)
+
+	query := Webhooks(queryMods...)
+	queries.SetFrom(query.Query, "\"webhooks\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"webhooks\".*"})
+	}
+
+	return query
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (integrationL) LoadUser(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
@@ -438,9 +667,7 @@ func (integrationL) LoadUser(e boil.Executor, singular bool, maybeIntegration in
 		if object.R == nil {
 			object.R = &integrationR{}
 		}
-		if !queries.IsNil(object.UserID) {
-			args = append(args, object.UserID)
-		}
+		args = append(args, object.UserID)
 
 	} else {
 	Outer:
@@ -450,14 +677,12 @@ func (integrationL) LoadUser(e boil.Executor, singular bool, maybeIntegration in
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.UserID) {
+				if a == obj.UserID {
 					continue Outer
 				}
 			}
 
-			if !queries.IsNil(obj.UserID) {
-				args = append(args, obj.UserID)
-			}
+			args = append(args, obj.UserID)
 
 		}
 	}
@@ -512,7 +737,7 @@ func (integrationL) LoadUser(e boil.Executor, singular bool, maybeIntegration in
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.UserID, foreign.ID) {
+			if local.UserID == foreign.ID {
 				local.R.User = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
@@ -650,7 +875,7 @@ func (integrationL) LoadFriend(e boil.Executor, singular bool, maybeIntegration
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -708,7 +933,7 @@ func (integrationL) LoadFriend(e boil.Executor, singular bool, maybeIntegration
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.ID, foreign.IntegrationID) {
+			if local.ID == foreign.IntegrationID {
 				local.R.Friend = foreign
 				if foreign.R == nil {
 					foreign.R = &friendR{}
@@ -722,118 +947,976 @@ func (integrationL) LoadFriend(e boil.Executor, singular bool, maybeIntegration
 	return nil
 }
 
-// SetUserG of the integration to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Integrations.
-// Uses the global database handle.
-func (o *Integration) SetUserG(insert bool, related *User) error {
-	return o.SetUser(boil.GetDB(), insert, related)
-}
+// LoadLocationSample allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (integrationL) LoadLocationSample(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
 
-// SetUser of the integration to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Integrations.
-func (o *Integration) SetUser(exec boil.Executor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"integrations\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
-		strmangle.WhereClause("\"", "\"", 0, integrationPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
 	}
 
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if len(args) == 0 {
+		return nil
 	}
 
-	queries.Assign(&o.UserID, related.ID)
-	if o.R == nil {
-		o.R = &integrationR{
-			User: related,
-		}
-	} else {
-		o.R.User = related
+	query := NewQuery(qm.From(`location_samples`), qm.WhereIn(`location_samples.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			Integrations: IntegrationSlice{o},
-		}
-	} else {
-		related.R.Integrations = append(related.R.Integrations, o)
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LocationSample")
 	}
 
-	return nil
-}
+	var resultSlice []*LocationSample
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LocationSample")
+	}
 
-// SetAttendanceG of the integration to the related item.
-// Sets o.R.Attendance to related.
-// Adds o to related.R.Integration.
-// Uses the global database handle.
-func (o *Integration) SetAttendanceG(insert bool, related *Attendance) error {
-	return o.SetAttendance(boil.GetDB(), insert, related)
-}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for location_samples")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location_samples")
+	}
 
-// SetAttendance of the integration to the related item.
-// Sets o.R.Attendance to related.
-// Adds o to related.R.Integration.
-func (o *Integration) SetAttendance(exec boil.Executor, insert bool, related *Attendance) error {
-	var err error
+	if len(integrationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
 
-	if insert {
-		queries.Assign(&related.IntegrationID, o.ID)
+	if len(resultSlice) == 0 {
+		return nil
+	}
 
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LocationSample = foreign
+		if foreign.R == nil {
+			foreign.R = &locationSampleR{}
 		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"attendance\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
-			strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID}
+		foreign.R.Integration = object
+	}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.IntegrationID {
+				local.R.LocationSample = foreign
+				if foreign.R == nil {
+					foreign.R = &locationSampleR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
 		}
+	}
 
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
-		}
+	return nil
+}
 
-		queries.Assign(&related.IntegrationID, o.ID)
-	}
+// LoadAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadAuditLogs(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
 
-	if o.R == nil {
-		o.R = &integrationR{
-			Attendance: related,
-		}
+	if singular {
+		object = maybeIntegration.(*Integration)
 	} else {
-		o.R.Attendance = related
+		slice = *maybeIntegration.(*[]*Integration)
 	}
 
-	if related.R == nil {
-		related.R = &attendanceR{
-			Integration: o,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.Integration = o
-	}
-	return nil
-}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`audit_log`), qm.WhereIn(`audit_log.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_log")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.AuditLogs = append(local.R.AuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClassSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadClassSessions(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`class_sessions`), qm.WhereIn(`class_sessions.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class_sessions")
+	}
+
+	var resultSlice []*ClassSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class_sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_sessions")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ClassSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classSessionR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.ClassSessions = append(local.R.ClassSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &classSessionR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadManualAttendances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadManualAttendances(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`manual_attendance`), qm.WhereIn(`manual_attendance.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load manual_attendance")
+	}
+
+	var resultSlice []*ManualAttendance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice manual_attendance")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on manual_attendance")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for manual_attendance")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ManualAttendances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &manualAttendanceR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.ManualAttendances = append(local.R.ManualAttendances, foreign)
+				if foreign.R == nil {
+					foreign.R = &manualAttendanceR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReprocessJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadReprocessJobs(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`reprocess_jobs`), qm.WhereIn(`reprocess_jobs.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reprocess_jobs")
+	}
+
+	var resultSlice []*ReprocessJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reprocess_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reprocess_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reprocess_jobs")
+	}
+
+	if len(reprocessJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReprocessJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reprocessJobR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.ReprocessJobs = append(local.R.ReprocessJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &reprocessJobR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRosters allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadRosters(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`rosters`), qm.WhereIn(`rosters.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rosters")
+	}
+
+	var resultSlice []*Roster
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rosters")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rosters")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rosters")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Rosters = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rosterR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.Rosters = append(local.R.Rosters, foreign)
+				if foreign.R == nil {
+					foreign.R = &rosterR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadSchedules(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`schedules`), qm.WhereIn(`schedules.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load schedules")
+	}
+
+	var resultSlice []*Schedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for schedules")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Schedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scheduleR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.Schedules = append(local.R.Schedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &scheduleR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTrackerHeartbeats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadTrackerHeartbeats(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`tracker_heartbeats`), qm.WhereIn(`tracker_heartbeats.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tracker_heartbeats")
+	}
+
+	var resultSlice []*TrackerHeartbeat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tracker_heartbeats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tracker_heartbeats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracker_heartbeats")
+	}
+
+	if len(trackerHeartbeatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TrackerHeartbeats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &trackerHeartbeatR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.TrackerHeartbeats = append(local.R.TrackerHeartbeats, foreign)
+				if foreign.R == nil {
+					foreign.R = &trackerHeartbeatR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (integrationL) LoadWebhooks(e boil.Executor, singular bool, maybeIntegration interface{}, mods queries.Applicator) error {
+	var slice []*Integration
+	var object *Integration
+
+	if singular {
+		object = maybeIntegration.(*Integration)
+	} else {
+		slice = *maybeIntegration.(*[]*Integration)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &integrationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &integrationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`webhooks`), qm.WhereIn(`webhooks.integration_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Webhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.Integration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.IntegrationID {
+				local.R.Webhooks = append(local.R.Webhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.Integration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the integration to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Integrations.
+// Uses the global database handle.
+func (o *Integration) SetUserG(insert bool, related *User) error {
+	return o.SetUser(boil.GetDB(), insert, related)
+}
+
+// SetUser of the integration to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Integrations.
+func (o *Integration) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"integrations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, integrationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &integrationR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Integrations: IntegrationSlice{o},
+		}
+	} else {
+		related.R.Integrations = append(related.R.Integrations, o)
+	}
+
+	return nil
+}
+
+// SetAttendanceG of the integration to the related item.
+// Sets o.R.Attendance to related.
+// Adds o to related.R.Integration.
+// Uses the global database handle.
+func (o *Integration) SetAttendanceG(insert bool, related *Attendance) error {
+	return o.SetAttendance(boil.GetDB(), insert, related)
+}
+
+// SetAttendance of the integration to the related item.
+// Sets o.R.Attendance to related.
+// Adds o to related.R.Integration.
+func (o *Integration) SetAttendance(exec boil.Executor, insert bool, related *Attendance) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.IntegrationID, o.ID)
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"attendance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+			strmangle.WhereClause("\"", "\"", 0, attendancePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID, related.TeacherID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.IntegrationID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			Attendance: related,
+		}
+	} else {
+		o.R.Attendance = related
+	}
+
+	if related.R == nil {
+		related.R = &attendanceR{
+			Integration: o,
+		}
+	} else {
+		related.R.Integration = o
+	}
+	return nil
+}
 
 // RemoveAttendanceG relationship.
 // Sets o.R.Attendance to nil.
@@ -843,53 +1926,112 @@ func (o *Integration) RemoveAttendanceG(related *Attendance) error {
 	return o.RemoveAttendance(boil.GetDB(), related)
 }
 
-// RemoveAttendance relationship.
-// Sets o.R.Attendance to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *Integration) RemoveAttendance(exec boil.Executor, related *Attendance) error {
-	var err error
+// RemoveAttendance relationship.
+// Sets o.R.Attendance to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Integration) RemoveAttendance(exec boil.Executor, related *Attendance) error {
+	var err error
+
+	queries.SetScanner(&related.IntegrationID, nil)
+	if _, err = related.Update(exec, boil.Whitelist("integration_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.Attendance = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Integration = nil
+	return nil
+}
+
+// SetFriendG of the integration to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.Integration.
+// Uses the global database handle.
+func (o *Integration) SetFriendG(insert bool, related *Friend) error {
+	return o.SetFriend(boil.GetDB(), insert, related)
+}
+
+// SetFriend of the integration to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.Integration.
+func (o *Integration) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+
+	if insert {
+		related.IntegrationID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"friends\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+			strmangle.WhereClause("\"", "\"", 0, friendPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.IntegrationID = o.ID
 
-	queries.SetScanner(&related.IntegrationID, nil)
-	if _, err = related.Update(exec, boil.Whitelist("integration_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.R.Attendance = nil
-	if related == nil || related.R == nil {
-		return nil
+	if o.R == nil {
+		o.R = &integrationR{
+			Friend: related,
+		}
+	} else {
+		o.R.Friend = related
 	}
 
-	related.R.Integration = nil
+	if related.R == nil {
+		related.R = &friendR{
+			Integration: o,
+		}
+	} else {
+		related.R.Integration = o
+	}
 	return nil
 }
 
-// SetFriendG of the integration to the related item.
-// Sets o.R.Friend to related.
+// SetLocationSampleG of the integration to the related item.
+// Sets o.R.LocationSample to related.
 // Adds o to related.R.Integration.
 // Uses the global database handle.
-func (o *Integration) SetFriendG(insert bool, related *Friend) error {
-	return o.SetFriend(boil.GetDB(), insert, related)
+func (o *Integration) SetLocationSampleG(insert bool, related *LocationSample) error {
+	return o.SetLocationSample(boil.GetDB(), insert, related)
 }
 
-// SetFriend of the integration to the related item.
-// Sets o.R.Friend to related.
+// SetLocationSample of the integration to the related item.
+// Sets o.R.LocationSample to related.
 // Adds o to related.R.Integration.
-func (o *Integration) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+func (o *Integration) SetLocationSample(exec boil.Executor, insert bool, related *LocationSample) error {
 	var err error
 
 	if insert {
-		queries.Assign(&related.IntegrationID, o.ID)
+		related.IntegrationID = o.ID
 
 		if err = related.Insert(exec, boil.Infer()); err != nil {
 			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	} else {
 		updateQuery := fmt.Sprintf(
-			"UPDATE \"friends\" SET %s WHERE %s",
+			"UPDATE \"location_samples\" SET %s WHERE %s",
 			strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
-			strmangle.WhereClause("\"", "\"", 0, friendPrimaryKeyColumns),
+			strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns),
 		)
-		values := []interface{}{o.ID, related.ID}
+		values := []interface{}{o.ID, related.Timestamp, related.IntegrationID, related.FriendID}
 
 		if boil.DebugMode {
 			fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -900,19 +2042,20 @@ func (o *Integration) SetFriend(exec boil.Executor, insert bool, related *Friend
 			return errors.Wrap(err, "failed to update foreign table")
 		}
 
-		queries.Assign(&related.IntegrationID, o.ID)
+		related.IntegrationID = o.ID
+
 	}
 
 	if o.R == nil {
 		o.R = &integrationR{
-			Friend: related,
+			LocationSample: related,
 		}
 	} else {
-		o.R.Friend = related
+		o.R.LocationSample = related
 	}
 
 	if related.R == nil {
-		related.R = &friendR{
+		related.R = &locationSampleR{
 			Integration: o,
 		}
 	} else {
@@ -921,6 +2064,502 @@ func (o *Integration) SetFriend(exec boil.Executor, insert bool, related *Friend
 	return nil
 }
 
+// AddAuditLogsG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.AuditLogs.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddAuditLogsG(insert bool, related ...*AuditLog) error {
+	return o.AddAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// AddAuditLogs adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.AuditLogs.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddAuditLogs(exec boil.Executor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_log\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			AuditLogs: related,
+		}
+	} else {
+		o.R.AuditLogs = append(o.R.AuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddClassSessionsG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ClassSessions.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddClassSessionsG(insert bool, related ...*ClassSession) error {
+	return o.AddClassSessions(boil.GetDB(), insert, related...)
+}
+
+// AddClassSessions adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ClassSessions.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddClassSessions(exec boil.Executor, insert bool, related ...*ClassSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"class_sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			ClassSessions: related,
+		}
+	} else {
+		o.R.ClassSessions = append(o.R.ClassSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &classSessionR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddManualAttendancesG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ManualAttendances.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddManualAttendancesG(insert bool, related ...*ManualAttendance) error {
+	return o.AddManualAttendances(boil.GetDB(), insert, related...)
+}
+
+// AddManualAttendances adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ManualAttendances.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddManualAttendances(exec boil.Executor, insert bool, related ...*ManualAttendance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"manual_attendance\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			ManualAttendances: related,
+		}
+	} else {
+		o.R.ManualAttendances = append(o.R.ManualAttendances, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &manualAttendanceR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddReprocessJobsG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ReprocessJobs.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddReprocessJobsG(insert bool, related ...*ReprocessJob) error {
+	return o.AddReprocessJobs(boil.GetDB(), insert, related...)
+}
+
+// AddReprocessJobs adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.ReprocessJobs.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddReprocessJobs(exec boil.Executor, insert bool, related ...*ReprocessJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			ReprocessJobs: related,
+		}
+	} else {
+		o.R.ReprocessJobs = append(o.R.ReprocessJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reprocessJobR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddRostersG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Rosters.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddRostersG(insert bool, related ...*Roster) error {
+	return o.AddRosters(boil.GetDB(), insert, related...)
+}
+
+// AddRosters adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Rosters.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddRosters(exec boil.Executor, insert bool, related ...*Roster) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"rosters\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			Rosters: related,
+		}
+	} else {
+		o.R.Rosters = append(o.R.Rosters, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rosterR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddSchedulesG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Schedules.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddSchedulesG(insert bool, related ...*Schedule) error {
+	return o.AddSchedules(boil.GetDB(), insert, related...)
+}
+
+// AddSchedules adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Schedules.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddSchedules(exec boil.Executor, insert bool, related ...*Schedule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"schedules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			Schedules: related,
+		}
+	} else {
+		o.R.Schedules = append(o.R.Schedules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scheduleR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddTrackerHeartbeatsG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.TrackerHeartbeats.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddTrackerHeartbeatsG(insert bool, related ...*TrackerHeartbeat) error {
+	return o.AddTrackerHeartbeats(boil.GetDB(), insert, related...)
+}
+
+// AddTrackerHeartbeats adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.TrackerHeartbeats.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddTrackerHeartbeats(exec boil.Executor, insert bool, related ...*TrackerHeartbeat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"tracker_heartbeats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, trackerHeartbeatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			TrackerHeartbeats: related,
+		}
+	} else {
+		o.R.TrackerHeartbeats = append(o.R.TrackerHeartbeats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &trackerHeartbeatR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
+// AddWebhooksG adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Webhooks.
+// Sets related.R.Integration appropriately.
+// Uses the global database handle.
+func (o *Integration) AddWebhooksG(insert bool, related ...*Webhook) error {
+	return o.AddWebhooks(boil.GetDB(), insert, related...)
+}
+
+// AddWebhooks adds the given related objects to the existing relationships
+// of the integration, optionally inserting them as new records.
+// Appends related to o.R.Webhooks.
+// Sets related.R.Integration appropriately.
+func (o *Integration) AddWebhooks(exec boil.Executor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.IntegrationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+				strmangle.WhereClause("\"", "\"", 0, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.IntegrationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &integrationR{
+			Webhooks: related,
+		}
+	} else {
+		o.R.Webhooks = append(o.R.Webhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				Integration: o,
+			}
+		} else {
+			rel.R.Integration = o
+		}
+	}
+	return nil
+}
+
 // Integrations retrieves all the records using an executor.
 func Integrations(mods ...qm.QueryMod) integrationQuery {
 	mods = append(mods, qm.From("\"integrations\""))
@@ -928,13 +2567,13 @@ func Integrations(mods ...qm.QueryMod) integrationQuery {
 }
 
 // FindIntegrationG retrieves a single record by ID.
-func FindIntegrationG(iD null.Int64, selectCols ...string) (*Integration, error) {
+func FindIntegrationG(iD int64, selectCols ...string) (*Integration, error) {
 	return FindIntegration(boil.GetDB(), iD, selectCols...)
 }
 
 // FindIntegration retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindIntegration(exec boil.Executor, iD null.Int64, selectCols ...string) (*Integration, error) {
+func FindIntegration(exec boil.Executor, iD int64, selectCols ...string) (*Integration, error) {
 	integrationObj := &Integration{}
 
 	sel := "*"
@@ -1030,18 +2669,29 @@ func (o *Integration) Insert(exec boil.Executor, columns boil.Columns) error {
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
 
-	_, err = exec.Exec(cache.query, vals...)
+	result, err := exec.Exec(cache.query, vals...)
 
 	if err != nil {
 		return errors.Wrap(err, "db: unable to insert into integrations")
 	}
 
+	var lastID int64
 	var identifierCols []interface{}
 
 	if len(cache.retMapping) == 0 {
 		goto CacheNoHooks
 	}
 
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == integrationMapping["ID"] {
+		goto CacheNoHooks
+	}
+
 	identifierCols = []interface{}{
 		o.ID,
 	}
@@ -1392,12 +3042,12 @@ func (o *IntegrationSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // IntegrationExistsG checks if the Integration row exists.
-func IntegrationExistsG(iD null.Int64) (bool, error) {
+func IntegrationExistsG(iD int64) (bool, error) {
 	return IntegrationExists(boil.GetDB(), iD)
 }
 
 // IntegrationExists checks if the Integration row exists.
-func IntegrationExists(exec boil.Executor, iD null.Int64) (bool, error) {
+func IntegrationExists(exec boil.Executor, iD int64) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"integrations\" where \"id\"=? limit 1)"
 
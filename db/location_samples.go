@@ -0,0 +1,1181 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// LocationSample is an object representing the database table.
+type LocationSample struct {
+	Timestamp     int64     `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	IntegrationID int64     `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	FriendID      int64     `boil:"friend_id" json:"friend_id" toml:"friend_id" yaml:"friend_id"`
+	Location      string    `boil:"location" json:"location" toml:"location" yaml:"location"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *locationSampleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L locationSampleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LocationSampleColumns = struct {
+	Timestamp     string
+	IntegrationID string
+	FriendID      string
+	Location      string
+	CreatedAt     string
+}{
+	Timestamp:     "timestamp",
+	IntegrationID: "integration_id",
+	FriendID:      "friend_id",
+	Location:      "location",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+var LocationSampleWhere = struct {
+	Timestamp     whereHelperint64
+	IntegrationID whereHelperint64
+	FriendID      whereHelperint64
+	Location      whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	Timestamp:     whereHelperint64{field: "\"location_samples\".\"timestamp\""},
+	IntegrationID: whereHelperint64{field: "\"location_samples\".\"integration_id\""},
+	FriendID:      whereHelperint64{field: "\"location_samples\".\"friend_id\""},
+	Location:      whereHelperstring{field: "\"location_samples\".\"location\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"location_samples\".\"created_at\""},
+}
+
+// LocationSampleRels is where relationship names are stored.
+var LocationSampleRels = struct {
+	Friend      string
+	Integration string
+}{
+	Friend:      "Friend",
+	Integration: "Integration",
+}
+
+// locationSampleR is where relationships are stored.
+type locationSampleR struct {
+	Friend      *Friend
+	Integration *Integration
+}
+
+// NewStruct creates a new relationship struct
+func (*locationSampleR) NewStruct() *locationSampleR {
+	return &locationSampleR{}
+}
+
+// locationSampleL is where Load methods for each relationship are stored.
+type locationSampleL struct{}
+
+var (
+	locationSampleAllColumns            = []string{"timestamp", "integration_id", "friend_id", "location", "created_at"}
+	locationSampleColumnsWithoutDefault = []string{"timestamp", "integration_id", "friend_id", "location"}
+	locationSampleColumnsWithDefault    = []string{"created_at"}
+	locationSamplePrimaryKeyColumns     = []string{"timestamp", "integration_id", "friend_id"}
+)
+
+type (
+	// LocationSampleSlice is an alias for a slice of pointers to LocationSample.
+	// This should generally be used opposed to []LocationSample.
+	LocationSampleSlice []*LocationSample
+	// LocationSampleHook is the signature for custom LocationSample hook methods
+	LocationSampleHook func(boil.Executor, *LocationSample) error
+
+	locationSampleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	locationSampleType                 = reflect.TypeOf(&LocationSample{})
+	locationSampleMapping              = queries.MakeStructMapping(locationSampleType)
+	locationSamplePrimaryKeyMapping, _ = queries.BindMapping(locationSampleType, locationSampleMapping, locationSamplePrimaryKeyColumns)
+	locationSampleInsertCacheMut       sync.RWMutex
+	locationSampleInsertCache          = make(map[string]insertCache)
+	locationSampleUpdateCacheMut       sync.RWMutex
+	locationSampleUpdateCache          = make(map[string]updateCache)
+	locationSampleUpsertCacheMut       sync.RWMutex
+	locationSampleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var locationSampleBeforeInsertHooks []LocationSampleHook
+var locationSampleBeforeUpdateHooks []LocationSampleHook
+var locationSampleBeforeDeleteHooks []LocationSampleHook
+var locationSampleBeforeUpsertHooks []LocationSampleHook
+
+var locationSampleAfterInsertHooks []LocationSampleHook
+var locationSampleAfterSelectHooks []LocationSampleHook
+var locationSampleAfterUpdateHooks []LocationSampleHook
+var locationSampleAfterDeleteHooks []LocationSampleHook
+var locationSampleAfterUpsertHooks []LocationSampleHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LocationSample) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LocationSample) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LocationSample) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LocationSample) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LocationSample) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LocationSample) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LocationSample) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LocationSample) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LocationSample) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range locationSampleAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLocationSampleHook registers your hook function for all future operations.
+func AddLocationSampleHook(hookPoint boil.HookPoint, locationSampleHook LocationSampleHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		locationSampleBeforeInsertHooks = append(locationSampleBeforeInsertHooks, locationSampleHook)
+	case boil.BeforeUpdateHook:
+		locationSampleBeforeUpdateHooks = append(locationSampleBeforeUpdateHooks, locationSampleHook)
+	case boil.BeforeDeleteHook:
+		locationSampleBeforeDeleteHooks = append(locationSampleBeforeDeleteHooks, locationSampleHook)
+	case boil.BeforeUpsertHook:
+		locationSampleBeforeUpsertHooks = append(locationSampleBeforeUpsertHooks, locationSampleHook)
+	case boil.AfterInsertHook:
+		locationSampleAfterInsertHooks = append(locationSampleAfterInsertHooks, locationSampleHook)
+	case boil.AfterSelectHook:
+		locationSampleAfterSelectHooks = append(locationSampleAfterSelectHooks, locationSampleHook)
+	case boil.AfterUpdateHook:
+		locationSampleAfterUpdateHooks = append(locationSampleAfterUpdateHooks, locationSampleHook)
+	case boil.AfterDeleteHook:
+		locationSampleAfterDeleteHooks = append(locationSampleAfterDeleteHooks, locationSampleHook)
+	case boil.AfterUpsertHook:
+		locationSampleAfterUpsertHooks = append(locationSampleAfterUpsertHooks, locationSampleHook)
+	}
+}
+
+// OneG returns a single locationSample record from the query using the global executor.
+func (q locationSampleQuery) OneG() (*LocationSample, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single locationSample record from the query.
+func (q locationSampleQuery) One(exec boil.Executor) (*LocationSample, error) {
+	o := &LocationSample{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for location_samples")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LocationSample records from the query using the global executor.
+func (q locationSampleQuery) AllG() (LocationSampleSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all LocationSample records from the query.
+func (q locationSampleQuery) All(exec boil.Executor) (LocationSampleSlice, error) {
+	var o []*LocationSample
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to LocationSample slice")
+	}
+
+	if len(locationSampleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LocationSample records in the query, and panics on error.
+func (q locationSampleQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all LocationSample records in the query.
+func (q locationSampleQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count location_samples rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q locationSampleQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q locationSampleQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if location_samples exists")
+	}
+
+	return count > 0, nil
+}
+
+// Friend pointed to by the foreign key.
+func (o *LocationSample) Friend(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FriendID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *LocationSample) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// LoadFriend allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (locationSampleL) LoadFriend(e boil.Executor, singular bool, maybeLocationSample interface{}, mods queries.Applicator) error {
+	var slice []*LocationSample
+	var object *LocationSample
+
+	if singular {
+		object = maybeLocationSample.(*LocationSample)
+	} else {
+		slice = *maybeLocationSample.(*[]*LocationSample)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &locationSampleR{}
+		}
+		args = append(args, object.FriendID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationSampleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FriendID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FriendID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(locationSampleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Friend = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.LocationSample = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FriendID == foreign.ID {
+				local.R.Friend = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.LocationSample = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (locationSampleL) LoadIntegration(e boil.Executor, singular bool, maybeLocationSample interface{}, mods queries.Applicator) error {
+	var slice []*LocationSample
+	var object *LocationSample
+
+	if singular {
+		object = maybeLocationSample.(*LocationSample)
+	} else {
+		slice = *maybeLocationSample.(*[]*LocationSample)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &locationSampleR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationSampleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(locationSampleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.LocationSample = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.LocationSample = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFriendG of the locationSample to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.LocationSample.
+// Uses the global database handle.
+func (o *LocationSample) SetFriendG(insert bool, related *Friend) error {
+	return o.SetFriend(boil.GetDB(), insert, related)
+}
+
+// SetFriend of the locationSample to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.LocationSample.
+func (o *LocationSample) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"location_samples\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+		strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FriendID = related.ID
+	if o.R == nil {
+		o.R = &locationSampleR{
+			Friend: related,
+		}
+	} else {
+		o.R.Friend = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			LocationSample: o,
+		}
+	} else {
+		related.R.LocationSample = o
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the locationSample to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.LocationSample.
+// Uses the global database handle.
+func (o *LocationSample) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the locationSample to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.LocationSample.
+func (o *LocationSample) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"location_samples\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Timestamp, o.IntegrationID, o.FriendID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &locationSampleR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			LocationSample: o,
+		}
+	} else {
+		related.R.LocationSample = o
+	}
+
+	return nil
+}
+
+// LocationSamples retrieves all the records using an executor.
+func LocationSamples(mods ...qm.QueryMod) locationSampleQuery {
+	mods = append(mods, qm.From("\"location_samples\""))
+	return locationSampleQuery{NewQuery(mods...)}
+}
+
+// FindLocationSampleG retrieves a single record by ID.
+func FindLocationSampleG(timestamp int64, integrationID int64, friendID int64, selectCols ...string) (*LocationSample, error) {
+	return FindLocationSample(boil.GetDB(), timestamp, integrationID, friendID, selectCols...)
+}
+
+// FindLocationSample retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLocationSample(exec boil.Executor, timestamp int64, integrationID int64, friendID int64, selectCols ...string) (*LocationSample, error) {
+	locationSampleObj := &LocationSample{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"location_samples\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, timestamp, integrationID, friendID)
+
+	err := q.Bind(nil, exec, locationSampleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from location_samples")
+	}
+
+	return locationSampleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LocationSample) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LocationSample) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no location_samples provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(locationSampleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	locationSampleInsertCacheMut.RLock()
+	cache, cached := locationSampleInsertCache[key]
+	locationSampleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			locationSampleAllColumns,
+			locationSampleColumnsWithDefault,
+			locationSampleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(locationSampleType, locationSampleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(locationSampleType, locationSampleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"location_samples\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"location_samples\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"location_samples\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into location_samples")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.Timestamp,
+		o.IntegrationID,
+		o.FriendID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for location_samples")
+	}
+
+CacheNoHooks:
+	if !cached {
+		locationSampleInsertCacheMut.Lock()
+		locationSampleInsertCache[key] = cache
+		locationSampleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single LocationSample record using the global executor.
+// See Update for more documentation.
+func (o *LocationSample) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the LocationSample.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LocationSample) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	locationSampleUpdateCacheMut.RLock()
+	cache, cached := locationSampleUpdateCache[key]
+	locationSampleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			locationSampleAllColumns,
+			locationSamplePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update location_samples, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"location_samples\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, locationSamplePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(locationSampleType, locationSampleMapping, append(wl, locationSamplePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update location_samples row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for location_samples")
+	}
+
+	if !cached {
+		locationSampleUpdateCacheMut.Lock()
+		locationSampleUpdateCache[key] = cache
+		locationSampleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q locationSampleQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q locationSampleQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for location_samples")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for location_samples")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LocationSampleSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LocationSampleSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationSamplePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"location_samples\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, locationSamplePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in locationSample slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all locationSample")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single LocationSample record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LocationSample) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single LocationSample record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LocationSample) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no LocationSample provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), locationSamplePrimaryKeyMapping)
+	sql := "DELETE FROM \"location_samples\" WHERE \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from location_samples")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for location_samples")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q locationSampleQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no locationSampleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from location_samples")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for location_samples")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LocationSampleSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LocationSampleSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(locationSampleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationSamplePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"location_samples\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, locationSamplePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from locationSample slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for location_samples")
+	}
+
+	if len(locationSampleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LocationSample) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no LocationSample provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LocationSample) Reload(exec boil.Executor) error {
+	ret, err := FindLocationSample(exec, o.Timestamp, o.IntegrationID, o.FriendID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LocationSampleSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty LocationSampleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LocationSampleSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LocationSampleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationSamplePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"location_samples\".* FROM \"location_samples\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, locationSamplePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in LocationSampleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LocationSampleExistsG checks if the LocationSample row exists.
+func LocationSampleExistsG(timestamp int64, integrationID int64, friendID int64) (bool, error) {
+	return LocationSampleExists(boil.GetDB(), timestamp, integrationID, friendID)
+}
+
+// LocationSampleExists checks if the LocationSample row exists.
+func LocationSampleExists(exec boil.Executor, timestamp int64, integrationID int64, friendID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"location_samples\" where \"timestamp\"=? AND \"integration_id\"=? AND \"friend_id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, timestamp, integrationID, friendID)
+	}
+
+	row := exec.QueryRow(sql, timestamp, integrationID, friendID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if location_samples exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1579 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// ManualAttendance is an object representing the database table.
+type ManualAttendance struct {
+	ID            int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID int64     `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	TeacherID     int64     `boil:"teacher_id" json:"teacher_id" toml:"teacher_id" yaml:"teacher_id"`
+	FriendID      int64     `boil:"friend_id" json:"friend_id" toml:"friend_id" yaml:"friend_id"`
+	StartsAt      time.Time `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt        time.Time `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	Reason        string    `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	AuthorID      int64     `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	Archived      bool      `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	ArchivedAt    null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *manualAttendanceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L manualAttendanceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ManualAttendanceColumns = struct {
+	ID            string
+	IntegrationID string
+	TeacherID     string
+	FriendID      string
+	StartsAt      string
+	EndsAt        string
+	Reason        string
+	AuthorID      string
+	Archived      string
+	ArchivedAt    string
+	UpdatedAt     string
+	CreatedAt     string
+}{
+	ID:            "id",
+	IntegrationID: "integration_id",
+	TeacherID:     "teacher_id",
+	FriendID:      "friend_id",
+	StartsAt:      "starts_at",
+	EndsAt:        "ends_at",
+	Reason:        "reason",
+	AuthorID:      "author_id",
+	Archived:      "archived",
+	ArchivedAt:    "archived_at",
+	UpdatedAt:     "updated_at",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+var ManualAttendanceWhere = struct {
+	ID            whereHelperint64
+	IntegrationID whereHelperint64
+	TeacherID     whereHelperint64
+	FriendID      whereHelperint64
+	StartsAt      whereHelpertime_Time
+	EndsAt        whereHelpertime_Time
+	Reason        whereHelperstring
+	AuthorID      whereHelperint64
+	Archived      whereHelperbool
+	ArchivedAt    whereHelpernull_Time
+	UpdatedAt     whereHelpertime_Time
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"manual_attendance\".\"id\""},
+	IntegrationID: whereHelperint64{field: "\"manual_attendance\".\"integration_id\""},
+	TeacherID:     whereHelperint64{field: "\"manual_attendance\".\"teacher_id\""},
+	FriendID:      whereHelperint64{field: "\"manual_attendance\".\"friend_id\""},
+	StartsAt:      whereHelpertime_Time{field: "\"manual_attendance\".\"starts_at\""},
+	EndsAt:        whereHelpertime_Time{field: "\"manual_attendance\".\"ends_at\""},
+	Reason:        whereHelperstring{field: "\"manual_attendance\".\"reason\""},
+	AuthorID:      whereHelperint64{field: "\"manual_attendance\".\"author_id\""},
+	Archived:      whereHelperbool{field: "\"manual_attendance\".\"archived\""},
+	ArchivedAt:    whereHelpernull_Time{field: "\"manual_attendance\".\"archived_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"manual_attendance\".\"updated_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"manual_attendance\".\"created_at\""},
+}
+
+// ManualAttendanceRels is where relationship names are stored.
+var ManualAttendanceRels = struct {
+	Author      string
+	Friend      string
+	Teacher     string
+	Integration string
+}{
+	Author:      "Author",
+	Friend:      "Friend",
+	Teacher:     "Teacher",
+	Integration: "Integration",
+}
+
+// manualAttendanceR is where relationships are stored.
+type manualAttendanceR struct {
+	Author      *User
+	Friend      *Friend
+	Teacher     *Friend
+	Integration *Integration
+}
+
+// NewStruct creates a new relationship struct
+func (*manualAttendanceR) NewStruct() *manualAttendanceR {
+	return &manualAttendanceR{}
+}
+
+// manualAttendanceL is where Load methods for each relationship are stored.
+type manualAttendanceL struct{}
+
+var (
+	manualAttendanceAllColumns            = []string{"id", "integration_id", "teacher_id", "friend_id", "starts_at", "ends_at", "reason", "author_id", "archived", "archived_at", "updated_at", "created_at"}
+	manualAttendanceColumnsWithoutDefault = []string{"integration_id", "teacher_id", "friend_id", "starts_at", "ends_at", "reason", "author_id", "archived_at"}
+	manualAttendanceColumnsWithDefault    = []string{"id", "archived", "updated_at", "created_at"}
+	manualAttendancePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ManualAttendanceSlice is an alias for a slice of pointers to ManualAttendance.
+	// This should generally be used opposed to []ManualAttendance.
+	ManualAttendanceSlice []*ManualAttendance
+	// ManualAttendanceHook is the signature for custom ManualAttendance hook methods
+	ManualAttendanceHook func(boil.Executor, *ManualAttendance) error
+
+	manualAttendanceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	manualAttendanceType                 = reflect.TypeOf(&ManualAttendance{})
+	manualAttendanceMapping              = queries.MakeStructMapping(manualAttendanceType)
+	manualAttendancePrimaryKeyMapping, _ = queries.BindMapping(manualAttendanceType, manualAttendanceMapping, manualAttendancePrimaryKeyColumns)
+	manualAttendanceInsertCacheMut       sync.RWMutex
+	manualAttendanceInsertCache          = make(map[string]insertCache)
+	manualAttendanceUpdateCacheMut       sync.RWMutex
+	manualAttendanceUpdateCache          = make(map[string]updateCache)
+	manualAttendanceUpsertCacheMut       sync.RWMutex
+	manualAttendanceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var manualAttendanceBeforeInsertHooks []ManualAttendanceHook
+var manualAttendanceBeforeUpdateHooks []ManualAttendanceHook
+var manualAttendanceBeforeDeleteHooks []ManualAttendanceHook
+var manualAttendanceBeforeUpsertHooks []ManualAttendanceHook
+
+var manualAttendanceAfterInsertHooks []ManualAttendanceHook
+var manualAttendanceAfterSelectHooks []ManualAttendanceHook
+var manualAttendanceAfterUpdateHooks []ManualAttendanceHook
+var manualAttendanceAfterDeleteHooks []ManualAttendanceHook
+var manualAttendanceAfterUpsertHooks []ManualAttendanceHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ManualAttendance) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ManualAttendance) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ManualAttendance) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ManualAttendance) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ManualAttendance) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ManualAttendance) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ManualAttendance) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ManualAttendance) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ManualAttendance) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range manualAttendanceAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddManualAttendanceHook registers your hook function for all future operations.
+func AddManualAttendanceHook(hookPoint boil.HookPoint, manualAttendanceHook ManualAttendanceHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		manualAttendanceBeforeInsertHooks = append(manualAttendanceBeforeInsertHooks, manualAttendanceHook)
+	case boil.BeforeUpdateHook:
+		manualAttendanceBeforeUpdateHooks = append(manualAttendanceBeforeUpdateHooks, manualAttendanceHook)
+	case boil.BeforeDeleteHook:
+		manualAttendanceBeforeDeleteHooks = append(manualAttendanceBeforeDeleteHooks, manualAttendanceHook)
+	case boil.BeforeUpsertHook:
+		manualAttendanceBeforeUpsertHooks = append(manualAttendanceBeforeUpsertHooks, manualAttendanceHook)
+	case boil.AfterInsertHook:
+		manualAttendanceAfterInsertHooks = append(manualAttendanceAfterInsertHooks, manualAttendanceHook)
+	case boil.AfterSelectHook:
+		manualAttendanceAfterSelectHooks = append(manualAttendanceAfterSelectHooks, manualAttendanceHook)
+	case boil.AfterUpdateHook:
+		manualAttendanceAfterUpdateHooks = append(manualAttendanceAfterUpdateHooks, manualAttendanceHook)
+	case boil.AfterDeleteHook:
+		manualAttendanceAfterDeleteHooks = append(manualAttendanceAfterDeleteHooks, manualAttendanceHook)
+	case boil.AfterUpsertHook:
+		manualAttendanceAfterUpsertHooks = append(manualAttendanceAfterUpsertHooks, manualAttendanceHook)
+	}
+}
+
+// OneG returns a single manualAttendance record from the query using the global executor.
+func (q manualAttendanceQuery) OneG() (*ManualAttendance, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single manualAttendance record from the query.
+func (q manualAttendanceQuery) One(exec boil.Executor) (*ManualAttendance, error) {
+	o := &ManualAttendance{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for manual_attendance")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ManualAttendance records from the query using the global executor.
+func (q manualAttendanceQuery) AllG() (ManualAttendanceSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all ManualAttendance records from the query.
+func (q manualAttendanceQuery) All(exec boil.Executor) (ManualAttendanceSlice, error) {
+	var o []*ManualAttendance
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to ManualAttendance slice")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ManualAttendance records in the query, and panics on error.
+func (q manualAttendanceQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all ManualAttendance records in the query.
+func (q manualAttendanceQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count manual_attendance rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q manualAttendanceQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q manualAttendanceQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if manual_attendance exists")
+	}
+
+	return count > 0, nil
+}
+
+// Author pointed to by the foreign key.
+func (o *ManualAttendance) Author(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Friend pointed to by the foreign key.
+func (o *ManualAttendance) Friend(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FriendID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Teacher pointed to by the foreign key.
+func (o *ManualAttendance) Teacher(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeacherID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *ManualAttendance) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (manualAttendanceL) LoadAuthor(e boil.Executor, singular bool, maybeManualAttendance interface{}, mods queries.Applicator) error {
+	var slice []*ManualAttendance
+	var object *ManualAttendance
+
+	if singular {
+		object = maybeManualAttendance.(*ManualAttendance)
+	} else {
+		slice = *maybeManualAttendance.(*[]*ManualAttendance)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &manualAttendanceR{}
+		}
+		args = append(args, object.AuthorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &manualAttendanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AuthorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AuthorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`users`), qm.WhereIn(`users.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AuthorManualAttendances = append(foreign.R.AuthorManualAttendances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthorID == foreign.ID {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AuthorManualAttendances = append(foreign.R.AuthorManualAttendances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFriend allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (manualAttendanceL) LoadFriend(e boil.Executor, singular bool, maybeManualAttendance interface{}, mods queries.Applicator) error {
+	var slice []*ManualAttendance
+	var object *ManualAttendance
+
+	if singular {
+		object = maybeManualAttendance.(*ManualAttendance)
+	} else {
+		slice = *maybeManualAttendance.(*[]*ManualAttendance)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &manualAttendanceR{}
+		}
+		args = append(args, object.FriendID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &manualAttendanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FriendID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FriendID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Friend = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.ManualAttendances = append(foreign.R.ManualAttendances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FriendID == foreign.ID {
+				local.R.Friend = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.ManualAttendances = append(foreign.R.ManualAttendances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacher allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (manualAttendanceL) LoadTeacher(e boil.Executor, singular bool, maybeManualAttendance interface{}, mods queries.Applicator) error {
+	var slice []*ManualAttendance
+	var object *ManualAttendance
+
+	if singular {
+		object = maybeManualAttendance.(*ManualAttendance)
+	} else {
+		slice = *maybeManualAttendance.(*[]*ManualAttendance)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &manualAttendanceR{}
+		}
+		args = append(args, object.TeacherID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &manualAttendanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TeacherID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TeacherID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Teacher = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.TeacherManualAttendances = append(foreign.R.TeacherManualAttendances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeacherID == foreign.ID {
+				local.R.Teacher = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.TeacherManualAttendances = append(foreign.R.TeacherManualAttendances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (manualAttendanceL) LoadIntegration(e boil.Executor, singular bool, maybeManualAttendance interface{}, mods queries.Applicator) error {
+	var slice []*ManualAttendance
+	var object *ManualAttendance
+
+	if singular {
+		object = maybeManualAttendance.(*ManualAttendance)
+	} else {
+		slice = *maybeManualAttendance.(*[]*ManualAttendance)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &manualAttendanceR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &manualAttendanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.ManualAttendances = append(foreign.R.ManualAttendances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.ManualAttendances = append(foreign.R.ManualAttendances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAuthorG of the manualAttendance to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorManualAttendances.
+// Uses the global database handle.
+func (o *ManualAttendance) SetAuthorG(insert bool, related *User) error {
+	return o.SetAuthor(boil.GetDB(), insert, related)
+}
+
+// SetAuthor of the manualAttendance to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorManualAttendances.
+func (o *ManualAttendance) SetAuthor(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"manual_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"author_id"}),
+		strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthorID = related.ID
+	if o.R == nil {
+		o.R = &manualAttendanceR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AuthorManualAttendances: ManualAttendanceSlice{o},
+		}
+	} else {
+		related.R.AuthorManualAttendances = append(related.R.AuthorManualAttendances, o)
+	}
+
+	return nil
+}
+
+// SetFriendG of the manualAttendance to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.ManualAttendances.
+// Uses the global database handle.
+func (o *ManualAttendance) SetFriendG(insert bool, related *Friend) error {
+	return o.SetFriend(boil.GetDB(), insert, related)
+}
+
+// SetFriend of the manualAttendance to the related item.
+// Sets o.R.Friend to related.
+// Adds o to related.R.ManualAttendances.
+func (o *ManualAttendance) SetFriend(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"manual_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"friend_id"}),
+		strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FriendID = related.ID
+	if o.R == nil {
+		o.R = &manualAttendanceR{
+			Friend: related,
+		}
+	} else {
+		o.R.Friend = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			ManualAttendances: ManualAttendanceSlice{o},
+		}
+	} else {
+		related.R.ManualAttendances = append(related.R.ManualAttendances, o)
+	}
+
+	return nil
+}
+
+// SetTeacherG of the manualAttendance to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherManualAttendances.
+// Uses the global database handle.
+func (o *ManualAttendance) SetTeacherG(insert bool, related *Friend) error {
+	return o.SetTeacher(boil.GetDB(), insert, related)
+}
+
+// SetTeacher of the manualAttendance to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherManualAttendances.
+func (o *ManualAttendance) SetTeacher(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"manual_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+		strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeacherID = related.ID
+	if o.R == nil {
+		o.R = &manualAttendanceR{
+			Teacher: related,
+		}
+	} else {
+		o.R.Teacher = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			TeacherManualAttendances: ManualAttendanceSlice{o},
+		}
+	} else {
+		related.R.TeacherManualAttendances = append(related.R.TeacherManualAttendances, o)
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the manualAttendance to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ManualAttendances.
+// Uses the global database handle.
+func (o *ManualAttendance) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the manualAttendance to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ManualAttendances.
+func (o *ManualAttendance) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"manual_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &manualAttendanceR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			ManualAttendances: ManualAttendanceSlice{o},
+		}
+	} else {
+		related.R.ManualAttendances = append(related.R.ManualAttendances, o)
+	}
+
+	return nil
+}
+
+// ManualAttendances retrieves all the records using an executor.
+func ManualAttendances(mods ...qm.QueryMod) manualAttendanceQuery {
+	mods = append(mods, qm.From("\"manual_attendance\""))
+	return manualAttendanceQuery{NewQuery(mods...)}
+}
+
+// FindManualAttendanceG retrieves a single record by ID.
+func FindManualAttendanceG(iD int64, selectCols ...string) (*ManualAttendance, error) {
+	return FindManualAttendance(boil.GetDB(), iD, selectCols...)
+}
+
+// FindManualAttendance retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindManualAttendance(exec boil.Executor, iD int64, selectCols ...string) (*ManualAttendance, error) {
+	manualAttendanceObj := &ManualAttendance{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"manual_attendance\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, manualAttendanceObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from manual_attendance")
+	}
+
+	return manualAttendanceObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ManualAttendance) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ManualAttendance) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no manual_attendance provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(manualAttendanceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	manualAttendanceInsertCacheMut.RLock()
+	cache, cached := manualAttendanceInsertCache[key]
+	manualAttendanceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			manualAttendanceAllColumns,
+			manualAttendanceColumnsWithDefault,
+			manualAttendanceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(manualAttendanceType, manualAttendanceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(manualAttendanceType, manualAttendanceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"manual_attendance\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"manual_attendance\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"manual_attendance\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into manual_attendance")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == manualAttendanceMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for manual_attendance")
+	}
+
+CacheNoHooks:
+	if !cached {
+		manualAttendanceInsertCacheMut.Lock()
+		manualAttendanceInsertCache[key] = cache
+		manualAttendanceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single ManualAttendance record using the global executor.
+// See Update for more documentation.
+func (o *ManualAttendance) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the ManualAttendance.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ManualAttendance) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	manualAttendanceUpdateCacheMut.RLock()
+	cache, cached := manualAttendanceUpdateCache[key]
+	manualAttendanceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			manualAttendanceAllColumns,
+			manualAttendancePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update manual_attendance, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"manual_attendance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(manualAttendanceType, manualAttendanceMapping, append(wl, manualAttendancePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update manual_attendance row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for manual_attendance")
+	}
+
+	if !cached {
+		manualAttendanceUpdateCacheMut.Lock()
+		manualAttendanceUpdateCache[key] = cache
+		manualAttendanceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q manualAttendanceQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q manualAttendanceQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for manual_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for manual_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ManualAttendanceSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ManualAttendanceSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), manualAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"manual_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, manualAttendancePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in manualAttendance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all manualAttendance")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single ManualAttendance record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ManualAttendance) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single ManualAttendance record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ManualAttendance) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no ManualAttendance provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), manualAttendancePrimaryKeyMapping)
+	sql := "DELETE FROM \"manual_attendance\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from manual_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for manual_attendance")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q manualAttendanceQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no manualAttendanceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from manual_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for manual_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ManualAttendanceSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ManualAttendanceSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(manualAttendanceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), manualAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"manual_attendance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, manualAttendancePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from manualAttendance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for manual_attendance")
+	}
+
+	if len(manualAttendanceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ManualAttendance) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no ManualAttendance provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ManualAttendance) Reload(exec boil.Executor) error {
+	ret, err := FindManualAttendance(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ManualAttendanceSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty ManualAttendanceSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ManualAttendanceSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ManualAttendanceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), manualAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"manual_attendance\".* FROM \"manual_attendance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, manualAttendancePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in ManualAttendanceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ManualAttendanceExistsG checks if the ManualAttendance row exists.
+func ManualAttendanceExistsG(iD int64) (bool, error) {
+	return ManualAttendanceExists(boil.GetDB(), iD)
+}
+
+// ManualAttendanceExists checks if the ManualAttendance row exists.
+func ManualAttendanceExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"manual_attendance\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if manual_attendance exists")
+	}
+
+	return exists, nil
+}
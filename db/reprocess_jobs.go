@@ -0,0 +1,1296 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// ReprocessJob is an object representing the database table.
+type ReprocessJob struct {
+	ID               int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID    int64       `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	UserID           null.Int64  `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	PeriodStart      time.Time   `boil:"period_start" json:"period_start" toml:"period_start" yaml:"period_start"`
+	PeriodEnd        time.Time   `boil:"period_end" json:"period_end" toml:"period_end" yaml:"period_end"`
+	Status           string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	TotalClasses     int64       `boil:"total_classes" json:"total_classes" toml:"total_classes" yaml:"total_classes"`
+	ProcessedClasses int64       `boil:"processed_classes" json:"processed_classes" toml:"processed_classes" yaml:"processed_classes"`
+	SessionsCreated  int64       `boil:"sessions_created" json:"sessions_created" toml:"sessions_created" yaml:"sessions_created"`
+	SessionsChanged  int64       `boil:"sessions_changed" json:"sessions_changed" toml:"sessions_changed" yaml:"sessions_changed"`
+	SessionsDeleted  int64       `boil:"sessions_deleted" json:"sessions_deleted" toml:"sessions_deleted" yaml:"sessions_deleted"`
+	ErrorMessage     null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	StartedAt        null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt       null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reprocessJobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reprocessJobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReprocessJobColumns = struct {
+	ID               string
+	IntegrationID    string
+	UserID           string
+	PeriodStart      string
+	PeriodEnd        string
+	Status           string
+	TotalClasses     string
+	ProcessedClasses string
+	SessionsCreated  string
+	SessionsChanged  string
+	SessionsDeleted  string
+	ErrorMessage     string
+	StartedAt        string
+	FinishedAt       string
+	UpdatedAt        string
+	CreatedAt        string
+}{
+	ID:               "id",
+	IntegrationID:    "integration_id",
+	UserID:           "user_id",
+	PeriodStart:      "period_start",
+	PeriodEnd:        "period_end",
+	Status:           "status",
+	TotalClasses:     "total_classes",
+	ProcessedClasses: "processed_classes",
+	SessionsCreated:  "sessions_created",
+	SessionsChanged:  "sessions_changed",
+	SessionsDeleted:  "sessions_deleted",
+	ErrorMessage:     "error_message",
+	StartedAt:        "started_at",
+	FinishedAt:       "finished_at",
+	UpdatedAt:        "updated_at",
+	CreatedAt:        "created_at",
+}
+
+// Generated where
+
+var ReprocessJobWhere = struct {
+	ID               whereHelperint64
+	IntegrationID    whereHelperint64
+	UserID           whereHelpernull_Int64
+	PeriodStart      whereHelpertime_Time
+	PeriodEnd        whereHelpertime_Time
+	Status           whereHelperstring
+	TotalClasses     whereHelperint64
+	ProcessedClasses whereHelperint64
+	SessionsCreated  whereHelperint64
+	SessionsChanged  whereHelperint64
+	SessionsDeleted  whereHelperint64
+	ErrorMessage     whereHelpernull_String
+	StartedAt        whereHelpernull_Time
+	FinishedAt       whereHelpernull_Time
+	UpdatedAt        whereHelpertime_Time
+	CreatedAt        whereHelpertime_Time
+}{
+	ID:               whereHelperint64{field: "\"reprocess_jobs\".\"id\""},
+	IntegrationID:    whereHelperint64{field: "\"reprocess_jobs\".\"integration_id\""},
+	UserID:           whereHelpernull_Int64{field: "\"reprocess_jobs\".\"user_id\""},
+	PeriodStart:      whereHelpertime_Time{field: "\"reprocess_jobs\".\"period_start\""},
+	PeriodEnd:        whereHelpertime_Time{field: "\"reprocess_jobs\".\"period_end\""},
+	Status:           whereHelperstring{field: "\"reprocess_jobs\".\"status\""},
+	TotalClasses:     whereHelperint64{field: "\"reprocess_jobs\".\"total_classes\""},
+	ProcessedClasses: whereHelperint64{field: "\"reprocess_jobs\".\"processed_classes\""},
+	SessionsCreated:  whereHelperint64{field: "\"reprocess_jobs\".\"sessions_created\""},
+	SessionsChanged:  whereHelperint64{field: "\"reprocess_jobs\".\"sessions_changed\""},
+	SessionsDeleted:  whereHelperint64{field: "\"reprocess_jobs\".\"sessions_deleted\""},
+	ErrorMessage:     whereHelpernull_String{field: "\"reprocess_jobs\".\"error_message\""},
+	StartedAt:        whereHelpernull_Time{field: "\"reprocess_jobs\".\"started_at\""},
+	FinishedAt:       whereHelpernull_Time{field: "\"reprocess_jobs\".\"finished_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"reprocess_jobs\".\"updated_at\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"reprocess_jobs\".\"created_at\""},
+}
+
+// ReprocessJobRels is where relationship names are stored.
+var ReprocessJobRels = struct {
+	User        string
+	Integration string
+}{
+	User:        "User",
+	Integration: "Integration",
+}
+
+// reprocessJobR is where relationships are stored.
+type reprocessJobR struct {
+	User        *User
+	Integration *Integration
+}
+
+// NewStruct creates a new relationship struct
+func (*reprocessJobR) NewStruct() *reprocessJobR {
+	return &reprocessJobR{}
+}
+
+// reprocessJobL is where Load methods for each relationship are stored.
+type reprocessJobL struct{}
+
+var (
+	reprocessJobAllColumns            = []string{"id", "integration_id", "user_id", "period_start", "period_end", "status", "total_classes", "processed_classes", "sessions_created", "sessions_changed", "sessions_deleted", "error_message", "started_at", "finished_at", "updated_at", "created_at"}
+	reprocessJobColumnsWithoutDefault = []string{"integration_id", "user_id", "period_start", "period_end", "error_message", "started_at", "finished_at"}
+	reprocessJobColumnsWithDefault    = []string{"id", "status", "total_classes", "processed_classes", "sessions_created", "sessions_changed", "sessions_deleted", "updated_at", "created_at"}
+	reprocessJobPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ReprocessJobSlice is an alias for a slice of pointers to ReprocessJob.
+	// This should generally be used opposed to []ReprocessJob.
+	ReprocessJobSlice []*ReprocessJob
+	// ReprocessJobHook is the signature for custom ReprocessJob hook methods
+	ReprocessJobHook func(boil.Executor, *ReprocessJob) error
+
+	reprocessJobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reprocessJobType                 = reflect.TypeOf(&ReprocessJob{})
+	reprocessJobMapping              = queries.MakeStructMapping(reprocessJobType)
+	reprocessJobPrimaryKeyMapping, _ = queries.BindMapping(reprocessJobType, reprocessJobMapping, reprocessJobPrimaryKeyColumns)
+	reprocessJobInsertCacheMut       sync.RWMutex
+	reprocessJobInsertCache          = make(map[string]insertCache)
+	reprocessJobUpdateCacheMut       sync.RWMutex
+	reprocessJobUpdateCache          = make(map[string]updateCache)
+	reprocessJobUpsertCacheMut       sync.RWMutex
+	reprocessJobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reprocessJobBeforeInsertHooks []ReprocessJobHook
+var reprocessJobBeforeUpdateHooks []ReprocessJobHook
+var reprocessJobBeforeDeleteHooks []ReprocessJobHook
+var reprocessJobBeforeUpsertHooks []ReprocessJobHook
+
+var reprocessJobAfterInsertHooks []ReprocessJobHook
+var reprocessJobAfterSelectHooks []ReprocessJobHook
+var reprocessJobAfterUpdateHooks []ReprocessJobHook
+var reprocessJobAfterDeleteHooks []ReprocessJobHook
+var reprocessJobAfterUpsertHooks []ReprocessJobHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReprocessJob) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReprocessJob) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReprocessJob) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReprocessJob) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReprocessJob) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReprocessJob) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReprocessJob) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReprocessJob) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReprocessJob) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reprocessJobAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReprocessJobHook registers your hook function for all future operations.
+func AddReprocessJobHook(hookPoint boil.HookPoint, reprocessJobHook ReprocessJobHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		reprocessJobBeforeInsertHooks = append(reprocessJobBeforeInsertHooks, reprocessJobHook)
+	case boil.BeforeUpdateHook:
+		reprocessJobBeforeUpdateHooks = append(reprocessJobBeforeUpdateHooks, reprocessJobHook)
+	case boil.BeforeDeleteHook:
+		reprocessJobBeforeDeleteHooks = append(reprocessJobBeforeDeleteHooks, reprocessJobHook)
+	case boil.BeforeUpsertHook:
+		reprocessJobBeforeUpsertHooks = append(reprocessJobBeforeUpsertHooks, reprocessJobHook)
+	case boil.AfterInsertHook:
+		reprocessJobAfterInsertHooks = append(reprocessJobAfterInsertHooks, reprocessJobHook)
+	case boil.AfterSelectHook:
+		reprocessJobAfterSelectHooks = append(reprocessJobAfterSelectHooks, reprocessJobHook)
+	case boil.AfterUpdateHook:
+		reprocessJobAfterUpdateHooks = append(reprocessJobAfterUpdateHooks, reprocessJobHook)
+	case boil.AfterDeleteHook:
+		reprocessJobAfterDeleteHooks = append(reprocessJobAfterDeleteHooks, reprocessJobHook)
+	case boil.AfterUpsertHook:
+		reprocessJobAfterUpsertHooks = append(reprocessJobAfterUpsertHooks, reprocessJobHook)
+	}
+}
+
+// OneG returns a single reprocessJob record from the query using the global executor.
+func (q reprocessJobQuery) OneG() (*ReprocessJob, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single reprocessJob record from the query.
+func (q reprocessJobQuery) One(exec boil.Executor) (*ReprocessJob, error) {
+	o := &ReprocessJob{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for reprocess_jobs")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ReprocessJob records from the query using the global executor.
+func (q reprocessJobQuery) AllG() (ReprocessJobSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all ReprocessJob records from the query.
+func (q reprocessJobQuery) All(exec boil.Executor) (ReprocessJobSlice, error) {
+	var o []*ReprocessJob
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to ReprocessJob slice")
+	}
+
+	if len(reprocessJobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ReprocessJob records in the query, and panics on error.
+func (q reprocessJobQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all ReprocessJob records in the query.
+func (q reprocessJobQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count reprocess_jobs rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q reprocessJobQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q reprocessJobQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if reprocess_jobs exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *ReprocessJob) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *ReprocessJob) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reprocessJobL) LoadUser(e boil.Executor, singular bool, maybeReprocessJob interface{}, mods queries.Applicator) error {
+	var slice []*ReprocessJob
+	var object *ReprocessJob
+
+	if singular {
+		object = maybeReprocessJob.(*ReprocessJob)
+	} else {
+		slice = *maybeReprocessJob.(*[]*ReprocessJob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reprocessJobR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reprocessJobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`users`), qm.WhereIn(`users.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(reprocessJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReprocessJobs = append(foreign.R.ReprocessJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReprocessJobs = append(foreign.R.ReprocessJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reprocessJobL) LoadIntegration(e boil.Executor, singular bool, maybeReprocessJob interface{}, mods queries.Applicator) error {
+	var slice []*ReprocessJob
+	var object *ReprocessJob
+
+	if singular {
+		object = maybeReprocessJob.(*ReprocessJob)
+	} else {
+		slice = *maybeReprocessJob.(*[]*ReprocessJob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reprocessJobR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reprocessJobR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(reprocessJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.ReprocessJobs = append(foreign.R.ReprocessJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.ReprocessJobs = append(foreign.R.ReprocessJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the reprocessJob to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ReprocessJobs.
+// Uses the global database handle.
+func (o *ReprocessJob) SetUserG(insert bool, related *User) error {
+	return o.SetUser(boil.GetDB(), insert, related)
+}
+
+// SetUser of the reprocessJob to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ReprocessJobs.
+func (o *ReprocessJob) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &reprocessJobR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReprocessJobs: ReprocessJobSlice{o},
+		}
+	} else {
+		related.R.ReprocessJobs = append(related.R.ReprocessJobs, o)
+	}
+
+	return nil
+}
+
+// RemoveUserG relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *ReprocessJob) RemoveUserG(related *User) error {
+	return o.RemoveUser(boil.GetDB(), related)
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ReprocessJob) RemoveUser(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.User = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReprocessJobs {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.ReprocessJobs)
+		if ln > 1 && i < ln-1 {
+			related.R.ReprocessJobs[i] = related.R.ReprocessJobs[ln-1]
+		}
+		related.R.ReprocessJobs = related.R.ReprocessJobs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetIntegrationG of the reprocessJob to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ReprocessJobs.
+// Uses the global database handle.
+func (o *ReprocessJob) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the reprocessJob to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.ReprocessJobs.
+func (o *ReprocessJob) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &reprocessJobR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			ReprocessJobs: ReprocessJobSlice{o},
+		}
+	} else {
+		related.R.ReprocessJobs = append(related.R.ReprocessJobs, o)
+	}
+
+	return nil
+}
+
+// ReprocessJobs retrieves all the records using an executor.
+func ReprocessJobs(mods ...qm.QueryMod) reprocessJobQuery {
+	mods = append(mods, qm.From("\"reprocess_jobs\""))
+	return reprocessJobQuery{NewQuery(mods...)}
+}
+
+// FindReprocessJobG retrieves a single record by ID.
+func FindReprocessJobG(iD int64, selectCols ...string) (*ReprocessJob, error) {
+	return FindReprocessJob(boil.GetDB(), iD, selectCols...)
+}
+
+// FindReprocessJob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReprocessJob(exec boil.Executor, iD int64, selectCols ...string) (*ReprocessJob, error) {
+	reprocessJobObj := &ReprocessJob{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reprocess_jobs\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, reprocessJobObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from reprocess_jobs")
+	}
+
+	return reprocessJobObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ReprocessJob) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReprocessJob) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no reprocess_jobs provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reprocessJobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reprocessJobInsertCacheMut.RLock()
+	cache, cached := reprocessJobInsertCache[key]
+	reprocessJobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reprocessJobAllColumns,
+			reprocessJobColumnsWithDefault,
+			reprocessJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reprocessJobType, reprocessJobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reprocessJobType, reprocessJobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reprocess_jobs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reprocess_jobs\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"reprocess_jobs\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into reprocess_jobs")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == reprocessJobMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for reprocess_jobs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		reprocessJobInsertCacheMut.Lock()
+		reprocessJobInsertCache[key] = cache
+		reprocessJobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single ReprocessJob record using the global executor.
+// See Update for more documentation.
+func (o *ReprocessJob) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the ReprocessJob.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReprocessJob) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reprocessJobUpdateCacheMut.RLock()
+	cache, cached := reprocessJobUpdateCache[key]
+	reprocessJobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reprocessJobAllColumns,
+			reprocessJobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update reprocess_jobs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reprocessJobType, reprocessJobMapping, append(wl, reprocessJobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update reprocess_jobs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for reprocess_jobs")
+	}
+
+	if !cached {
+		reprocessJobUpdateCacheMut.Lock()
+		reprocessJobUpdateCache[key] = cache
+		reprocessJobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q reprocessJobQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reprocessJobQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for reprocess_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for reprocess_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ReprocessJobSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReprocessJobSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reprocessJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, reprocessJobPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in reprocessJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all reprocessJob")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single ReprocessJob record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ReprocessJob) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single ReprocessJob record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReprocessJob) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no ReprocessJob provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reprocessJobPrimaryKeyMapping)
+	sql := "DELETE FROM \"reprocess_jobs\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from reprocess_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for reprocess_jobs")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reprocessJobQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no reprocessJobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from reprocess_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for reprocess_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ReprocessJobSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReprocessJobSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reprocessJobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reprocessJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reprocess_jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, reprocessJobPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from reprocessJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for reprocess_jobs")
+	}
+
+	if len(reprocessJobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ReprocessJob) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no ReprocessJob provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReprocessJob) Reload(exec boil.Executor) error {
+	ret, err := FindReprocessJob(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReprocessJobSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty ReprocessJobSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReprocessJobSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReprocessJobSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reprocessJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reprocess_jobs\".* FROM \"reprocess_jobs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, reprocessJobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in ReprocessJobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReprocessJobExistsG checks if the ReprocessJob row exists.
+func ReprocessJobExistsG(iD int64) (bool, error) {
+	return ReprocessJobExists(boil.GetDB(), iD)
+}
+
+// ReprocessJobExists checks if the ReprocessJob row exists.
+func ReprocessJobExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reprocess_jobs\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if reprocess_jobs exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1657 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// Roster is an object representing the database table.
+type Roster struct {
+	ID            int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID int64     `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	TeacherID     int64     `boil:"teacher_id" json:"teacher_id" toml:"teacher_id" yaml:"teacher_id"`
+	Name          string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Archived      bool      `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	ArchivedAt    null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *rosterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rosterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RosterColumns = struct {
+	ID            string
+	IntegrationID string
+	TeacherID     string
+	Name          string
+	Archived      string
+	ArchivedAt    string
+	UpdatedAt     string
+	CreatedAt     string
+}{
+	ID:            "id",
+	IntegrationID: "integration_id",
+	TeacherID:     "teacher_id",
+	Name:          "name",
+	Archived:      "archived",
+	ArchivedAt:    "archived_at",
+	UpdatedAt:     "updated_at",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+var RosterWhere = struct {
+	ID            whereHelperint64
+	IntegrationID whereHelperint64
+	TeacherID     whereHelperint64
+	Name          whereHelperstring
+	Archived      whereHelperbool
+	ArchivedAt    whereHelpernull_Time
+	UpdatedAt     whereHelpertime_Time
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"rosters\".\"id\""},
+	IntegrationID: whereHelperint64{field: "\"rosters\".\"integration_id\""},
+	TeacherID:     whereHelperint64{field: "\"rosters\".\"teacher_id\""},
+	Name:          whereHelperstring{field: "\"rosters\".\"name\""},
+	Archived:      whereHelperbool{field: "\"rosters\".\"archived\""},
+	ArchivedAt:    whereHelpernull_Time{field: "\"rosters\".\"archived_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"rosters\".\"updated_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"rosters\".\"created_at\""},
+}
+
+// RosterRels is where relationship names are stored.
+var RosterRels = struct {
+	Teacher     string
+	Integration string
+	Enrolment   string
+	Schedules   string
+}{
+	Teacher:     "Teacher",
+	Integration: "Integration",
+	Enrolment:   "Enrolment",
+	Schedules:   "Schedules",
+}
+
+// rosterR is where relationships are stored.
+type rosterR struct {
+	Teacher     *Friend
+	Integration *Integration
+	Enrolment   *Enrolment
+	Schedules   ScheduleSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*rosterR) NewStruct() *rosterR {
+	return &rosterR{}
+}
+
+// rosterL is where Load methods for each relationship are stored.
+type rosterL struct{}
+
+var (
+	rosterAllColumns            = []string{"id", "integration_id", "teacher_id", "name", "archived", "archived_at", "updated_at", "created_at"}
+	rosterColumnsWithoutDefault = []string{"integration_id", "teacher_id", "name", "archived_at"}
+	rosterColumnsWithDefault    = []string{"id", "archived", "updated_at", "created_at"}
+	rosterPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// RosterSlice is an alias for a slice of pointers to Roster.
+	// This should generally be used opposed to []Roster.
+	RosterSlice []*Roster
+	// RosterHook is the signature for custom Roster hook methods
+	RosterHook func(boil.Executor, *Roster) error
+
+	rosterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rosterType                 = reflect.TypeOf(&Roster{})
+	rosterMapping              = queries.MakeStructMapping(rosterType)
+	rosterPrimaryKeyMapping, _ = queries.BindMapping(rosterType, rosterMapping, rosterPrimaryKeyColumns)
+	rosterInsertCacheMut       sync.RWMutex
+	rosterInsertCache          = make(map[string]insertCache)
+	rosterUpdateCacheMut       sync.RWMutex
+	rosterUpdateCache          = make(map[string]updateCache)
+	rosterUpsertCacheMut       sync.RWMutex
+	rosterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rosterBeforeInsertHooks []RosterHook
+var rosterBeforeUpdateHooks []RosterHook
+var rosterBeforeDeleteHooks []RosterHook
+var rosterBeforeUpsertHooks []RosterHook
+
+var rosterAfterInsertHooks []RosterHook
+var rosterAfterSelectHooks []RosterHook
+var rosterAfterUpdateHooks []RosterHook
+var rosterAfterDeleteHooks []RosterHook
+var rosterAfterUpsertHooks []RosterHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Roster) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Roster) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Roster) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Roster) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Roster) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Roster) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Roster) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Roster) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Roster) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range rosterAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRosterHook registers your hook function for all future operations.
+func AddRosterHook(hookPoint boil.HookPoint, rosterHook RosterHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		rosterBeforeInsertHooks = append(rosterBeforeInsertHooks, rosterHook)
+	case boil.BeforeUpdateHook:
+		rosterBeforeUpdateHooks = append(rosterBeforeUpdateHooks, rosterHook)
+	case boil.BeforeDeleteHook:
+		rosterBeforeDeleteHooks = append(rosterBeforeDeleteHooks, rosterHook)
+	case boil.BeforeUpsertHook:
+		rosterBeforeUpsertHooks = append(rosterBeforeUpsertHooks, rosterHook)
+	case boil.AfterInsertHook:
+		rosterAfterInsertHooks = append(rosterAfterInsertHooks, rosterHook)
+	case boil.AfterSelectHook:
+		rosterAfterSelectHooks = append(rosterAfterSelectHooks, rosterHook)
+	case boil.AfterUpdateHook:
+		rosterAfterUpdateHooks = append(rosterAfterUpdateHooks, rosterHook)
+	case boil.AfterDeleteHook:
+		rosterAfterDeleteHooks = append(rosterAfterDeleteHooks, rosterHook)
+	case boil.AfterUpsertHook:
+		rosterAfterUpsertHooks = append(rosterAfterUpsertHooks, rosterHook)
+	}
+}
+
+// OneG returns a single roster record from the query using the global executor.
+func (q rosterQuery) OneG() (*Roster, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single roster record from the query.
+func (q rosterQuery) One(exec boil.Executor) (*Roster, error) {
+	o := &Roster{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for rosters")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Roster records from the query using the global executor.
+func (q rosterQuery) AllG() (RosterSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Roster records from the query.
+func (q rosterQuery) All(exec boil.Executor) (RosterSlice, error) {
+	var o []*Roster
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to Roster slice")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Roster records in the query, and panics on error.
+func (q rosterQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Roster records in the query.
+func (q rosterQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count rosters rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q rosterQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rosterQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if rosters exists")
+	}
+
+	return count > 0, nil
+}
+
+// Teacher pointed to by the foreign key.
+func (o *Roster) Teacher(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeacherID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *Roster) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// Enrolment pointed to by the foreign key.
+func (o *Roster) Enrolment(mods ...qm.QueryMod) enrolmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"roster_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Enrolments(queryMods...)
+	queries.SetFrom(query.Query, "\"enrolments\"")
+
+	return query
+}
+
+// Schedules retrieves all the schedule's Schedules with an executor.
+func (o *Roster) Schedules(mods ...qm.QueryMod) scheduleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"schedules\".\"roster_id\"=?", o.ID),
+	)
+
+	query := Schedules(queryMods...)
+	queries.SetFrom(query.Query, "\"schedules\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"schedules\".*"})
+	}
+
+	return query
+}
+
+// LoadTeacher allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rosterL) LoadTeacher(e boil.Executor, singular bool, maybeRoster interface{}, mods queries.Applicator) error {
+	var slice []*Roster
+	var object *Roster
+
+	if singular {
+		object = maybeRoster.(*Roster)
+	} else {
+		slice = *maybeRoster.(*[]*Roster)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rosterR{}
+		}
+		args = append(args, object.TeacherID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rosterR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TeacherID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TeacherID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Teacher = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.TeacherRosters = append(foreign.R.TeacherRosters, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeacherID == foreign.ID {
+				local.R.Teacher = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.TeacherRosters = append(foreign.R.TeacherRosters, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rosterL) LoadIntegration(e boil.Executor, singular bool, maybeRoster interface{}, mods queries.Applicator) error {
+	var slice []*Roster
+	var object *Roster
+
+	if singular {
+		object = maybeRoster.(*Roster)
+	} else {
+		slice = *maybeRoster.(*[]*Roster)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rosterR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rosterR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.Rosters = append(foreign.R.Rosters, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.Rosters = append(foreign.R.Rosters, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEnrolment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (rosterL) LoadEnrolment(e boil.Executor, singular bool, maybeRoster interface{}, mods queries.Applicator) error {
+	var slice []*Roster
+	var object *Roster
+
+	if singular {
+		object = maybeRoster.(*Roster)
+	} else {
+		slice = *maybeRoster.(*[]*Roster)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rosterR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rosterR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`enrolments`), qm.WhereIn(`enrolments.roster_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Enrolment")
+	}
+
+	var resultSlice []*Enrolment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Enrolment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for enrolments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for enrolments")
+	}
+
+	if len(rosterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Enrolment = foreign
+		if foreign.R == nil {
+			foreign.R = &enrolmentR{}
+		}
+		foreign.R.Roster = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.RosterID {
+				local.R.Enrolment = foreign
+				if foreign.R == nil {
+					foreign.R = &enrolmentR{}
+				}
+				foreign.R.Roster = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (rosterL) LoadSchedules(e boil.Executor, singular bool, maybeRoster interface{}, mods queries.Applicator) error {
+	var slice []*Roster
+	var object *Roster
+
+	if singular {
+		object = maybeRoster.(*Roster)
+	} else {
+		slice = *maybeRoster.(*[]*Roster)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rosterR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rosterR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`schedules`), qm.WhereIn(`schedules.roster_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load schedules")
+	}
+
+	var resultSlice []*Schedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for schedules")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Schedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scheduleR{}
+			}
+			foreign.R.Roster = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RosterID) {
+				local.R.Schedules = append(local.R.Schedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &scheduleR{}
+				}
+				foreign.R.Roster = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTeacherG of the roster to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherRosters.
+// Uses the global database handle.
+func (o *Roster) SetTeacherG(insert bool, related *Friend) error {
+	return o.SetTeacher(boil.GetDB(), insert, related)
+}
+
+// SetTeacher of the roster to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherRosters.
+func (o *Roster) SetTeacher(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rosters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+		strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeacherID = related.ID
+	if o.R == nil {
+		o.R = &rosterR{
+			Teacher: related,
+		}
+	} else {
+		o.R.Teacher = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			TeacherRosters: RosterSlice{o},
+		}
+	} else {
+		related.R.TeacherRosters = append(related.R.TeacherRosters, o)
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the roster to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Rosters.
+// Uses the global database handle.
+func (o *Roster) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the roster to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Rosters.
+func (o *Roster) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rosters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &rosterR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			Rosters: RosterSlice{o},
+		}
+	} else {
+		related.R.Rosters = append(related.R.Rosters, o)
+	}
+
+	return nil
+}
+
+// SetEnrolmentG of the roster to the related item.
+// Sets o.R.Enrolment to related.
+// Adds o to related.R.Roster.
+// Uses the global database handle.
+func (o *Roster) SetEnrolmentG(insert bool, related *Enrolment) error {
+	return o.SetEnrolment(boil.GetDB(), insert, related)
+}
+
+// SetEnrolment of the roster to the related item.
+// Sets o.R.Enrolment to related.
+// Adds o to related.R.Roster.
+func (o *Roster) SetEnrolment(exec boil.Executor, insert bool, related *Enrolment) error {
+	var err error
+
+	if insert {
+		related.RosterID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"enrolments\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"roster_id"}),
+			strmangle.WhereClause("\"", "\"", 0, enrolmentPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.RosterID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &rosterR{
+			Enrolment: related,
+		}
+	} else {
+		o.R.Enrolment = related
+	}
+
+	if related.R == nil {
+		related.R = &enrolmentR{
+			Roster: o,
+		}
+	} else {
+		related.R.Roster = o
+	}
+	return nil
+}
+
+// AddSchedulesG adds the given related objects to the existing relationships
+// of the roster, optionally inserting them as new records.
+// Appends related to o.R.Schedules.
+// Sets related.R.Roster appropriately.
+// Uses the global database handle.
+func (o *Roster) AddSchedulesG(insert bool, related ...*Schedule) error {
+	return o.AddSchedules(boil.GetDB(), insert, related...)
+}
+
+// AddSchedules adds the given related objects to the existing relationships
+// of the roster, optionally inserting them as new records.
+// Appends related to o.R.Schedules.
+// Sets related.R.Roster appropriately.
+func (o *Roster) AddSchedules(exec boil.Executor, insert bool, related ...*Schedule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RosterID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"schedules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"roster_id"}),
+				strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RosterID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &rosterR{
+			Schedules: related,
+		}
+	} else {
+		o.R.Schedules = append(o.R.Schedules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scheduleR{
+				Roster: o,
+			}
+		} else {
+			rel.R.Roster = o
+		}
+	}
+	return nil
+}
+
+// SetSchedulesG removes all previously related items of the
+// roster replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roster's Schedules accordingly.
+// Replaces o.R.Schedules with related.
+// Sets related.R.Roster's Schedules accordingly.
+// Uses the global database handle.
+func (o *Roster) SetSchedulesG(insert bool, related ...*Schedule) error {
+	return o.SetSchedules(boil.GetDB(), insert, related...)
+}
+
+// SetSchedules removes all previously related items of the
+// roster replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roster's Schedules accordingly.
+// Replaces o.R.Schedules with related.
+// Sets related.R.Roster's Schedules accordingly.
+func (o *Roster) SetSchedules(exec boil.Executor, insert bool, related ...*Schedule) error {
+	query := "update \"schedules\" set \"roster_id\" = null where \"roster_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Schedules {
+			queries.SetScanner(&rel.RosterID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Roster = nil
+		}
+
+		o.R.Schedules = nil
+	}
+	return o.AddSchedules(exec, insert, related...)
+}
+
+// RemoveSchedulesG relationships from objects passed in.
+// Removes related items from R.Schedules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roster.
+// Uses the global database handle.
+func (o *Roster) RemoveSchedulesG(related ...*Schedule) error {
+	return o.RemoveSchedules(boil.GetDB(), related...)
+}
+
+// RemoveSchedules relationships from objects passed in.
+// Removes related items from R.Schedules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roster.
+func (o *Roster) RemoveSchedules(exec boil.Executor, related ...*Schedule) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RosterID, nil)
+		if rel.R != nil {
+			rel.R.Roster = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("roster_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Schedules {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Schedules)
+			if ln > 1 && i < ln-1 {
+				o.R.Schedules[i] = o.R.Schedules[ln-1]
+			}
+			o.R.Schedules = o.R.Schedules[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Rosters retrieves all the records using an executor.
+func Rosters(mods ...qm.QueryMod) rosterQuery {
+	mods = append(mods, qm.From("\"rosters\""))
+	return rosterQuery{NewQuery(mods...)}
+}
+
+// FindRosterG retrieves a single record by ID.
+func FindRosterG(iD int64, selectCols ...string) (*Roster, error) {
+	return FindRoster(boil.GetDB(), iD, selectCols...)
+}
+
+// FindRoster retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRoster(exec boil.Executor, iD int64, selectCols ...string) (*Roster, error) {
+	rosterObj := &Roster{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rosters\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, rosterObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from rosters")
+	}
+
+	return rosterObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Roster) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Roster) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no rosters provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rosterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rosterInsertCacheMut.RLock()
+	cache, cached := rosterInsertCache[key]
+	rosterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rosterAllColumns,
+			rosterColumnsWithDefault,
+			rosterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rosterType, rosterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rosterType, rosterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rosters\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rosters\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"rosters\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into rosters")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == rosterMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for rosters")
+	}
+
+CacheNoHooks:
+	if !cached {
+		rosterInsertCacheMut.Lock()
+		rosterInsertCache[key] = cache
+		rosterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Roster record using the global executor.
+// See Update for more documentation.
+func (o *Roster) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Roster.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Roster) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rosterUpdateCacheMut.RLock()
+	cache, cached := rosterUpdateCache[key]
+	rosterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rosterAllColumns,
+			rosterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update rosters, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rosters\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, rosterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rosterType, rosterMapping, append(wl, rosterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update rosters row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for rosters")
+	}
+
+	if !cached {
+		rosterUpdateCacheMut.Lock()
+		rosterUpdateCache[key] = cache
+		rosterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rosterQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rosterQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for rosters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for rosters")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RosterSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RosterSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rosterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rosters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rosterPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in roster slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all roster")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single Roster record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Roster) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Roster record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Roster) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no Roster provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rosterPrimaryKeyMapping)
+	sql := "DELETE FROM \"rosters\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from rosters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for rosters")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rosterQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no rosterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from rosters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for rosters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RosterSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RosterSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rosterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rosterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rosters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rosterPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from roster slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for rosters")
+	}
+
+	if len(rosterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Roster) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no Roster provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Roster) Reload(exec boil.Executor) error {
+	ret, err := FindRoster(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RosterSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty RosterSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RosterSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RosterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rosterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rosters\".* FROM \"rosters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rosterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in RosterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RosterExistsG checks if the Roster row exists.
+func RosterExistsG(iD int64) (bool, error) {
+	return RosterExists(boil.GetDB(), iD)
+}
+
+// RosterExists checks if the Roster row exists.
+func RosterExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rosters\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if rosters exists")
+	}
+
+	return exists, nil
+}
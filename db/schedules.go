@@ -0,0 +1,1648 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// Schedule is an object representing the database table.
+type Schedule struct {
+	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID      int64       `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	TeacherID          int64       `boil:"teacher_id" json:"teacher_id" toml:"teacher_id" yaml:"teacher_id"`
+	Name               string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Weekdays           string      `boil:"weekdays" json:"weekdays" toml:"weekdays" yaml:"weekdays"`
+	StartTime          string      `boil:"start_time" json:"start_time" toml:"start_time" yaml:"start_time"`
+	EndTime            string      `boil:"end_time" json:"end_time" toml:"end_time" yaml:"end_time"`
+	TimeZone           string      `boil:"time_zone" json:"time_zone" toml:"time_zone" yaml:"time_zone"`
+	WorldID            null.String `boil:"world_id" json:"world_id,omitempty" toml:"world_id" yaml:"world_id,omitempty"`
+	Archived           bool        `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	ArchivedAt         null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RosterID           null.Int64  `boil:"roster_id" json:"roster_id,omitempty" toml:"roster_id" yaml:"roster_id,omitempty"`
+	MinPresencePercent null.Int64  `boil:"min_presence_percent" json:"min_presence_percent,omitempty" toml:"min_presence_percent" yaml:"min_presence_percent,omitempty"`
+	LateAfterMinutes   null.Int64  `boil:"late_after_minutes" json:"late_after_minutes,omitempty" toml:"late_after_minutes" yaml:"late_after_minutes,omitempty"`
+	LeftEarlyMinutes   null.Int64  `boil:"left_early_minutes" json:"left_early_minutes,omitempty" toml:"left_early_minutes" yaml:"left_early_minutes,omitempty"`
+
+	R *scheduleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scheduleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScheduleColumns = struct {
+	ID                 string
+	IntegrationID      string
+	TeacherID          string
+	Name               string
+	Weekdays           string
+	StartTime          string
+	EndTime            string
+	TimeZone           string
+	WorldID            string
+	Archived           string
+	ArchivedAt         string
+	UpdatedAt          string
+	CreatedAt          string
+	RosterID           string
+	MinPresencePercent string
+	LateAfterMinutes   string
+	LeftEarlyMinutes   string
+}{
+	ID:                 "id",
+	IntegrationID:      "integration_id",
+	TeacherID:          "teacher_id",
+	Name:               "name",
+	Weekdays:           "weekdays",
+	StartTime:          "start_time",
+	EndTime:            "end_time",
+	TimeZone:           "time_zone",
+	WorldID:            "world_id",
+	Archived:           "archived",
+	ArchivedAt:         "archived_at",
+	UpdatedAt:          "updated_at",
+	CreatedAt:          "created_at",
+	RosterID:           "roster_id",
+	MinPresencePercent: "min_presence_percent",
+	LateAfterMinutes:   "late_after_minutes",
+	LeftEarlyMinutes:   "left_early_minutes",
+}
+
+// Generated where
+
+var ScheduleWhere = struct {
+	ID                 whereHelperint64
+	IntegrationID      whereHelperint64
+	TeacherID          whereHelperint64
+	Name               whereHelperstring
+	Weekdays           whereHelperstring
+	StartTime          whereHelperstring
+	EndTime            whereHelperstring
+	TimeZone           whereHelperstring
+	WorldID            whereHelpernull_String
+	Archived           whereHelperbool
+	ArchivedAt         whereHelpernull_Time
+	UpdatedAt          whereHelpertime_Time
+	CreatedAt          whereHelpertime_Time
+	RosterID           whereHelpernull_Int64
+	MinPresencePercent whereHelpernull_Int64
+	LateAfterMinutes   whereHelpernull_Int64
+	LeftEarlyMinutes   whereHelpernull_Int64
+}{
+	ID:                 whereHelperint64{field: "\"schedules\".\"id\""},
+	IntegrationID:      whereHelperint64{field: "\"schedules\".\"integration_id\""},
+	TeacherID:          whereHelperint64{field: "\"schedules\".\"teacher_id\""},
+	Name:               whereHelperstring{field: "\"schedules\".\"name\""},
+	Weekdays:           whereHelperstring{field: "\"schedules\".\"weekdays\""},
+	StartTime:          whereHelperstring{field: "\"schedules\".\"start_time\""},
+	EndTime:            whereHelperstring{field: "\"schedules\".\"end_time\""},
+	TimeZone:           whereHelperstring{field: "\"schedules\".\"time_zone\""},
+	WorldID:            whereHelpernull_String{field: "\"schedules\".\"world_id\""},
+	Archived:           whereHelperbool{field: "\"schedules\".\"archived\""},
+	ArchivedAt:         whereHelpernull_Time{field: "\"schedules\".\"archived_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"schedules\".\"updated_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"schedules\".\"created_at\""},
+	RosterID:           whereHelpernull_Int64{field: "\"schedules\".\"roster_id\""},
+	MinPresencePercent: whereHelpernull_Int64{field: "\"schedules\".\"min_presence_percent\""},
+	LateAfterMinutes:   whereHelpernull_Int64{field: "\"schedules\".\"late_after_minutes\""},
+	LeftEarlyMinutes:   whereHelpernull_Int64{field: "\"schedules\".\"left_early_minutes\""},
+}
+
+// ScheduleRels is where relationship names are stored.
+var ScheduleRels = struct {
+	Roster       string
+	Teacher      string
+	Integration  string
+	ClassSession string
+}{
+	Roster:       "Roster",
+	Teacher:      "Teacher",
+	Integration:  "Integration",
+	ClassSession: "ClassSession",
+}
+
+// scheduleR is where relationships are stored.
+type scheduleR struct {
+	Roster       *Roster
+	Teacher      *Friend
+	Integration  *Integration
+	ClassSession *ClassSession
+}
+
+// NewStruct creates a new relationship struct
+func (*scheduleR) NewStruct() *scheduleR {
+	return &scheduleR{}
+}
+
+// scheduleL is where Load methods for each relationship are stored.
+type scheduleL struct{}
+
+var (
+	scheduleAllColumns            = []string{"id", "integration_id", "teacher_id", "name", "weekdays", "start_time", "end_time", "time_zone", "world_id", "archived", "archived_at", "updated_at", "created_at", "roster_id", "min_presence_percent", "late_after_minutes", "left_early_minutes"}
+	scheduleColumnsWithoutDefault = []string{"integration_id", "teacher_id", "name", "weekdays", "start_time", "end_time", "world_id", "archived_at", "roster_id", "min_presence_percent", "late_after_minutes", "left_early_minutes"}
+	scheduleColumnsWithDefault    = []string{"id", "time_zone", "archived", "updated_at", "created_at"}
+	schedulePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ScheduleSlice is an alias for a slice of pointers to Schedule.
+	// This should generally be used opposed to []Schedule.
+	ScheduleSlice []*Schedule
+	// ScheduleHook is the signature for custom Schedule hook methods
+	ScheduleHook func(boil.Executor, *Schedule) error
+
+	scheduleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scheduleType                 = reflect.TypeOf(&Schedule{})
+	scheduleMapping              = queries.MakeStructMapping(scheduleType)
+	schedulePrimaryKeyMapping, _ = queries.BindMapping(scheduleType, scheduleMapping, schedulePrimaryKeyColumns)
+	scheduleInsertCacheMut       sync.RWMutex
+	scheduleInsertCache          = make(map[string]insertCache)
+	scheduleUpdateCacheMut       sync.RWMutex
+	scheduleUpdateCache          = make(map[string]updateCache)
+	scheduleUpsertCacheMut       sync.RWMutex
+	scheduleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scheduleBeforeInsertHooks []ScheduleHook
+var scheduleBeforeUpdateHooks []ScheduleHook
+var scheduleBeforeDeleteHooks []ScheduleHook
+var scheduleBeforeUpsertHooks []ScheduleHook
+
+var scheduleAfterInsertHooks []ScheduleHook
+var scheduleAfterSelectHooks []ScheduleHook
+var scheduleAfterUpdateHooks []ScheduleHook
+var scheduleAfterDeleteHooks []ScheduleHook
+var scheduleAfterUpsertHooks []ScheduleHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Schedule) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Schedule) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Schedule) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Schedule) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Schedule) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Schedule) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Schedule) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Schedule) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Schedule) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range scheduleAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScheduleHook registers your hook function for all future operations.
+func AddScheduleHook(hookPoint boil.HookPoint, scheduleHook ScheduleHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		scheduleBeforeInsertHooks = append(scheduleBeforeInsertHooks, scheduleHook)
+	case boil.BeforeUpdateHook:
+		scheduleBeforeUpdateHooks = append(scheduleBeforeUpdateHooks, scheduleHook)
+	case boil.BeforeDeleteHook:
+		scheduleBeforeDeleteHooks = append(scheduleBeforeDeleteHooks, scheduleHook)
+	case boil.BeforeUpsertHook:
+		scheduleBeforeUpsertHooks = append(scheduleBeforeUpsertHooks, scheduleHook)
+	case boil.AfterInsertHook:
+		scheduleAfterInsertHooks = append(scheduleAfterInsertHooks, scheduleHook)
+	case boil.AfterSelectHook:
+		scheduleAfterSelectHooks = append(scheduleAfterSelectHooks, scheduleHook)
+	case boil.AfterUpdateHook:
+		scheduleAfterUpdateHooks = append(scheduleAfterUpdateHooks, scheduleHook)
+	case boil.AfterDeleteHook:
+		scheduleAfterDeleteHooks = append(scheduleAfterDeleteHooks, scheduleHook)
+	case boil.AfterUpsertHook:
+		scheduleAfterUpsertHooks = append(scheduleAfterUpsertHooks, scheduleHook)
+	}
+}
+
+// OneG returns a single schedule record from the query using the global executor.
+func (q scheduleQuery) OneG() (*Schedule, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single schedule record from the query.
+func (q scheduleQuery) One(exec boil.Executor) (*Schedule, error) {
+	o := &Schedule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for schedules")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Schedule records from the query using the global executor.
+func (q scheduleQuery) AllG() (ScheduleSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Schedule records from the query.
+func (q scheduleQuery) All(exec boil.Executor) (ScheduleSlice, error) {
+	var o []*Schedule
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to Schedule slice")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Schedule records in the query, and panics on error.
+func (q scheduleQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Schedule records in the query.
+func (q scheduleQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count schedules rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q scheduleQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q scheduleQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if schedules exists")
+	}
+
+	return count > 0, nil
+}
+
+// Roster pointed to by the foreign key.
+func (o *Schedule) Roster(mods ...qm.QueryMod) rosterQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RosterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Rosters(queryMods...)
+	queries.SetFrom(query.Query, "\"rosters\"")
+
+	return query
+}
+
+// Teacher pointed to by the foreign key.
+func (o *Schedule) Teacher(mods ...qm.QueryMod) friendQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeacherID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Friends(queryMods...)
+	queries.SetFrom(query.Query, "\"friends\"")
+
+	return query
+}
+
+// Integration pointed to by the foreign key.
+func (o *Schedule) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// ClassSession pointed to by the foreign key.
+func (o *Schedule) ClassSession(mods ...qm.QueryMod) classSessionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"schedule_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ClassSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"class_sessions\"")
+
+	return query
+}
+
+// LoadRoster allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scheduleL) LoadRoster(e boil.Executor, singular bool, maybeSchedule interface{}, mods queries.Applicator) error {
+	var slice []*Schedule
+	var object *Schedule
+
+	if singular {
+		object = maybeSchedule.(*Schedule)
+	} else {
+		slice = *maybeSchedule.(*[]*Schedule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduleR{}
+		}
+		if !queries.IsNil(object.RosterID) {
+			args = append(args, object.RosterID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RosterID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RosterID) {
+				args = append(args, obj.RosterID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`rosters`), qm.WhereIn(`rosters.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Roster")
+	}
+
+	var resultSlice []*Roster
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Roster")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for rosters")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rosters")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Roster = foreign
+		if foreign.R == nil {
+			foreign.R = &rosterR{}
+		}
+		foreign.R.Schedules = append(foreign.R.Schedules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RosterID, foreign.ID) {
+				local.R.Roster = foreign
+				if foreign.R == nil {
+					foreign.R = &rosterR{}
+				}
+				foreign.R.Schedules = append(foreign.R.Schedules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeacher allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scheduleL) LoadTeacher(e boil.Executor, singular bool, maybeSchedule interface{}, mods queries.Applicator) error {
+	var slice []*Schedule
+	var object *Schedule
+
+	if singular {
+		object = maybeSchedule.(*Schedule)
+	} else {
+		slice = *maybeSchedule.(*[]*Schedule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduleR{}
+		}
+		args = append(args, object.TeacherID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TeacherID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TeacherID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`friends`), qm.WhereIn(`friends.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Friend")
+	}
+
+	var resultSlice []*Friend
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Friend")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for friends")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friends")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Teacher = foreign
+		if foreign.R == nil {
+			foreign.R = &friendR{}
+		}
+		foreign.R.TeacherSchedules = append(foreign.R.TeacherSchedules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeacherID == foreign.ID {
+				local.R.Teacher = foreign
+				if foreign.R == nil {
+					foreign.R = &friendR{}
+				}
+				foreign.R.TeacherSchedules = append(foreign.R.TeacherSchedules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scheduleL) LoadIntegration(e boil.Executor, singular bool, maybeSchedule interface{}, mods queries.Applicator) error {
+	var slice []*Schedule
+	var object *Schedule
+
+	if singular {
+		object = maybeSchedule.(*Schedule)
+	} else {
+		slice = *maybeSchedule.(*[]*Schedule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduleR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.Schedules = append(foreign.R.Schedules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.Schedules = append(foreign.R.Schedules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClassSession allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (scheduleL) LoadClassSession(e boil.Executor, singular bool, maybeSchedule interface{}, mods queries.Applicator) error {
+	var slice []*Schedule
+	var object *Schedule
+
+	if singular {
+		object = maybeSchedule.(*Schedule)
+	} else {
+		slice = *maybeSchedule.(*[]*Schedule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`class_sessions`), qm.WhereIn(`class_sessions.schedule_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ClassSession")
+	}
+
+	var resultSlice []*ClassSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ClassSession")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for class_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_sessions")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ClassSession = foreign
+		if foreign.R == nil {
+			foreign.R = &classSessionR{}
+		}
+		foreign.R.Schedule = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ClassSession = foreign
+				if foreign.R == nil {
+					foreign.R = &classSessionR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRosterG of the schedule to the related item.
+// Sets o.R.Roster to related.
+// Adds o to related.R.Schedules.
+// Uses the global database handle.
+func (o *Schedule) SetRosterG(insert bool, related *Roster) error {
+	return o.SetRoster(boil.GetDB(), insert, related)
+}
+
+// SetRoster of the schedule to the related item.
+// Sets o.R.Roster to related.
+// Adds o to related.R.Schedules.
+func (o *Schedule) SetRoster(exec boil.Executor, insert bool, related *Roster) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"roster_id"}),
+		strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RosterID, related.ID)
+	if o.R == nil {
+		o.R = &scheduleR{
+			Roster: related,
+		}
+	} else {
+		o.R.Roster = related
+	}
+
+	if related.R == nil {
+		related.R = &rosterR{
+			Schedules: ScheduleSlice{o},
+		}
+	} else {
+		related.R.Schedules = append(related.R.Schedules, o)
+	}
+
+	return nil
+}
+
+// RemoveRosterG relationship.
+// Sets o.R.Roster to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+// Uses the global database handle.
+func (o *Schedule) RemoveRosterG(related *Roster) error {
+	return o.RemoveRoster(boil.GetDB(), related)
+}
+
+// RemoveRoster relationship.
+// Sets o.R.Roster to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Schedule) RemoveRoster(exec boil.Executor, related *Roster) error {
+	var err error
+
+	queries.SetScanner(&o.RosterID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("roster_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.Roster = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Schedules {
+		if queries.Equal(o.RosterID, ri.RosterID) {
+			continue
+		}
+
+		ln := len(related.R.Schedules)
+		if ln > 1 && i < ln-1 {
+			related.R.Schedules[i] = related.R.Schedules[ln-1]
+		}
+		related.R.Schedules = related.R.Schedules[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTeacherG of the schedule to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherSchedules.
+// Uses the global database handle.
+func (o *Schedule) SetTeacherG(insert bool, related *Friend) error {
+	return o.SetTeacher(boil.GetDB(), insert, related)
+}
+
+// SetTeacher of the schedule to the related item.
+// Sets o.R.Teacher to related.
+// Adds o to related.R.TeacherSchedules.
+func (o *Schedule) SetTeacher(exec boil.Executor, insert bool, related *Friend) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"teacher_id"}),
+		strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeacherID = related.ID
+	if o.R == nil {
+		o.R = &scheduleR{
+			Teacher: related,
+		}
+	} else {
+		o.R.Teacher = related
+	}
+
+	if related.R == nil {
+		related.R = &friendR{
+			TeacherSchedules: ScheduleSlice{o},
+		}
+	} else {
+		related.R.TeacherSchedules = append(related.R.TeacherSchedules, o)
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the schedule to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Schedules.
+// Uses the global database handle.
+func (o *Schedule) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the schedule to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.Schedules.
+func (o *Schedule) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &scheduleR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			Schedules: ScheduleSlice{o},
+		}
+	} else {
+		related.R.Schedules = append(related.R.Schedules, o)
+	}
+
+	return nil
+}
+
+// SetClassSessionG of the schedule to the related item.
+// Sets o.R.ClassSession to related.
+// Adds o to related.R.Schedule.
+// Uses the global database handle.
+func (o *Schedule) SetClassSessionG(insert bool, related *ClassSession) error {
+	return o.SetClassSession(boil.GetDB(), insert, related)
+}
+
+// SetClassSession of the schedule to the related item.
+// Sets o.R.ClassSession to related.
+// Adds o to related.R.Schedule.
+func (o *Schedule) SetClassSession(exec boil.Executor, insert bool, related *ClassSession) error {
+	var err error
+
+	if insert {
+		related.ScheduleID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"class_sessions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"schedule_id"}),
+			strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ScheduleID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &scheduleR{
+			ClassSession: related,
+		}
+	} else {
+		o.R.ClassSession = related
+	}
+
+	if related.R == nil {
+		related.R = &classSessionR{
+			Schedule: o,
+		}
+	} else {
+		related.R.Schedule = o
+	}
+	return nil
+}
+
+// Schedules retrieves all the records using an executor.
+func Schedules(mods ...qm.QueryMod) scheduleQuery {
+	mods = append(mods, qm.From("\"schedules\""))
+	return scheduleQuery{NewQuery(mods...)}
+}
+
+// FindScheduleG retrieves a single record by ID.
+func FindScheduleG(iD int64, selectCols ...string) (*Schedule, error) {
+	return FindSchedule(boil.GetDB(), iD, selectCols...)
+}
+
+// FindSchedule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSchedule(exec boil.Executor, iD int64, selectCols ...string) (*Schedule, error) {
+	scheduleObj := &Schedule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"schedules\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, scheduleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from schedules")
+	}
+
+	return scheduleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Schedule) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Schedule) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no schedules provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scheduleInsertCacheMut.RLock()
+	cache, cached := scheduleInsertCache[key]
+	scheduleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scheduleAllColumns,
+			scheduleColumnsWithDefault,
+			scheduleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduleType, scheduleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scheduleType, scheduleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"schedules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"schedules\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"schedules\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into schedules")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == scheduleMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for schedules")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scheduleInsertCacheMut.Lock()
+		scheduleInsertCache[key] = cache
+		scheduleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Schedule record using the global executor.
+// See Update for more documentation.
+func (o *Schedule) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Schedule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Schedule) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scheduleUpdateCacheMut.RLock()
+	cache, cached := scheduleUpdateCache[key]
+	scheduleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scheduleAllColumns,
+			schedulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update schedules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"schedules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, schedulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scheduleType, scheduleMapping, append(wl, schedulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update schedules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for schedules")
+	}
+
+	if !cached {
+		scheduleUpdateCacheMut.Lock()
+		scheduleUpdateCache[key] = cache
+		scheduleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q scheduleQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scheduleQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ScheduleSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScheduleSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, schedulePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in schedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all schedule")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single Schedule record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Schedule) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Schedule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Schedule) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no Schedule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), schedulePrimaryKeyMapping)
+	sql := "DELETE FROM \"schedules\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for schedules")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scheduleQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no scheduleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ScheduleSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScheduleSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scheduleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"schedules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, schedulePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from schedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for schedules")
+	}
+
+	if len(scheduleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Schedule) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no Schedule provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Schedule) Reload(exec boil.Executor) error {
+	ret, err := FindSchedule(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScheduleSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty ScheduleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScheduleSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScheduleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"schedules\".* FROM \"schedules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, schedulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in ScheduleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScheduleExistsG checks if the Schedule row exists.
+func ScheduleExistsG(iD int64) (bool, error) {
+	return ScheduleExists(boil.GetDB(), iD)
+}
+
+// ScheduleExists checks if the Schedule row exists.
+func ScheduleExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"schedules\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if schedules exists")
+	}
+
+	return exists, nil
+}
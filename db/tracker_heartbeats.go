@@ -0,0 +1,1033 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// TrackerHeartbeat is an object representing the database table.
+type TrackerHeartbeat struct {
+	ID            int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IntegrationID int64       `boil:"integration_id" json:"integration_id" toml:"integration_id" yaml:"integration_id"`
+	TickedAt      time.Time   `boil:"ticked_at" json:"ticked_at" toml:"ticked_at" yaml:"ticked_at"`
+	Succeeded     bool        `boil:"succeeded" json:"succeeded" toml:"succeeded" yaml:"succeeded"`
+	ErrorMessage  null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	Friends       int64       `boil:"friends" json:"friends" toml:"friends" yaml:"friends"`
+	DurationMS    int64       `boil:"duration_ms" json:"duration_ms" toml:"duration_ms" yaml:"duration_ms"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *trackerHeartbeatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L trackerHeartbeatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TrackerHeartbeatColumns = struct {
+	ID            string
+	IntegrationID string
+	TickedAt      string
+	Succeeded     string
+	ErrorMessage  string
+	Friends       string
+	DurationMS    string
+	CreatedAt     string
+}{
+	ID:            "id",
+	IntegrationID: "integration_id",
+	TickedAt:      "ticked_at",
+	Succeeded:     "succeeded",
+	ErrorMessage:  "error_message",
+	Friends:       "friends",
+	DurationMS:    "duration_ms",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+var TrackerHeartbeatWhere = struct {
+	ID            whereHelperint64
+	IntegrationID whereHelperint64
+	TickedAt      whereHelpertime_Time
+	Succeeded     whereHelperbool
+	ErrorMessage  whereHelpernull_String
+	Friends       whereHelperint64
+	DurationMS    whereHelperint64
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"tracker_heartbeats\".\"id\""},
+	IntegrationID: whereHelperint64{field: "\"tracker_heartbeats\".\"integration_id\""},
+	TickedAt:      whereHelpertime_Time{field: "\"tracker_heartbeats\".\"ticked_at\""},
+	Succeeded:     whereHelperbool{field: "\"tracker_heartbeats\".\"succeeded\""},
+	ErrorMessage:  whereHelpernull_String{field: "\"tracker_heartbeats\".\"error_message\""},
+	Friends:       whereHelperint64{field: "\"tracker_heartbeats\".\"friends\""},
+	DurationMS:    whereHelperint64{field: "\"tracker_heartbeats\".\"duration_ms\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"tracker_heartbeats\".\"created_at\""},
+}
+
+// TrackerHeartbeatRels is where relationship names are stored.
+var TrackerHeartbeatRels = struct {
+	Integration string
+}{
+	Integration: "Integration",
+}
+
+// trackerHeartbeatR is where relationships are stored.
+type trackerHeartbeatR struct {
+	Integration *Integration
+}
+
+// NewStruct creates a new relationship struct
+func (*trackerHeartbeatR) NewStruct() *trackerHeartbeatR {
+	return &trackerHeartbeatR{}
+}
+
+// trackerHeartbeatL is where Load methods for each relationship are stored.
+type trackerHeartbeatL struct{}
+
+var (
+	trackerHeartbeatAllColumns            = []string{"id", "integration_id", "ticked_at", "succeeded", "error_message", "friends", "duration_ms", "created_at"}
+	trackerHeartbeatColumnsWithoutDefault = []string{"integration_id", "ticked_at", "succeeded", "error_message"}
+	trackerHeartbeatColumnsWithDefault    = []string{"id", "friends", "duration_ms", "created_at"}
+	trackerHeartbeatPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TrackerHeartbeatSlice is an alias for a slice of pointers to TrackerHeartbeat.
+	// This should generally be used opposed to []TrackerHeartbeat.
+	TrackerHeartbeatSlice []*TrackerHeartbeat
+	// TrackerHeartbeatHook is the signature for custom TrackerHeartbeat hook methods
+	TrackerHeartbeatHook func(boil.Executor, *TrackerHeartbeat) error
+
+	trackerHeartbeatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	trackerHeartbeatType                 = reflect.TypeOf(&TrackerHeartbeat{})
+	trackerHeartbeatMapping              = queries.MakeStructMapping(trackerHeartbeatType)
+	trackerHeartbeatPrimaryKeyMapping, _ = queries.BindMapping(trackerHeartbeatType, trackerHeartbeatMapping, trackerHeartbeatPrimaryKeyColumns)
+	trackerHeartbeatInsertCacheMut       sync.RWMutex
+	trackerHeartbeatInsertCache          = make(map[string]insertCache)
+	trackerHeartbeatUpdateCacheMut       sync.RWMutex
+	trackerHeartbeatUpdateCache          = make(map[string]updateCache)
+	trackerHeartbeatUpsertCacheMut       sync.RWMutex
+	trackerHeartbeatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var trackerHeartbeatBeforeInsertHooks []TrackerHeartbeatHook
+var trackerHeartbeatBeforeUpdateHooks []TrackerHeartbeatHook
+var trackerHeartbeatBeforeDeleteHooks []TrackerHeartbeatHook
+var trackerHeartbeatBeforeUpsertHooks []TrackerHeartbeatHook
+
+var trackerHeartbeatAfterInsertHooks []TrackerHeartbeatHook
+var trackerHeartbeatAfterSelectHooks []TrackerHeartbeatHook
+var trackerHeartbeatAfterUpdateHooks []TrackerHeartbeatHook
+var trackerHeartbeatAfterDeleteHooks []TrackerHeartbeatHook
+var trackerHeartbeatAfterUpsertHooks []TrackerHeartbeatHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TrackerHeartbeat) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TrackerHeartbeat) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TrackerHeartbeat) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TrackerHeartbeat) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TrackerHeartbeat) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TrackerHeartbeat) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TrackerHeartbeat) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TrackerHeartbeat) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TrackerHeartbeat) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range trackerHeartbeatAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTrackerHeartbeatHook registers your hook function for all future operations.
+func AddTrackerHeartbeatHook(hookPoint boil.HookPoint, trackerHeartbeatHook TrackerHeartbeatHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		trackerHeartbeatBeforeInsertHooks = append(trackerHeartbeatBeforeInsertHooks, trackerHeartbeatHook)
+	case boil.BeforeUpdateHook:
+		trackerHeartbeatBeforeUpdateHooks = append(trackerHeartbeatBeforeUpdateHooks, trackerHeartbeatHook)
+	case boil.BeforeDeleteHook:
+		trackerHeartbeatBeforeDeleteHooks = append(trackerHeartbeatBeforeDeleteHooks, trackerHeartbeatHook)
+	case boil.BeforeUpsertHook:
+		trackerHeartbeatBeforeUpsertHooks = append(trackerHeartbeatBeforeUpsertHooks, trackerHeartbeatHook)
+	case boil.AfterInsertHook:
+		trackerHeartbeatAfterInsertHooks = append(trackerHeartbeatAfterInsertHooks, trackerHeartbeatHook)
+	case boil.AfterSelectHook:
+		trackerHeartbeatAfterSelectHooks = append(trackerHeartbeatAfterSelectHooks, trackerHeartbeatHook)
+	case boil.AfterUpdateHook:
+		trackerHeartbeatAfterUpdateHooks = append(trackerHeartbeatAfterUpdateHooks, trackerHeartbeatHook)
+	case boil.AfterDeleteHook:
+		trackerHeartbeatAfterDeleteHooks = append(trackerHeartbeatAfterDeleteHooks, trackerHeartbeatHook)
+	case boil.AfterUpsertHook:
+		trackerHeartbeatAfterUpsertHooks = append(trackerHeartbeatAfterUpsertHooks, trackerHeartbeatHook)
+	}
+}
+
+// OneG returns a single trackerHeartbeat record from the query using the global executor.
+func (q trackerHeartbeatQuery) OneG() (*TrackerHeartbeat, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single trackerHeartbeat record from the query.
+func (q trackerHeartbeatQuery) One(exec boil.Executor) (*TrackerHeartbeat, error) {
+	o := &TrackerHeartbeat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for tracker_heartbeats")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all TrackerHeartbeat records from the query using the global executor.
+func (q trackerHeartbeatQuery) AllG() (TrackerHeartbeatSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all TrackerHeartbeat records from the query.
+func (q trackerHeartbeatQuery) All(exec boil.Executor) (TrackerHeartbeatSlice, error) {
+	var o []*TrackerHeartbeat
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to TrackerHeartbeat slice")
+	}
+
+	if len(trackerHeartbeatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TrackerHeartbeat records in the query, and panics on error.
+func (q trackerHeartbeatQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all TrackerHeartbeat records in the query.
+func (q trackerHeartbeatQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count tracker_heartbeats rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q trackerHeartbeatQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q trackerHeartbeatQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if tracker_heartbeats exists")
+	}
+
+	return count > 0, nil
+}
+
+// Integration pointed to by the foreign key.
+func (o *TrackerHeartbeat) Integration(mods ...qm.QueryMod) integrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IntegrationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Integrations(queryMods...)
+	queries.SetFrom(query.Query, "\"integrations\"")
+
+	return query
+}
+
+// LoadIntegration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (trackerHeartbeatL) LoadIntegration(e boil.Executor, singular bool, maybeTrackerHeartbeat interface{}, mods queries.Applicator) error {
+	var slice []*TrackerHeartbeat
+	var object *TrackerHeartbeat
+
+	if singular {
+		object = maybeTrackerHeartbeat.(*TrackerHeartbeat)
+	} else {
+		slice = *maybeTrackerHeartbeat.(*[]*TrackerHeartbeat)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackerHeartbeatR{}
+		}
+		args = append(args, object.IntegrationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackerHeartbeatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.IntegrationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.IntegrationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Integration")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Integration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(trackerHeartbeatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Integration = foreign
+		if foreign.R == nil {
+			foreign.R = &integrationR{}
+		}
+		foreign.R.TrackerHeartbeats = append(foreign.R.TrackerHeartbeats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.IntegrationID == foreign.ID {
+				local.R.Integration = foreign
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.TrackerHeartbeats = append(foreign.R.TrackerHeartbeats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetIntegrationG of the trackerHeartbeat to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.TrackerHeartbeats.
+// Uses the global database handle.
+func (o *TrackerHeartbeat) SetIntegrationG(insert bool, related *Integration) error {
+	return o.SetIntegration(boil.GetDB(), insert, related)
+}
+
+// SetIntegration of the trackerHeartbeat to the related item.
+// Sets o.R.Integration to related.
+// Adds o to related.R.TrackerHeartbeats.
+func (o *TrackerHeartbeat) SetIntegration(exec boil.Executor, insert bool, related *Integration) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tracker_heartbeats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"integration_id"}),
+		strmangle.WhereClause("\"", "\"", 0, trackerHeartbeatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.IntegrationID = related.ID
+	if o.R == nil {
+		o.R = &trackerHeartbeatR{
+			Integration: related,
+		}
+	} else {
+		o.R.Integration = related
+	}
+
+	if related.R == nil {
+		related.R = &integrationR{
+			TrackerHeartbeats: TrackerHeartbeatSlice{o},
+		}
+	} else {
+		related.R.TrackerHeartbeats = append(related.R.TrackerHeartbeats, o)
+	}
+
+	return nil
+}
+
+// TrackerHeartbeats retrieves all the records using an executor.
+func TrackerHeartbeats(mods ...qm.QueryMod) trackerHeartbeatQuery {
+	mods = append(mods, qm.From("\"tracker_heartbeats\""))
+	return trackerHeartbeatQuery{NewQuery(mods...)}
+}
+
+// FindTrackerHeartbeatG retrieves a single record by ID.
+func FindTrackerHeartbeatG(iD int64, selectCols ...string) (*TrackerHeartbeat, error) {
+	return FindTrackerHeartbeat(boil.GetDB(), iD, selectCols...)
+}
+
+// FindTrackerHeartbeat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTrackerHeartbeat(exec boil.Executor, iD int64, selectCols ...string) (*TrackerHeartbeat, error) {
+	trackerHeartbeatObj := &TrackerHeartbeat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"tracker_heartbeats\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, trackerHeartbeatObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from tracker_heartbeats")
+	}
+
+	return trackerHeartbeatObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TrackerHeartbeat) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TrackerHeartbeat) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no tracker_heartbeats provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(trackerHeartbeatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	trackerHeartbeatInsertCacheMut.RLock()
+	cache, cached := trackerHeartbeatInsertCache[key]
+	trackerHeartbeatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			trackerHeartbeatAllColumns,
+			trackerHeartbeatColumnsWithDefault,
+			trackerHeartbeatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(trackerHeartbeatType, trackerHeartbeatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(trackerHeartbeatType, trackerHeartbeatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"tracker_heartbeats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"tracker_heartbeats\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"tracker_heartbeats\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, trackerHeartbeatPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into tracker_heartbeats")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == trackerHeartbeatMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for tracker_heartbeats")
+	}
+
+CacheNoHooks:
+	if !cached {
+		trackerHeartbeatInsertCacheMut.Lock()
+		trackerHeartbeatInsertCache[key] = cache
+		trackerHeartbeatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single TrackerHeartbeat record using the global executor.
+// See Update for more documentation.
+func (o *TrackerHeartbeat) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the TrackerHeartbeat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TrackerHeartbeat) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	trackerHeartbeatUpdateCacheMut.RLock()
+	cache, cached := trackerHeartbeatUpdateCache[key]
+	trackerHeartbeatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			trackerHeartbeatAllColumns,
+			trackerHeartbeatPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update tracker_heartbeats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"tracker_heartbeats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, trackerHeartbeatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(trackerHeartbeatType, trackerHeartbeatMapping, append(wl, trackerHeartbeatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update tracker_heartbeats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for tracker_heartbeats")
+	}
+
+	if !cached {
+		trackerHeartbeatUpdateCacheMut.Lock()
+		trackerHeartbeatUpdateCache[key] = cache
+		trackerHeartbeatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q trackerHeartbeatQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q trackerHeartbeatQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for tracker_heartbeats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for tracker_heartbeats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TrackerHeartbeatSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TrackerHeartbeatSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), trackerHeartbeatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"tracker_heartbeats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, trackerHeartbeatPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in trackerHeartbeat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all trackerHeartbeat")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single TrackerHeartbeat record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TrackerHeartbeat) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single TrackerHeartbeat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TrackerHeartbeat) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no TrackerHeartbeat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), trackerHeartbeatPrimaryKeyMapping)
+	sql := "DELETE FROM \"tracker_heartbeats\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from tracker_heartbeats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for tracker_heartbeats")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q trackerHeartbeatQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no trackerHeartbeatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from tracker_heartbeats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for tracker_heartbeats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TrackerHeartbeatSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TrackerHeartbeatSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(trackerHeartbeatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), trackerHeartbeatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"tracker_heartbeats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, trackerHeartbeatPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from trackerHeartbeat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for tracker_heartbeats")
+	}
+
+	if len(trackerHeartbeatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TrackerHeartbeat) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no TrackerHeartbeat provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TrackerHeartbeat) Reload(exec boil.Executor) error {
+	ret, err := FindTrackerHeartbeat(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TrackerHeartbeatSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty TrackerHeartbeatSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TrackerHeartbeatSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TrackerHeartbeatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), trackerHeartbeatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"tracker_heartbeats\".* FROM \"tracker_heartbeats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, trackerHeartbeatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in TrackerHeartbeatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TrackerHeartbeatExistsG checks if the TrackerHeartbeat row exists.
+func TrackerHeartbeatExistsG(iD int64) (bool, error) {
+	return TrackerHeartbeatExists(boil.GetDB(), iD)
+}
+
+// TrackerHeartbeatExists checks if the TrackerHeartbeat row exists.
+func TrackerHeartbeatExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"tracker_heartbeats\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if tracker_heartbeats exists")
+	}
+
+	return exists, nil
+}
@@ -22,14 +22,14 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
-	Email        string     `boil:"email" json:"email" toml:"email" yaml:"email"`
-	PasswordHash string     `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
-	Role         string     `boil:"role" json:"role" toml:"role" yaml:"role"`
-	Archived     bool       `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
-	ArchivedAt   null.Time  `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
-	UpdatedAt    time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	CreatedAt    time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID           int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email        string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	PasswordHash string    `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
+	Role         string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	Archived     bool      `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
+	ArchivedAt   null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -58,7 +58,7 @@ var UserColumns = struct {
 // Generated where
 
 var UserWhere = struct {
-	ID           whereHelpernull_Int64
+	ID           whereHelperint64
 	Email        whereHelperstring
 	PasswordHash whereHelperstring
 	Role         whereHelperstring
@@ -67,7 +67,7 @@ var UserWhere = struct {
 	UpdatedAt    whereHelpertime_Time
 	CreatedAt    whereHelpertime_Time
 }{
-	ID:           whereHelpernull_Int64{field: "\"users\".\"id\""},
+	ID:           whereHelperint64{field: "\"users\".\"id\""},
 	Email:        whereHelperstring{field: "\"users\".\"email\""},
 	PasswordHash: whereHelperstring{field: "\"users\".\"password_hash\""},
 	Role:         whereHelperstring{field: "\"users\".\"role\""},
@@ -79,14 +79,26 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Integrations string
+	AuditLogs                   string
+	OverrideAuthorClassSessions string
+	Integrations                string
+	AuthorManualAttendances     string
+	ReprocessJobs               string
 }{
-	Integrations: "Integrations",
+	AuditLogs:                   "AuditLogs",
+	OverrideAuthorClassSessions: "OverrideAuthorClassSessions",
+	Integrations:                "Integrations",
+	AuthorManualAttendances:     "AuthorManualAttendances",
+	ReprocessJobs:               "ReprocessJobs",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Integrations IntegrationSlice
+	AuditLogs                   AuditLogSlice
+	OverrideAuthorClassSessions ClassSessionSlice
+	Integrations                IntegrationSlice
+	AuthorManualAttendances     ManualAttendanceSlice
+	ReprocessJobs               ReprocessJobSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -363,6 +375,48 @@ func (q userQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// AuditLogs retrieves all the audit_log's AuditLogs with an executor.
+func (o *User) AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_log\".\"user_id\"=?", o.ID),
+	)
+
+	query := AuditLogs(queryMods...)
+	queries.SetFrom(query.Query, "\"audit_log\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"audit_log\".*"})
+	}
+
+	return query
+}
+
+// OverrideAuthorClassSessions retrieves all the class_session's ClassSessions with an executor via override_author_id column.
+func (o *User) OverrideAuthorClassSessions(mods ...qm.QueryMod) classSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"class_sessions\".\"override_author_id\"=?", o.ID),
+	)
+
+	query := ClassSessions(queryMods...)
+	queries.SetFrom(query.Query, "\"class_sessions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"class_sessions\".*"})
+	}
+
+	return query
+}
+
 // Integrations retrieves all the integration's Integrations with an executor.
 func (o *User) Integrations(mods ...qm.QueryMod) integrationQuery {
 	var queryMods []qm.QueryMod
@@ -384,95 +438,730 @@ func (o *User) Integrations(mods ...qm.QueryMod) integrationQuery {
 	return query
 }
 
-// LoadIntegrations allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadIntegrations(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AuthorManualAttendances retrieves all the manual_attendance's ManualAttendances with an executor via author_id column.
+func (o *User) AuthorManualAttendances(mods ...qm.QueryMod) manualAttendanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"manual_attendance\".\"author_id\"=?", o.ID),
+	)
+
+	query := ManualAttendances(queryMods...)
+	queries.SetFrom(query.Query, "\"manual_attendance\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"manual_attendance\".*"})
+	}
+
+	return query
+}
+
+// ReprocessJobs retrieves all the reprocess_job's ReprocessJobs with an executor.
+func (o *User) ReprocessJobs(mods ...qm.QueryMod) reprocessJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reprocess_jobs\".\"user_id\"=?", o.ID),
+	)
+
+	query := ReprocessJobs(queryMods...)
+	queries.SetFrom(query.Query, "\"reprocess_jobs\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reprocess_jobs\".*"})
+	}
+
+	return query
+}
+
+// LoadAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuditLogs(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`audit_log`), qm.WhereIn(`audit_log.user_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_log")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.AuditLogs = append(local.R.AuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOverrideAuthorClassSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOverrideAuthorClassSessions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`class_sessions`), qm.WhereIn(`class_sessions.override_author_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load class_sessions")
+	}
+
+	var resultSlice []*ClassSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice class_sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on class_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for class_sessions")
+	}
+
+	if len(classSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OverrideAuthorClassSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &classSessionR{}
+			}
+			foreign.R.OverrideAuthor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OverrideAuthorID) {
+				local.R.OverrideAuthorClassSessions = append(local.R.OverrideAuthorClassSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &classSessionR{}
+				}
+				foreign.R.OverrideAuthor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIntegrations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIntegrations(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.user_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load integrations")
+	}
+
+	var resultSlice []*Integration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice integrations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on integrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	}
+
+	if len(integrationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Integrations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &integrationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Integrations = append(local.R.Integrations, foreign)
+				if foreign.R == nil {
+					foreign.R = &integrationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorManualAttendances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorManualAttendances(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`manual_attendance`), qm.WhereIn(`manual_attendance.author_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load manual_attendance")
+	}
+
+	var resultSlice []*ManualAttendance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice manual_attendance")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on manual_attendance")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for manual_attendance")
+	}
+
+	if len(manualAttendanceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthorManualAttendances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &manualAttendanceR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AuthorID {
+				local.R.AuthorManualAttendances = append(local.R.AuthorManualAttendances, foreign)
+				if foreign.R == nil {
+					foreign.R = &manualAttendanceR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReprocessJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReprocessJobs(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`reprocess_jobs`), qm.WhereIn(`reprocess_jobs.user_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reprocess_jobs")
+	}
+
+	var resultSlice []*ReprocessJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reprocess_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reprocess_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reprocess_jobs")
+	}
+
+	if len(reprocessJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReprocessJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reprocessJobR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.ReprocessJobs = append(local.R.ReprocessJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &reprocessJobR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuditLogs.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddAuditLogsG(insert bool, related ...*AuditLog) error {
+	return o.AddAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// AddAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuditLogs.
+// Sets related.R.User appropriately.
+func (o *User) AddAuditLogs(exec boil.Executor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_log\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuditLogs: related,
+		}
+	} else {
+		o.R.AuditLogs = append(o.R.AuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOverrideAuthorClassSessionsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OverrideAuthorClassSessions.
+// Sets related.R.OverrideAuthor appropriately.
+// Uses the global database handle.
+func (o *User) AddOverrideAuthorClassSessionsG(insert bool, related ...*ClassSession) error {
+	return o.AddOverrideAuthorClassSessions(boil.GetDB(), insert, related...)
+}
 
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
-	}
+// AddOverrideAuthorClassSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OverrideAuthorClassSessions.
+// Sets related.R.OverrideAuthor appropriately.
+func (o *User) AddOverrideAuthorClassSessions(exec boil.Executor, insert bool, related ...*ClassSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OverrideAuthorID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"class_sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"override_author_id"}),
+				strmangle.WhereClause("\"", "\"", 0, classSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
 			}
 
-			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
-					continue Outer
-				}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			queries.Assign(&rel.OverrideAuthorID, o.ID)
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			OverrideAuthorClassSessions: related,
+		}
+	} else {
+		o.R.OverrideAuthorClassSessions = append(o.R.OverrideAuthorClassSessions, related...)
 	}
 
-	query := NewQuery(qm.From(`integrations`), qm.WhereIn(`integrations.user_id in ?`, args...))
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &classSessionR{
+				OverrideAuthor: o,
+			}
+		} else {
+			rel.R.OverrideAuthor = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load integrations")
-	}
+// SetOverrideAuthorClassSessionsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OverrideAuthor's OverrideAuthorClassSessions accordingly.
+// Replaces o.R.OverrideAuthorClassSessions with related.
+// Sets related.R.OverrideAuthor's OverrideAuthorClassSessions accordingly.
+// Uses the global database handle.
+func (o *User) SetOverrideAuthorClassSessionsG(insert bool, related ...*ClassSession) error {
+	return o.SetOverrideAuthorClassSessions(boil.GetDB(), insert, related...)
+}
 
-	var resultSlice []*Integration
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice integrations")
+// SetOverrideAuthorClassSessions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OverrideAuthor's OverrideAuthorClassSessions accordingly.
+// Replaces o.R.OverrideAuthorClassSessions with related.
+// Sets related.R.OverrideAuthor's OverrideAuthorClassSessions accordingly.
+func (o *User) SetOverrideAuthorClassSessions(exec boil.Executor, insert bool, related ...*ClassSession) error {
+	query := "update \"class_sessions\" set \"override_author_id\" = null where \"override_author_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on integrations")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for integrations")
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	if len(integrationAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
+	if o.R != nil {
+		for _, rel := range o.R.OverrideAuthorClassSessions {
+			queries.SetScanner(&rel.OverrideAuthorID, nil)
+			if rel.R == nil {
+				continue
 			}
+
+			rel.R.OverrideAuthor = nil
 		}
+
+		o.R.OverrideAuthorClassSessions = nil
 	}
-	if singular {
-		object.R.Integrations = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &integrationR{}
-			}
-			foreign.R.User = object
+	return o.AddOverrideAuthorClassSessions(exec, insert, related...)
+}
+
+// RemoveOverrideAuthorClassSessionsG relationships from objects passed in.
+// Removes related items from R.OverrideAuthorClassSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.OverrideAuthor.
+// Uses the global database handle.
+func (o *User) RemoveOverrideAuthorClassSessionsG(related ...*ClassSession) error {
+	return o.RemoveOverrideAuthorClassSessions(boil.GetDB(), related...)
+}
+
+// RemoveOverrideAuthorClassSessions relationships from objects passed in.
+// Removes related items from R.OverrideAuthorClassSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.OverrideAuthor.
+func (o *User) RemoveOverrideAuthorClassSessions(exec boil.Executor, related ...*ClassSession) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OverrideAuthorID, nil)
+		if rel.R != nil {
+			rel.R.OverrideAuthor = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("override_author_id")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.Integrations = append(local.R.Integrations, foreign)
-				if foreign.R == nil {
-					foreign.R = &integrationR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.OverrideAuthorClassSessions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OverrideAuthorClassSessions)
+			if ln > 1 && i < ln-1 {
+				o.R.OverrideAuthorClassSessions[i] = o.R.OverrideAuthorClassSessions[ln-1]
 			}
+			o.R.OverrideAuthorClassSessions = o.R.OverrideAuthorClassSessions[:ln-1]
+			break
 		}
 	}
 
@@ -496,7 +1185,7 @@ func (o *User) AddIntegrations(exec boil.Executor, insert bool, related ...*Inte
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UserID, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
@@ -517,7 +1206,7 @@ func (o *User) AddIntegrations(exec boil.Executor, insert bool, related ...*Inte
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UserID, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
@@ -541,6 +1230,219 @@ func (o *User) AddIntegrations(exec boil.Executor, insert bool, related ...*Inte
 	return nil
 }
 
+// AddAuthorManualAttendancesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorManualAttendances.
+// Sets related.R.Author appropriately.
+// Uses the global database handle.
+func (o *User) AddAuthorManualAttendancesG(insert bool, related ...*ManualAttendance) error {
+	return o.AddAuthorManualAttendances(boil.GetDB(), insert, related...)
+}
+
+// AddAuthorManualAttendances adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorManualAttendances.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorManualAttendances(exec boil.Executor, insert bool, related ...*ManualAttendance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuthorID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"manual_attendance\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 0, manualAttendancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AuthorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorManualAttendances: related,
+		}
+	} else {
+		o.R.AuthorManualAttendances = append(o.R.AuthorManualAttendances, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &manualAttendanceR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
+// AddReprocessJobsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReprocessJobs.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddReprocessJobsG(insert bool, related ...*ReprocessJob) error {
+	return o.AddReprocessJobs(boil.GetDB(), insert, related...)
+}
+
+// AddReprocessJobs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReprocessJobs.
+// Sets related.R.User appropriately.
+func (o *User) AddReprocessJobs(exec boil.Executor, insert bool, related ...*ReprocessJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reprocess_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 0, reprocessJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReprocessJobs: related,
+		}
+	} else {
+		o.R.ReprocessJobs = append(o.R.ReprocessJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reprocessJobR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetReprocessJobsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's ReprocessJobs accordingly.
+// Replaces o.R.ReprocessJobs with related.
+// Sets related.R.User's ReprocessJobs accordingly.
+// Uses the global database handle.
+func (o *User) SetReprocessJobsG(insert bool, related ...*ReprocessJob) error {
+	return o.SetReprocessJobs(boil.GetDB(), insert, related...)
+}
+
+// SetReprocessJobs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's ReprocessJobs accordingly.
+// Replaces o.R.ReprocessJobs with related.
+// Sets related.R.User's ReprocessJobs accordingly.
+func (o *User) SetReprocessJobs(exec boil.Executor, insert bool, related ...*ReprocessJob) error {
+	query := "update \"reprocess_jobs\" set \"user_id\" = null where \"user_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReprocessJobs {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+
+		o.R.ReprocessJobs = nil
+	}
+	return o.AddReprocessJobs(exec, insert, related...)
+}
+
+// RemoveReprocessJobsG relationships from objects passed in.
+// Removes related items from R.ReprocessJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+// Uses the global database handle.
+func (o *User) RemoveReprocessJobsG(related ...*ReprocessJob) error {
+	return o.RemoveReprocessJobs(boil.GetDB(), related...)
+}
+
+// RemoveReprocessJobs relationships from objects passed in.
+// Removes related items from R.ReprocessJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveReprocessJobs(exec boil.Executor, related ...*ReprocessJob) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReprocessJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReprocessJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.ReprocessJobs[i] = o.R.ReprocessJobs[ln-1]
+			}
+			o.R.ReprocessJobs = o.R.ReprocessJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -548,13 +1450,13 @@ func Users(mods ...qm.QueryMod) userQuery {
 }
 
 // FindUserG retrieves a single record by ID.
-func FindUserG(iD null.Int64, selectCols ...string) (*User, error) {
+func FindUserG(iD int64, selectCols ...string) (*User, error) {
 	return FindUser(boil.GetDB(), iD, selectCols...)
 }
 
 // FindUser retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindUser(exec boil.Executor, iD null.Int64, selectCols ...string) (*User, error) {
+func FindUser(exec boil.Executor, iD int64, selectCols ...string) (*User, error) {
 	userObj := &User{}
 
 	sel := "*"
@@ -650,18 +1552,29 @@ func (o *User) Insert(exec boil.Executor, columns boil.Columns) error {
 		fmt.Fprintln(boil.DebugWriter, vals)
 	}
 
-	_, err = exec.Exec(cache.query, vals...)
+	result, err := exec.Exec(cache.query, vals...)
 
 	if err != nil {
 		return errors.Wrap(err, "db: unable to insert into users")
 	}
 
+	var lastID int64
 	var identifierCols []interface{}
 
 	if len(cache.retMapping) == 0 {
 		goto CacheNoHooks
 	}
 
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userMapping["ID"] {
+		goto CacheNoHooks
+	}
+
 	identifierCols = []interface{}{
 		o.ID,
 	}
@@ -1012,12 +1925,12 @@ func (o *UserSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // UserExistsG checks if the User row exists.
-func UserExistsG(iD null.Int64) (bool, error) {
+func UserExistsG(iD int64) (bool, error) {
 	return UserExists(boil.GetDB(), iD)
 }
 
 // UserExists checks if the User row exists.
-func UserExists(exec boil.Executor, iD null.Int64) (bool, error) {
+func UserExists(exec boil.Executor, iD int64) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"users\" where \"id\"=? limit 1)"
 
@@ -0,0 +1,865 @@
+// Code generated by SQLBoiler 3.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// World is an object representing the database table.
+type World struct {
+	ID                int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	VrchatID          string    `boil:"vrchat_id" json:"vrchat_id" toml:"vrchat_id" yaml:"vrchat_id"`
+	Name              string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	AuthorName        string    `boil:"author_name" json:"author_name" toml:"author_name" yaml:"author_name"`
+	ThumbnailImageURL string    `boil:"thumbnail_image_url" json:"thumbnail_image_url" toml:"thumbnail_image_url" yaml:"thumbnail_image_url"`
+	FetchedAt         time.Time `boil:"fetched_at" json:"fetched_at" toml:"fetched_at" yaml:"fetched_at"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *worldR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L worldL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WorldColumns = struct {
+	ID                string
+	VrchatID          string
+	Name              string
+	AuthorName        string
+	ThumbnailImageURL string
+	FetchedAt         string
+	UpdatedAt         string
+	CreatedAt         string
+}{
+	ID:                "id",
+	VrchatID:          "vrchat_id",
+	Name:              "name",
+	AuthorName:        "author_name",
+	ThumbnailImageURL: "thumbnail_image_url",
+	FetchedAt:         "fetched_at",
+	UpdatedAt:         "updated_at",
+	CreatedAt:         "created_at",
+}
+
+// Generated where
+
+var WorldWhere = struct {
+	ID                whereHelperint64
+	VrchatID          whereHelperstring
+	Name              whereHelperstring
+	AuthorName        whereHelperstring
+	ThumbnailImageURL whereHelperstring
+	FetchedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	CreatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperint64{field: "\"worlds\".\"id\""},
+	VrchatID:          whereHelperstring{field: "\"worlds\".\"vrchat_id\""},
+	Name:              whereHelperstring{field: "\"worlds\".\"name\""},
+	AuthorName:        whereHelperstring{field: "\"worlds\".\"author_name\""},
+	ThumbnailImageURL: whereHelperstring{field: "\"worlds\".\"thumbnail_image_url\""},
+	FetchedAt:         whereHelpertime_Time{field: "\"worlds\".\"fetched_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"worlds\".\"updated_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"worlds\".\"created_at\""},
+}
+
+// WorldRels is where relationship names are stored.
+var WorldRels = struct {
+}{}
+
+// worldR is where relationships are stored.
+type worldR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*worldR) NewStruct() *worldR {
+	return &worldR{}
+}
+
+// worldL is where Load methods for each relationship are stored.
+type worldL struct{}
+
+var (
+	worldAllColumns            = []string{"id", "vrchat_id", "name", "author_name", "thumbnail_image_url", "fetched_at", "updated_at", "created_at"}
+	worldColumnsWithoutDefault = []string{"vrchat_id", "name", "author_name", "thumbnail_image_url", "fetched_at"}
+	worldColumnsWithDefault    = []string{"id", "updated_at", "created_at"}
+	worldPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WorldSlice is an alias for a slice of pointers to World.
+	// This should generally be used opposed to []World.
+	WorldSlice []*World
+	// WorldHook is the signature for custom World hook methods
+	WorldHook func(boil.Executor, *World) error
+
+	worldQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	worldType                 = reflect.TypeOf(&World{})
+	worldMapping              = queries.MakeStructMapping(worldType)
+	worldPrimaryKeyMapping, _ = queries.BindMapping(worldType, worldMapping, worldPrimaryKeyColumns)
+	worldInsertCacheMut       sync.RWMutex
+	worldInsertCache          = make(map[string]insertCache)
+	worldUpdateCacheMut       sync.RWMutex
+	worldUpdateCache          = make(map[string]updateCache)
+	worldUpsertCacheMut       sync.RWMutex
+	worldUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var worldBeforeInsertHooks []WorldHook
+var worldBeforeUpdateHooks []WorldHook
+var worldBeforeDeleteHooks []WorldHook
+var worldBeforeUpsertHooks []WorldHook
+
+var worldAfterInsertHooks []WorldHook
+var worldAfterSelectHooks []WorldHook
+var worldAfterUpdateHooks []WorldHook
+var worldAfterDeleteHooks []WorldHook
+var worldAfterUpsertHooks []WorldHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *World) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *World) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *World) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *World) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *World) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *World) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *World) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *World) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *World) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range worldAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWorldHook registers your hook function for all future operations.
+func AddWorldHook(hookPoint boil.HookPoint, worldHook WorldHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		worldBeforeInsertHooks = append(worldBeforeInsertHooks, worldHook)
+	case boil.BeforeUpdateHook:
+		worldBeforeUpdateHooks = append(worldBeforeUpdateHooks, worldHook)
+	case boil.BeforeDeleteHook:
+		worldBeforeDeleteHooks = append(worldBeforeDeleteHooks, worldHook)
+	case boil.BeforeUpsertHook:
+		worldBeforeUpsertHooks = append(worldBeforeUpsertHooks, worldHook)
+	case boil.AfterInsertHook:
+		worldAfterInsertHooks = append(worldAfterInsertHooks, worldHook)
+	case boil.AfterSelectHook:
+		worldAfterSelectHooks = append(worldAfterSelectHooks, worldHook)
+	case boil.AfterUpdateHook:
+		worldAfterUpdateHooks = append(worldAfterUpdateHooks, worldHook)
+	case boil.AfterDeleteHook:
+		worldAfterDeleteHooks = append(worldAfterDeleteHooks, worldHook)
+	case boil.AfterUpsertHook:
+		worldAfterUpsertHooks = append(worldAfterUpsertHooks, worldHook)
+	}
+}
+
+// OneG returns a single world record from the query using the global executor.
+func (q worldQuery) OneG() (*World, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single world record from the query.
+func (q worldQuery) One(exec boil.Executor) (*World, error) {
+	o := &World{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for worlds")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all World records from the query using the global executor.
+func (q worldQuery) AllG() (WorldSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all World records from the query.
+func (q worldQuery) All(exec boil.Executor) (WorldSlice, error) {
+	var o []*World
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to World slice")
+	}
+
+	if len(worldAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all World records in the query, and panics on error.
+func (q worldQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all World records in the query.
+func (q worldQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count worlds rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table, and panics on error.
+func (q worldQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q worldQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if worlds exists")
+	}
+
+	return count > 0, nil
+}
+
+// Worlds retrieves all the records using an executor.
+func Worlds(mods ...qm.QueryMod) worldQuery {
+	mods = append(mods, qm.From("\"worlds\""))
+	return worldQuery{NewQuery(mods...)}
+}
+
+// FindWorldG retrieves a single record by ID.
+func FindWorldG(iD int64, selectCols ...string) (*World, error) {
+	return FindWorld(boil.GetDB(), iD, selectCols...)
+}
+
+// FindWorld retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWorld(exec boil.Executor, iD int64, selectCols ...string) (*World, error) {
+	worldObj := &World{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"worlds\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, worldObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from worlds")
+	}
+
+	return worldObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *World) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *World) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no worlds provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(worldColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	worldInsertCacheMut.RLock()
+	cache, cached := worldInsertCache[key]
+	worldInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			worldAllColumns,
+			worldColumnsWithDefault,
+			worldColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(worldType, worldMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(worldType, worldMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"worlds\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"worlds\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"worlds\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, worldPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.Exec(cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into worlds")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == worldMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to populate default values for worlds")
+	}
+
+CacheNoHooks:
+	if !cached {
+		worldInsertCacheMut.Lock()
+		worldInsertCache[key] = cache
+		worldInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single World record using the global executor.
+// See Update for more documentation.
+func (o *World) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the World.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *World) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	worldUpdateCacheMut.RLock()
+	cache, cached := worldUpdateCache[key]
+	worldUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			worldAllColumns,
+			worldPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update worlds, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"worlds\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, worldPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(worldType, worldMapping, append(wl, worldPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update worlds row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for worlds")
+	}
+
+	if !cached {
+		worldUpdateCacheMut.Lock()
+		worldUpdateCache[key] = cache
+		worldUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q worldQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q worldQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for worlds")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for worlds")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WorldSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WorldSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), worldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"worlds\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, worldPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in world slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all world")
+	}
+	return rowsAff, nil
+}
+
+// DeleteG deletes a single World record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *World) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single World record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *World) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no World provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), worldPrimaryKeyMapping)
+	sql := "DELETE FROM \"worlds\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from worlds")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for worlds")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q worldQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no worldQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from worlds")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for worlds")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WorldSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WorldSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(worldBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), worldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"worlds\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, worldPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from world slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for worlds")
+	}
+
+	if len(worldAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *World) ReloadG() error {
+	if o == nil {
+		return errors.New("db: no World provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *World) Reload(exec boil.Executor) error {
+	ret, err := FindWorld(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WorldSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("db: empty WorldSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WorldSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WorldSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), worldPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"worlds\".* FROM \"worlds\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, worldPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in WorldSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WorldExistsG checks if the World row exists.
+func WorldExistsG(iD int64) (bool, error) {
+	return WorldExists(boil.GetDB(), iD)
+}
+
+// WorldExists checks if the World row exists.
+func WorldExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"worlds\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if worlds exists")
+	}
+
+	return exists, nil
+}
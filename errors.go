@@ -0,0 +1,175 @@
+package accumulator
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+)
+
+// ErrorCode is the stable, machine readable code of an API error
+type ErrorCode string
+
+// Error codes returned by the API
+const (
+	CodeBadRequest         ErrorCode = "bad_request"
+	CodeInvalidID          ErrorCode = "invalid_id"
+	CodeValidationFailed   ErrorCode = "validation_failed"
+	CodeTooLarge           ErrorCode = "too_large"
+	CodeUnauthorized       ErrorCode = "unauthorized"
+	CodeForbidden          ErrorCode = "forbidden"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeConflict           ErrorCode = "conflict"
+	CodeNotImplemented     ErrorCode = "not_implemented"
+	CodeUnsupportedVersion ErrorCode = "unsupported_version"
+	CodeInternal           ErrorCode = "internal"
+)
+
+var errorCodes = []ErrorCode{
+	CodeBadRequest, CodeInvalidID, CodeValidationFailed, CodeTooLarge, CodeUnauthorized,
+	CodeForbidden, CodeNotFound, CodeConflict, CodeNotImplemented, CodeUnsupportedVersion, CodeInternal,
+}
+
+// AppError is an error with the status and code it is reported to clients with.
+// Message is shown to clients, Err is the cause and is only logged.
+type AppError struct {
+	Status  int
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+// Unwrap the cause
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+func errBadRequest(err error, message string) *AppError {
+	return &AppError{http.StatusBadRequest, CodeBadRequest, message, err}
+}
+
+func errUnauthorized(err error) *AppError {
+	return &AppError{http.StatusUnauthorized, CodeUnauthorized, "authentication required", err}
+}
+
+func errForbidden(err error) *AppError {
+	return &AppError{http.StatusForbidden, CodeForbidden, "forbidden", err}
+}
+
+func errNotFound(what string) *AppError {
+	return &AppError{http.StatusNotFound, CodeNotFound, what + " not found", sql.ErrNoRows}
+}
+
+func errConflict(message string, err error) *AppError {
+	return &AppError{http.StatusConflict, CodeConflict, message, err}
+}
+
+// ErrorResponse for HTTP. Fields lists the invalid fields of the request body when the code is validation_failed.
+type ErrorResponse struct {
+	Code      ErrorCode    `json:"code"`
+	Message   string       `json:"message"`
+	Fields    []FieldError `json:"fields,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+}
+
+// toAppError classifies errors returned by handlers. Errors the handlers did not classify themselves are mapped
+// from well known causes, and otherwise from the status the handler returned. Causes of server errors are never sent.
+func toAppError(err error, status int) *AppError {
+	appErr := &AppError{}
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	validationErr := &ValidationError{}
+	if errors.As(err, &validationErr) {
+		return &AppError{http.StatusUnprocessableEntity, CodeValidationFailed, validationErr.Error(), err}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return &AppError{http.StatusNotFound, CodeNotFound, "not found", err}
+	}
+	if isConstraintViolation(err) {
+		return errConflict("conflicts with an existing record", err)
+	}
+	if errors.Is(err, ErrNotImplemented) {
+		return &AppError{http.StatusNotImplemented, CodeNotImplemented, "not implemented", err}
+	}
+	switch {
+	case status == http.StatusUnauthorized:
+		return errUnauthorized(err)
+	case status == http.StatusForbidden:
+		return errForbidden(err)
+	case status == http.StatusNotFound:
+		return &AppError{status, CodeNotFound, "not found", err}
+	case status == http.StatusConflict:
+		return errConflict(err.Error(), err)
+	case status >= 400 && status < 500:
+		return &AppError{status, CodeBadRequest, err.Error(), err}
+	}
+	return &AppError{http.StatusInternalServerError, CodeInternal, "internal server error", err}
+}
+
+func isConstraintViolation(err error) bool {
+	sqliteErr := sqlite3.Error{}
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey
+	}
+	pqErr := &pq.Error{}
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Class() == "23"
+	}
+	return false
+}
+
+// writeError logs the error with the request ID and sends it to the client as JSON
+func writeError(w http.ResponseWriter, r *http.Request, log *zap.SugaredLogger, err error, status int) {
+	appErr := toAppError(err, status)
+	requestID := middleware.GetReqID(r.Context())
+	fields := []interface{}{"request_id", requestID, "method", r.Method, "path", r.URL.Path, "status", appErr.Status, "code", appErr.Code, "err", err}
+	if appErr.Status >= 500 {
+		log.Errorw("request failed", fields...)
+	} else {
+		log.Infow("request rejected", fields...)
+	}
+	resp := &ErrorResponse{Code: appErr.Code, Message: appErr.Message, RequestID: requestID}
+	validationErr := &ValidationError{}
+	if errors.As(err, &validationErr) {
+		resp.Fields = validationErr.Fields
+	}
+	err = writeJSON(w, appErr.Status, resp)
+	if err != nil {
+		log.Errorw("write error response", "request_id", requestID, "err", err)
+	}
+}
+
+// writeJSON sends v with the status. The status is already sent when encoding fails, so the error can only be logged.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// urlParamID parses an ID from the URL, malformed IDs are the client's fault
+func urlParamID(r *http.Request, name string) (int64, error) {
+	s := chi.URLParam(r, name)
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id < 1 {
+		return 0, &AppError{http.StatusBadRequest, CodeInvalidID, fmt.Sprintf("%s must be a positive integer, got %q", name, s), err}
+	}
+	return id, nil
+}
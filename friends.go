@@ -2,20 +2,22 @@ package accumulator
 
 import (
 	"accumulator/db"
+	"database/sql"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"strings"
+	"time"
 
-	"github.com/gofrs/uuid"
 	vrc "github.com/nii236/vrchat-go/client"
 	"github.com/volatiletech/null"
 	"github.com/volatiletech/sqlboiler/boil"
+	"go.uber.org/zap"
 )
 
 // refreshFriendCache in the database
-func refreshFriendCache(d *Darer, IntegrationID int, updateBlob bool) error {
-	integration, err := db.FindIntegrationG(null.Int64From(int64(IntegrationID)))
+// Friends are keyed on (integration_id, vrchat_id) so the same VRChat user can be tracked by several integrations.
+// Friends that are no longer on the VRChat friend list are archived, not deleted, so their attendance history is kept.
+// An avatar that can't be refreshed is logged and the friend kept, anything else fails the refresh.
+func refreshFriendCache(d *Darer, blobs *BlobStorage, IntegrationID int, updateBlob bool, log *zap.SugaredLogger) error {
+	integration, err := db.FindIntegrationG(int64(IntegrationID))
 	if err != nil {
 		return err
 	}
@@ -39,71 +41,117 @@ func refreshFriendCache(d *Darer, IntegrationID int, updateBlob bool) error {
 		return err
 	}
 	vrcResult = append(vrcResult, vrcResult2...)
+	seen := map[string]bool{}
 	for _, vrcFriend := range vrcResult {
-		newBlobFilename := uuid.Must(uuid.NewV4()).String()
-		if updateBlob {
-			resp, err := http.Get(vrcFriend.CurrentAvatarThumbnailImageURL)
-			if err != nil {
-				fmt.Println(err)
-				continue
-			}
-			b, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				fmt.Println(err)
-				continue
+		if seen[vrcFriend.ID] {
+			continue
+		}
+		seen[vrcFriend.ID] = true
+		friend, err := db.Friends(
+			db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
+			db.FriendWhere.VrchatID.EQ(vrcFriend.ID),
+		).OneG()
+		if err == sql.ErrNoRows {
+			friend = &db.Friend{
+				IntegrationID:                 int64(IntegrationID),
+				VrchatID:                      vrcFriend.ID,
+				VrchatUsername:                vrcFriend.Username,
+				VrchatDisplayName:             vrcFriend.DisplayName,
+				VrchatAvatarImageURL:          vrcFriend.CurrentAvatarImageURL,
+				VrchatAvatarThumbnailImageURL: vrcFriend.CurrentAvatarThumbnailImageURL,
 			}
-
-			blob := &db.Blob{
-				FileName:      newBlobFilename,
-				MimeType:      "image/jpg",
-				FileSizeBytes: int64(len(b)),
-				EXTENSION:     "jpg",
-				File:          b,
+			setFriendLocation(friend, vrcFriend.Location)
+			if updateBlob {
+				_, err = refreshAvatar(blobs, friend, vrcFriend.CurrentAvatarThumbnailImageURL)
+				if err != nil {
+					log.Warnw("refresh avatar", "integration_id", IntegrationID, "vrchat_id", vrcFriend.ID, "err", err)
+				}
 			}
-			err = blob.InsertG(boil.Infer())
-			if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-				return err
+			err = friend.InsertG(boil.Infer())
+			if err != nil {
+				return fmt.Errorf("insert friend %s: %w", vrcFriend.ID, err)
 			}
-
+			continue
 		}
-		record := &db.Friend{
-			IntegrationID:                 int64(IntegrationID),
-			VrchatID:                      vrcFriend.ID,
-			VrchatUsername:                vrcFriend.Username,
-			VrchatDisplayName:             vrcFriend.DisplayName,
-			VrchatAvatarImageURL:          vrcFriend.CurrentAvatarImageURL,
-			VrchatAvatarThumbnailImageURL: vrcFriend.CurrentAvatarThumbnailImageURL,
-			VrchatLocation:                vrcFriend.Location,
+		if err != nil {
+			return err
 		}
-		if updateBlob {
-			record.AvatarBlobFilename = null.StringFrom(newBlobFilename)
+		friend.VrchatUsername = vrcFriend.Username
+		friend.VrchatDisplayName = vrcFriend.DisplayName
+		friend.VrchatAvatarImageURL = vrcFriend.CurrentAvatarImageURL
+		friend.VrchatAvatarThumbnailImageURL = vrcFriend.CurrentAvatarThumbnailImageURL
+		friend.Archived = false
+		friend.ArchivedAt = null.Time{}
+		columns := []string{
+			db.FriendColumns.VrchatUsername,
+			db.FriendColumns.VrchatDisplayName,
+			db.FriendColumns.VrchatAvatarImageURL,
+			db.FriendColumns.VrchatAvatarThumbnailImageURL,
+			db.FriendColumns.Archived,
+			db.FriendColumns.ArchivedAt,
+			db.FriendColumns.UpdatedAt,
 		}
-		existing, err := db.Friends(
-			db.FriendWhere.VrchatID.EQ(vrcFriend.ID),
-		).AllG()
-		if len(existing) == 0 {
-			err = record.InsertG(boil.Infer())
-			if err != nil && !strings.Contains(err.Error(), ErrUnableToPopulate) {
-				fmt.Println(err)
-				continue
+		columns = append(columns, setFriendLocation(friend, vrcFriend.Location)...)
+		if updateBlob {
+			avatarColumns, err := refreshAvatar(blobs, friend, vrcFriend.CurrentAvatarThumbnailImageURL)
+			if err != nil {
+				log.Warnw("refresh avatar", "integration_id", IntegrationID, "vrchat_id", vrcFriend.ID, "err", err)
 			}
-			continue
+			columns = append(columns, avatarColumns...)
 		}
-		updateMany := db.M{
-			db.FriendColumns.VrchatID:                      vrcFriend.ID,
-			db.FriendColumns.VrchatUsername:                vrcFriend.Username,
-			db.FriendColumns.VrchatDisplayName:             vrcFriend.DisplayName,
-			db.FriendColumns.VrchatAvatarImageURL:          vrcFriend.CurrentAvatarImageURL,
-			db.FriendColumns.VrchatAvatarThumbnailImageURL: vrcFriend.CurrentAvatarThumbnailImageURL,
-			db.FriendColumns.VrchatLocation:                vrcFriend.Location,
+		_, err = friend.UpdateG(boil.Whitelist(columns...))
+		if err != nil {
+			return err
 		}
-		if updateBlob {
-			updateMany[db.FriendColumns.AvatarBlobFilename] = null.StringFrom(newBlobFilename)
+	}
+	return archiveRemovedFriends(IntegrationID, seen)
+}
+
+// archiveRemovedFriends marks friends that are no longer on the VRChat friend list as archived
+func archiveRemovedFriends(IntegrationID int, seen map[string]bool) error {
+	friends, err := db.Friends(
+		db.FriendWhere.IntegrationID.EQ(int64(IntegrationID)),
+		db.FriendWhere.Archived.EQ(false),
+	).AllG()
+	if err != nil {
+		return err
+	}
+	for _, friend := range friends {
+		if seen[friend.VrchatID] {
+			continue
 		}
-		_, err = existing.UpdateAllG(updateMany)
+		friend.Archived = true
+		friend.ArchivedAt = null.TimeFrom(time.Now())
+		_, err = friend.UpdateG(boil.Whitelist(
+			db.FriendColumns.Archived,
+			db.FriendColumns.ArchivedAt,
+			db.FriendColumns.UpdatedAt,
+		))
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// RefreshFriends pulls the friend list and avatars of the integration from VRChat
+func RefreshFriends(d *Darer, blobs *BlobStorage, integrationID int64, log *zap.SugaredLogger) error {
+	return refreshFriendCache(d, blobs, int(integrationID), true, log)
+}
+
+// SetTeacher promotes the friend to a teacher, or demotes them to a student
+func SetTeacher(integrationID int64, vrchatID string, isTeacher bool) (*db.Friend, error) {
+	friend, err := db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integrationID),
+		db.FriendWhere.VrchatID.EQ(vrchatID),
+	).OneG()
+	if err != nil {
+		return nil, err
+	}
+	friend.IsTeacher = isTeacher
+	_, err = friend.UpdateG(boil.Whitelist(db.FriendColumns.IsTeacher))
+	if err != nil {
+		return nil, err
+	}
+	return friend, nil
+}
@@ -1,4 +1,3 @@
 package accumulator
 
-//go:generate ./bin/go-bindata -prefix migrations/ -pkg bindata -nocompress -o ./bindata/bindata.go migrations
 //go:generate ./bin/sqlboiler ./bin/sqlboiler-sqlite3 --wipe
@@ -1,13 +1,12 @@
 module accumulator
 
-go 1.13
+go 1.16
 
 //replace github.com/nii236/vrchat-go => /home/nii236/git/vrchat-go
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.3.3 // indirect
 	github.com/alexedwards/scs/v2 v2.3.0
-	github.com/bxcodec/faker/v3 v3.2.0
 	github.com/caddyserver/caddy v1.0.4
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/friendsofgo/errors v0.9.2 // indirect
@@ -16,8 +15,10 @@ require (
 	github.com/go-chi/jwtauth v4.0.3+incompatible
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/golang-migrate/migrate/v4 v4.7.1
+	github.com/gorilla/websocket v1.4.0
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/kelseyhightower/envconfig v1.4.0
+	github.com/lib/pq v1.3.0
 	github.com/mattn/go-sqlite3 v1.10.0
 	github.com/minio/sio v0.2.0
 	github.com/nii236/vrchat-go v0.0.1
@@ -29,6 +30,7 @@ require (
 	github.com/volatiletech/null v8.0.0+incompatible
 	github.com/volatiletech/sqlboiler v3.6.1+incompatible
 	go.uber.org/zap v1.13.0
-	golang.org/x/crypto v0.1.0
+	golang.org/x/crypto v0.23.0
+	golang.org/x/image v0.18.0
 	google.golang.org/appengine v1.6.5 // indirect
 )
@@ -0,0 +1,197 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+// avatarRenditionSizes are the bounding boxes, in pixels, of the resized copies generated for every image blob
+var avatarRenditionSizes = []int{64, 256}
+
+// maxImageBytes rejects payloads before decoding them
+const maxImageBytes = 10 << 20
+
+// maxImagePixels rejects images by the dimensions in their header, before decoding allocates memory for every pixel
+const maxImagePixels = 16 << 20
+
+// maxImageDimension caps the stored original, larger images are downscaled before they are written
+const maxImageDimension = 1024
+
+const jpegQuality = 85
+
+// ErrNotImage is returned for payloads that do not decode as a JPEG, PNG, GIF or WebP image
+var ErrNotImage = errors.New("payload is not a supported image")
+
+// decodeImage sniffs the content instead of trusting the URL or headers it came from
+func decodeImage(b []byte) (image.Image, string, error) {
+	if len(b) > maxImageBytes {
+		return nil, "", fmt.Errorf("image is %d bytes, the limit is %d", len(b), maxImageBytes)
+	}
+	mimeType := http.DetectContentType(b)
+	var decode func(r *bytes.Reader) (image.Image, error)
+	switch mimeType {
+	case "image/jpeg":
+		decode = func(r *bytes.Reader) (image.Image, error) { return jpeg.Decode(r) }
+	case "image/png":
+		decode = func(r *bytes.Reader) (image.Image, error) { return png.Decode(r) }
+	case "image/gif":
+		// only the first frame of animations is kept
+		decode = func(r *bytes.Reader) (image.Image, error) { return gif.Decode(r) }
+	case "image/webp":
+		decode = func(r *bytes.Reader) (image.Image, error) { return webp.Decode(r) }
+	default:
+		return nil, "", ErrNotImage
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrNotImage, err)
+	}
+	if int64(config.Width)*int64(config.Height) > maxImagePixels {
+		return nil, "", fmt.Errorf("image is %dx%d, the limit is %d pixels", config.Width, config.Height, maxImagePixels)
+	}
+	img, err := decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrNotImage, err)
+	}
+	return img, mimeType, nil
+}
+
+// encodeImage re-encodes as PNG when the source can carry transparency and JPEG otherwise.
+// There is no WebP encoder available, so WebP sources are stored as one of the two.
+func encodeImage(img image.Image, sourceMimeType string) ([]byte, string, string, error) {
+	buf := &bytes.Buffer{}
+	if sourceMimeType == "image/jpeg" || isOpaque(img) {
+		err := jpeg.Encode(buf, img, &jpeg.Options{Quality: jpegQuality})
+		if err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "image/jpeg", "jpg", nil
+	}
+	err := png.Encode(buf, img)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return buf.Bytes(), "image/png", "png", nil
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// fitImage scales the image down to fit in a size by size box, keeping the aspect ratio. Smaller images are returned as is.
+func fitImage(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= size && h <= size {
+		return img
+	}
+	if w >= h {
+		h = h * size / w
+		w = size
+	} else {
+		w = w * size / h
+		h = size
+	}
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// PutImage validates and re-encodes the image, stores it and generates its resized renditions
+func (s *BlobStorage) PutImage(b []byte) (*db.Blob, error) {
+	img, mimeType, err := decodeImage(b)
+	if err != nil {
+		return nil, err
+	}
+	img = fitImage(img, maxImageDimension)
+	encoded, mimeType, extension, err := encodeImage(img, mimeType)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := s.Put(encoded, mimeType, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, size := range avatarRenditionSizes {
+		bounds := img.Bounds()
+		if bounds.Dx() <= size && bounds.Dy() <= size {
+			// the original is served for sizes it already fits in
+			continue
+		}
+		exists, err := db.BlobRenditions(
+			db.BlobRenditionWhere.ParentFileName.EQ(blob.FileName),
+			db.BlobRenditionWhere.Size.EQ(int64(size)),
+		).ExistsG()
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+		resized, renditionMimeType, renditionExtension, err := encodeImage(fitImage(img, size), mimeType)
+		if err != nil {
+			return nil, err
+		}
+		renditionBlob, err := s.Put(resized, renditionMimeType, renditionExtension)
+		if err != nil {
+			return nil, err
+		}
+		rendition := &db.BlobRendition{
+			ParentFileName: blob.FileName,
+			Size:           int64(size),
+			BlobFileName:   renditionBlob.FileName,
+		}
+		err = rendition.InsertG(boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blob, nil
+}
+
+// FindRendition returns the blob holding the resized copy of the parent, or the parent itself when the original
+// is already small enough to have no rendition at that size
+func (s *BlobStorage) FindRendition(parent *db.Blob, size int) (*db.Blob, error) {
+	valid := false
+	for _, renditionSize := range avatarRenditionSizes {
+		if size == renditionSize {
+			valid = true
+		}
+	}
+	if !valid {
+		return nil, errBadRequest(nil, fmt.Sprintf("unsupported size %d, expected one of %v", size, avatarRenditionSizes))
+	}
+	rendition, err := db.BlobRenditions(
+		db.BlobRenditionWhere.ParentFileName.EQ(parent.FileName),
+		db.BlobRenditionWhere.Size.EQ(int64(size)),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return parent, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Find(rendition.BlobFileName)
+}
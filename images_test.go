@@ -0,0 +1,70 @@
+package accumulator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// webpPixel is a lossy 1x1 WebP image
+const webpPixel = "UklGRiQAAABXRUJQVlA4IBgAAAAwAQCdASoBAAEAAwA0JaQAA3AA/vuUAAA="
+
+func TestEncodeImage(t *testing.T) {
+	encode := func(encoder func(*bytes.Buffer, image.Image) error, alpha uint8) []byte {
+		img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+		for x := 0; x < 4; x++ {
+			for y := 0; y < 4; y++ {
+				img.Set(x, y, color.NRGBA{200, 100, 50, alpha})
+			}
+		}
+		buf := &bytes.Buffer{}
+		err := encoder(buf, img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	pngEncoder := func(buf *bytes.Buffer, img image.Image) error { return png.Encode(buf, img) }
+	jpegEncoder := func(buf *bytes.Buffer, img image.Image) error { return jpeg.Encode(buf, img, nil) }
+	webp, err := base64.StdEncoding.DecodeString(webpPixel)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name      string
+		b         []byte
+		source    string
+		stored    string
+		extension string
+	}{
+		{"jpeg", encode(jpegEncoder, 255), "image/jpeg", "image/jpeg", "jpg"},
+		{"opaque png", encode(pngEncoder, 255), "image/png", "image/jpeg", "jpg"},
+		{"transparent png", encode(pngEncoder, 128), "image/png", "image/png", "png"},
+		// there is no WebP encoder, so WebP is stored as JPEG or PNG
+		{"webp", webp, "image/webp", "image/jpeg", "jpg"},
+	} {
+		img, source, err := decodeImage(test.b)
+		if err != nil {
+			t.Errorf("%s: decode: %v", test.name, err)
+			continue
+		}
+		_, stored, extension, err := encodeImage(img, source)
+		if err != nil {
+			t.Errorf("%s: encode: %v", test.name, err)
+			continue
+		}
+		if source != test.source || stored != test.stored || extension != test.extension {
+			t.Errorf("%s: got %s stored as %s .%s, want %s stored as %s .%s", test.name, source, stored, extension, test.source, test.stored, test.extension)
+		}
+	}
+
+	_, _, err = decodeImage([]byte("<html></html>"))
+	if err != ErrNotImage {
+		t.Errorf("got %v decoding HTML, want ErrNotImage", err)
+	}
+}
@@ -0,0 +1,523 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// testPostgresURLEnv names an empty Postgres database the integration tests also run against. Everything in it is
+// dropped. Without it they only run against SQLite.
+const testPostgresURLEnv = "ACCUMULATOR_TEST_POSTGRES_URL"
+
+// testMasterKey encrypts the seeded integrations' credentials
+const testMasterKey = "9A1F3DE2BB279CB966CC1167BC6C538FDE97268E3EE5F581D918309409520AE3"
+
+// withDatabases runs the test against a freshly migrated SQLite database, and Postgres when testPostgresURLEnv is set,
+// with the generated models pointed at each in turn
+func withDatabases(t *testing.T, test func(t *testing.T, c *DatabaseConfig)) {
+	configs := map[string]*DatabaseConfig{
+		driverSQLite:   {Driver: driverSQLite, BusyTimeoutMS: 5000, MaxOpenConns: 10, MaxIdleConns: 5, ConnMaxLifetimeMinutes: 30},
+		driverPostgres: {Driver: driverPostgres, URL: os.Getenv(testPostgresURLEnv), MaxOpenConns: 10, MaxIdleConns: 5, ConnMaxLifetimeMinutes: 30},
+	}
+	for _, driver := range []string{driverSQLite, driverPostgres} {
+		c := configs[driver]
+		t.Run(driver, func(t *testing.T) {
+			switch {
+			case driver == driverSQLite:
+				c.URL = filepath.Join(t.TempDir(), "accumulator.db")
+			case c.URL == "":
+				t.Skipf("set %s to run against Postgres", testPostgresURLEnv)
+			default:
+				err := Drop(c, nil)
+				if err != nil {
+					t.Fatalf("drop: %v", err)
+				}
+			}
+			err := Migrate(c, nil)
+			if err != nil {
+				t.Fatalf("migrate: %v", err)
+			}
+			conn, err := Connect(c)
+			if err != nil {
+				t.Fatalf("connect: %v", err)
+			}
+			defer conn.Close()
+			err = CheckVersion(conn)
+			if err != nil {
+				t.Fatalf("check version: %v", err)
+			}
+			test(t, c)
+		})
+	}
+}
+
+// seedTest fills the database with two weeks of classes ending on 2020-05-01, with a tracker outage over one of them
+func seedTest(t *testing.T) *BlobStorage {
+	t.Helper()
+	blobs, err := NewBlobStorage(&BlobStoreConfig{Backend: blobBackendSQLite})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Seed(&SeedConfig{
+		Seed:                1,
+		Users:               2,
+		IntegrationsPerUser: 1,
+		Friends:             10,
+		Teachers:            2,
+		Days:                14,
+		End:                 time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC),
+		StepMinutes:         5,
+		AdminEmail:          "admin@example.com",
+		AdminPassword:       "password",
+	}, testMasterKey, blobs)
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	return blobs
+}
+
+func TestMigrationsUpAndDown(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		names, err := migrationNames(c.Driver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := ExpectedVersion(c.Driver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// every down migration, then every up migration again
+		err = MigrateSteps(c, -len(names)/2, nil)
+		if err != nil {
+			t.Fatalf("migrate down: %v", err)
+		}
+		err = Migrate(c, nil)
+		if err != nil {
+			t.Fatalf("migrate up again: %v", err)
+		}
+		v, dirty, err := Version(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != expected || dirty {
+			t.Errorf("got version %d dirty %v, want %d clean", v, dirty, expected)
+		}
+	})
+}
+
+func TestSeededHistory(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		seedTest(t)
+		integrations, err := ListIntegrations(0)
+		if err != nil {
+			t.Fatalf("list integrations: %v", err)
+		}
+		if len(integrations) != 2 {
+			t.Fatalf("got %d integrations, want 2", len(integrations))
+		}
+		if integrations[0].Attendance == 0 || integrations[0].Teachers != 2 {
+			t.Errorf("got %d attendance and %d teachers, want some attendance and 2 teachers", integrations[0].Attendance, integrations[0].Teachers)
+		}
+		integration, err := db.FindIntegrationG(integrations[0].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		n, err := db.Attendances(
+			db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integration.ID)),
+			enrolledAttendance(),
+		).CountG()
+		if err != nil {
+			t.Fatalf("enrolled attendance: %v", err)
+		}
+		if n != integrations[0].Attendance {
+			t.Errorf("got %d enrolled attendance, want all %d samples", n, integrations[0].Attendance)
+		}
+
+		from, to := time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC), time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+		gaps, ticks, err := coverageGaps(integration.ID, from, to, 5*time.Minute)
+		if err != nil {
+			t.Fatalf("coverage: %v", err)
+		}
+		if len(gaps) != 1 || gaps[0].end.Sub(gaps[0].start) != 3*time.Hour || gaps[0].failedTicks != 36 {
+			t.Errorf("got gaps %+v, want the seeded 3 hour outage with 36 failed ticks", gaps)
+		}
+		if len(ticks) != 14*24*12 {
+			t.Errorf("got %d ticks, want %d", len(ticks), 14*24*12)
+		}
+		// the week before the seeded heartbeats is a gap
+		earlier, _, err := coverageGaps(integration.ID, from.AddDate(0, 0, -7), to, 5*time.Minute)
+		if err != nil {
+			t.Fatalf("coverage: %v", err)
+		}
+		if len(earlier) != 2 || !earlier[0].start.Equal(from.AddDate(0, 0, -7)) || !earlier[0].end.Equal(from) || earlier[1].start != gaps[0].start {
+			t.Errorf("got gaps %+v from before the first heartbeat, want the week before it and the outage", earlier)
+		}
+
+		mods, invalid := sessionFilters(integration, url.Values{"status": {sessionAbsent}, "from": {from.Format(time.RFC3339)}})
+		if len(invalid) > 0 {
+			t.Fatalf("session filters: %v", invalid)
+		}
+		absent, err := db.ClassSessions(mods...).CountG()
+		if err != nil {
+			t.Fatalf("filter sessions: %v", err)
+		}
+		all, err := db.ClassSessions(db.ClassSessionWhere.IntegrationID.EQ(integration.ID)).CountG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if all == 0 || absent == 0 || absent == all {
+			t.Errorf("got %d absent of %d sessions, want some", absent, all)
+		}
+
+		job, err := QueueReprocess(integration.ID, nil, from, to)
+		if err != nil {
+			t.Fatalf("queue reprocess: %v", err)
+		}
+		err = RunReprocessJob(context.Background(), job, 5*time.Minute, nil)
+		if err != nil {
+			t.Fatalf("reprocess: %v", err)
+		}
+		if job.Status != jobSucceeded || job.ProcessedClasses == 0 || job.SessionsCreated+job.SessionsChanged+job.SessionsDeleted != 0 {
+			t.Errorf("got job %s with %d classes, %d created, %d changed and %d deleted, want it to succeed changing nothing",
+				job.Status, job.ProcessedClasses, job.SessionsCreated, job.SessionsChanged, job.SessionsDeleted)
+		}
+
+		// classes before the first heartbeat have no samples and were missed, so they are incomplete, not absent
+		job, err = QueueReprocess(integration.ID, nil, from.AddDate(0, 0, -7), from)
+		if err != nil {
+			t.Fatalf("queue reprocess: %v", err)
+		}
+		err = RunReprocessJob(context.Background(), job, 5*time.Minute, nil)
+		if err != nil {
+			t.Fatalf("reprocess: %v", err)
+		}
+		incomplete, err := db.ClassSessions(
+			db.ClassSessionWhere.IntegrationID.EQ(integration.ID),
+			db.ClassSessionWhere.StartsAt.LT(from),
+			db.ClassSessionWhere.Status.EQ(sessionIncomplete),
+		).CountG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.SessionsCreated == 0 || incomplete != job.SessionsCreated {
+			t.Errorf("got %d sessions created before the first heartbeat, %d of them incomplete, want some and all", job.SessionsCreated, incomplete)
+		}
+
+		err = deleteIntegration(integration)
+		if err != nil {
+			t.Fatalf("delete integration: %v", err)
+		}
+		left, err := db.ClassSessions(db.ClassSessionWhere.IntegrationID.EQ(integration.ID)).CountG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if left != 0 {
+			t.Errorf("got %d sessions of the deleted integration", left)
+		}
+	})
+}
+
+func TestTransactionRollback(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		u, err := CreateUser("admin@example.com", "password", roleAdmin)
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		if u.ID == 0 {
+			t.Fatal("inserted user has no ID")
+		}
+		tx, err := beginTx()
+		if err != nil {
+			t.Fatal(err)
+		}
+		integration := &db.Integration{UserID: u.ID, Username: "rolled-back", APIKey: "key", AuthToken: []byte{}, AuthTokenNonce: []byte{}}
+		err = integration.Insert(tx, boil.Infer())
+		if err != nil {
+			t.Fatalf("insert in transaction: %v", err)
+		}
+		err = tx.Rollback()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := db.Integrations(db.IntegrationWhere.Username.EQ("rolled-back")).CountG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 0 {
+			t.Errorf("got %d integrations after rolling back, want 0", n)
+		}
+	})
+}
+
+func TestBlobGarbageCollection(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		blobs := seedTest(t)
+		kept, err := db.Friends(db.FriendWhere.AvatarBlobFilename.IsNotNull(), qm.Limit(1)).OneG()
+		if err != nil {
+			t.Fatalf("find a friend with an avatar: %v", err)
+		}
+		unreferenced, err := blobs.Put([]byte("unreferenced"), "text/plain", "txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		reused, err := blobs.Put([]byte("reused"), "text/plain", "txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// everything is old enough to collect, then one of them is stored again
+		_, err = db.Blobs().UpdateAllG(db.M{db.BlobColumns.UpdatedAt: time.Now().Add(-time.Hour).UTC()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = blobs.Put([]byte("reused"), "text/plain", "txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = countBlobView(kept.AvatarBlobFilename.String)
+		if err != nil {
+			t.Fatalf("count view: %v", err)
+		}
+
+		_, err = collectBlobGarbage(blobs, time.Now().Add(-time.Minute).UTC())
+		if err != nil {
+			t.Fatalf("collect: %v", err)
+		}
+		for name, want := range map[string]bool{
+			kept.AvatarBlobFilename.String: true,
+			unreferenced.FileName:          false,
+			reused.FileName:                true,
+		} {
+			exists, err := db.Blobs(db.BlobWhere.FileName.EQ(name)).ExistsG()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exists != want {
+				t.Errorf("blob %s exists %v, want %v", name, exists, want)
+			}
+		}
+	})
+}
+
+// sharedClass is an integration with two teachers who hold a class at the same time in the same instance, with a student
+// enrolled with both of them, and a schedule for each of the teachers' classes on Sundays from 18:00 to 19:00 UTC
+type sharedClass struct {
+	integration *db.Integration
+	teachers    db.FriendSlice
+	student     *db.Friend
+	schedules   db.ScheduleSlice
+	location    Location
+}
+
+func newSharedClass(t *testing.T) *sharedClass {
+	t.Helper()
+	u, err := CreateUser("admin@example.com", "password", roleAdmin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &sharedClass{
+		integration: &db.Integration{UserID: u.ID, Username: "shared", APIKey: "key", AuthToken: []byte{}, AuthTokenNonce: []byte{}},
+		location:    ParseLocation("wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~region(eu)"),
+	}
+	err = c.integration.InsertG(boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"Teacher One", "Teacher Two", "Student"} {
+		friend := &db.Friend{
+			IntegrationID:     c.integration.ID,
+			IsTeacher:         i < 2,
+			VrchatID:          fmt.Sprintf("usr_%08d-0000-0000-0000-000000000000", i),
+			VrchatDisplayName: name,
+			VrchatLocation:    c.location.Raw,
+		}
+		err = friend.InsertG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 2 {
+			c.student = friend
+			break
+		}
+		c.teachers = append(c.teachers, friend)
+	}
+	for _, teacher := range c.teachers {
+		roster := &db.Roster{IntegrationID: c.integration.ID, TeacherID: teacher.ID, Name: teacher.VrchatDisplayName + "'s class"}
+		err = roster.InsertG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = Enrol(roster, c.student, nil, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		schedule := &db.Schedule{
+			IntegrationID: c.integration.ID,
+			TeacherID:     teacher.ID,
+			Name:          teacher.VrchatDisplayName + "'s class",
+			Weekdays:      "sun",
+			StartTime:     "18:00",
+			EndTime:       "19:00",
+			TimeZone:      "UTC",
+			RosterID:      null.Int64From(roster.ID),
+		}
+		err = schedule.InsertG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.schedules = append(c.schedules, schedule)
+	}
+	err = c.integration.ReloadG()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestStudentWithTwoTeachers(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		class := newSharedClass(t)
+		step := 5 * time.Minute
+		start := time.Date(2020, 4, 26, 18, 0, 0, 0, time.UTC)
+		for at := start; at.Before(start.Add(time.Hour)); at = at.Add(step) {
+			for _, teacher := range class.teachers {
+				err := newAttendance(class.integration.ID, at, class.student, teacher, class.location).InsertG(boil.Infer())
+				if err != nil {
+					t.Fatalf("insert a sample with %s: %v", teacher.VrchatDisplayName, err)
+				}
+			}
+		}
+		for _, s := range class.schedules {
+			_, err := evaluateSessions(class.integration, s, start, start.Add(time.Hour), step)
+			if err != nil {
+				t.Fatal(err)
+			}
+			session, err := db.ClassSessions(db.ClassSessionWhere.ScheduleID.EQ(s.ID)).OneG()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if session.Status != sessionPresent || session.Samples != 12 {
+				t.Errorf("got %s with %d samples at the class of teacher %d, want present with 12", session.Status, session.Samples, s.TeacherID)
+			}
+		}
+	})
+}
+
+func TestReprocessAfterPromotingATeacher(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		class := newSharedClass(t)
+		promoted := class.teachers[1]
+		_, err := SetTeacher(class.integration.ID, promoted.VrchatID, false)
+		if err != nil {
+			t.Fatalf("demote: %v", err)
+		}
+		rules, err := loadTrackingRules(class.integration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		step := 5 * time.Minute
+		start := time.Date(2020, 4, 26, 18, 0, 0, 0, time.UTC)
+		for at := start; at.Before(start.Add(time.Hour)); at = at.Add(step) {
+			locations := map[int64]Location{
+				class.teachers[0].ID: class.location,
+				promoted.ID:          class.location,
+				class.student.ID:     class.location,
+			}
+			_, attendance, err := rules.classes(at, locations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = recordTick(boil.GetDB(), class.integration.ID, at, locations, attendance)
+			if err != nil {
+				t.Fatalf("record tick: %v", err)
+			}
+			heartbeat := &db.TrackerHeartbeat{IntegrationID: class.integration.ID, TickedAt: at, Succeeded: true, Friends: 3}
+			err = heartbeat.InsertG(boil.Infer())
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, s := range class.schedules {
+			_, err = evaluateSessions(class.integration, s, start, start.Add(time.Hour), step)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the student wasn't recorded with a friend who wasn't a teacher yet
+		before, err := db.ClassSessions(db.ClassSessionWhere.TeacherID.EQ(promoted.ID)).OneG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if before.Status != sessionAbsent {
+			t.Errorf("got %s before promoting the teacher, want absent", before.Status)
+		}
+
+		_, err = SetTeacher(class.integration.ID, promoted.VrchatID, true)
+		if err != nil {
+			t.Fatalf("promote: %v", err)
+		}
+		job, err := QueueReprocess(class.integration.ID, nil, start.Add(-24*time.Hour), start.Add(24*time.Hour))
+		if err != nil {
+			t.Fatalf("queue reprocess: %v", err)
+		}
+		err = RunReprocessJob(context.Background(), job, step, nil)
+		if err != nil {
+			t.Fatalf("reprocess: %v", err)
+		}
+		if job.Status != jobSucceeded {
+			t.Fatalf("got job %s: %s, want it to succeed", job.Status, job.ErrorMessage.String)
+		}
+		for _, s := range class.schedules {
+			session, err := db.ClassSessions(db.ClassSessionWhere.ScheduleID.EQ(s.ID)).OneG()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if session.Status != sessionPresent || session.Samples != 12 {
+				t.Errorf("got %s with %d samples at the class of teacher %d, want present with 12", session.Status, session.Samples, s.TeacherID)
+			}
+		}
+	})
+}
+
+func TestReprocessLongerClass(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		class := newSharedClass(t)
+		s := class.schedules[0]
+		step := 5 * time.Minute
+		start := time.Date(2020, 4, 26, 18, 0, 0, 0, time.UTC)
+		_, err := evaluateSessions(class.integration, s, start, start.Add(time.Hour), step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.EndTime = "19:30"
+		_, err = s.UpdateG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		job, err := QueueReprocess(class.integration.ID, nil, start.Add(-24*time.Hour), start.Add(24*time.Hour))
+		if err != nil {
+			t.Fatalf("queue reprocess: %v", err)
+		}
+		err = RunReprocessJob(context.Background(), job, step, nil)
+		if err != nil {
+			t.Fatalf("reprocess: %v", err)
+		}
+		session, err := db.ClassSessions(db.ClassSessionWhere.ScheduleID.EQ(s.ID)).OneG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !session.StartsAt.Equal(start) || !session.EndsAt.Equal(start.Add(90*time.Minute)) {
+			t.Errorf("got a session from %v until %v, want the class that ends at 19:30", session.StartsAt, session.EndsAt)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// IntegrationDetail summarises an integration for operators, without its credentials
+type IntegrationDetail struct {
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"user_id"`
+	UserEmail       string    `json:"user_email"`
+	Username        string    `json:"username"`
+	Archived        bool      `json:"archived"`
+	Friends         int64     `json:"friends"`
+	Teachers        int64     `json:"teachers"`
+	ArchivedFriends int64     `json:"archived_friends"`
+	Attendance      int64     `json:"attendance"`
+	LastAttendance  null.Time `json:"last_attendance,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ListIntegrations of every user, or of one user when userID is non zero
+func ListIntegrations(userID int64) ([]*IntegrationDetail, error) {
+	mods := []qm.QueryMod{qm.OrderBy(db.IntegrationColumns.ID)}
+	if userID != 0 {
+		mods = append(mods, db.IntegrationWhere.UserID.EQ(userID))
+	}
+	integrations, err := db.Integrations(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+	result := []*IntegrationDetail{}
+	for _, integration := range integrations {
+		detail, err := integrationDetail(integration)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, detail)
+	}
+	return result, nil
+}
+
+// InspectIntegration returns the integration with counts of its friends and attendance
+func InspectIntegration(integrationID int64) (*IntegrationDetail, error) {
+	integration, err := db.FindIntegrationG(integrationID)
+	if err != nil {
+		return nil, err
+	}
+	return integrationDetail(integration)
+}
+
+func integrationDetail(integration *db.Integration) (*IntegrationDetail, error) {
+	detail := &IntegrationDetail{
+		ID:        integration.ID,
+		UserID:    integration.UserID,
+		Username:  integration.Username,
+		Archived:  integration.Archived,
+		CreatedAt: integration.CreatedAt,
+		UpdatedAt: integration.UpdatedAt,
+	}
+	u, err := db.FindUserG(integration.UserID)
+	if err != nil {
+		return nil, err
+	}
+	detail.UserEmail = u.Email
+
+	detail.Friends, err = db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.Archived.EQ(false),
+	).CountG()
+	if err != nil {
+		return nil, err
+	}
+	detail.Teachers, err = db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.Archived.EQ(false),
+		db.FriendWhere.IsTeacher.EQ(true),
+	).CountG()
+	if err != nil {
+		return nil, err
+	}
+	detail.ArchivedFriends, err = db.Friends(
+		db.FriendWhere.IntegrationID.EQ(integration.ID),
+		db.FriendWhere.Archived.EQ(true),
+	).CountG()
+	if err != nil {
+		return nil, err
+	}
+	detail.Attendance, err = db.Attendances(
+		db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integration.ID)),
+	).CountG()
+	if err != nil {
+		return nil, err
+	}
+	last, err := db.Attendances(
+		db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integration.ID)),
+		qm.OrderBy(db.AttendanceColumns.Timestamp+" DESC"),
+	).OneG()
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if last != nil {
+		detail.LastAttendance = null.TimeFrom(time.Unix(last.Timestamp, 0))
+	}
+	return detail, nil
+}
@@ -0,0 +1,213 @@
+package accumulator
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Events only sent to live connections, as they happen too often for webhooks
+const (
+	EventFriendMoved        = "friend.moved"
+	EventTeacherOnline      = "teacher.online"
+	EventTeacherOffline     = "teacher.offline"
+	EventAttendanceRecorded = "attendance.recorded"
+)
+
+const (
+	// liveBuffer is how many events a connection may fall behind before it is closed
+	liveBuffer = 256
+	// livePingPeriod is how often connections are pinged and their authorization checked again
+	livePingPeriod = 30 * time.Second
+	livePongWait   = 2 * livePingPeriod
+	liveWriteWait  = 10 * time.Second
+)
+
+// friendMovedEventData is the data of friend.moved. Locations are VRChat's, "offline" or "private" included.
+type friendMovedEventData struct {
+	Friend *v1Friend `json:"friend"`
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+}
+
+// teacherEventData is the data of teacher.online and teacher.offline
+type teacherEventData struct {
+	Teacher  *v1Friend `json:"teacher"`
+	Location string    `json:"location"`
+}
+
+// Hub passes the events of the attendance tracker to the live connections of their integration,
+// and keeps the presence the tracker saw last
+type Hub struct {
+	mu        sync.Mutex
+	subs      map[int64]map[*subscription]bool
+	presences map[int64]*v1Presence
+}
+
+// subscription receives the events of one integration. Its channel is closed when it falls behind.
+type subscription struct {
+	integrationID int64
+	events        chan *Event
+}
+
+// NewHub for the tracker and the server to share
+func NewHub() *Hub {
+	return &Hub{subs: map[int64]map[*subscription]bool{}, presences: map[int64]*v1Presence{}}
+}
+
+// presence of the integration at the last tick, nil before the first.
+// The snapshot is replaced and never changed, so it can be shared.
+func (h *Hub) presence(integrationID int64) *v1Presence {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.presences[integrationID]
+}
+
+func (h *Hub) setPresence(integrationID int64, presence *v1Presence) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.presences[integrationID] = presence
+}
+
+func (h *Hub) subscribe(integrationID int64) *subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub := &subscription{integrationID, make(chan *Event, liveBuffer)}
+	if h.subs[integrationID] == nil {
+		h.subs[integrationID] = map[*subscription]bool{}
+	}
+	h.subs[integrationID][sub] = true
+	return sub
+}
+
+func (h *Hub) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+// remove the subscription and close its channel, the caller holds the lock
+func (h *Hub) remove(sub *subscription) {
+	if !h.subs[sub.integrationID][sub] {
+		return
+	}
+	delete(h.subs[sub.integrationID], sub)
+	if len(h.subs[sub.integrationID]) == 0 {
+		delete(h.subs, sub.integrationID)
+	}
+	close(sub.events)
+}
+
+// publish never blocks the tracker, subscriptions that are full are dropped instead
+func (h *Hub) publish(event *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[event.IntegrationID] {
+		select {
+		case sub.events <- event:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// Browsers send the JWT cookie with any page's WebSocket, so the default same origin check is kept
+}
+
+// liveHandler streams the integration's events over a WebSocket, authenticated with the JWT cookie or header.
+// The user is authorized again every livePingPeriod, so expired tokens and lost integrations end the stream.
+func (c *API) liveHandler(auther *Auther, hub *Hub) http.HandlerFunc {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		u, err := userFromRequest(auther, r)
+		if err != nil {
+			writeError(w, r, c.log, errUnauthorized(err), http.StatusUnauthorized)
+			return
+		}
+		integration, err := ownedIntegration(r, u)
+		if err != nil {
+			writeError(w, r, c.log, err, http.StatusForbidden)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has already responded
+			c.log.Infow("websocket upgrade", "integration_id", integration.ID, "err", err)
+			return
+		}
+		defer conn.Close()
+		sub := hub.subscribe(integration.ID)
+		defer hub.unsubscribe(sub)
+
+		// Reading handles pongs and notices the client going away, nothing is expected from it
+		gone := make(chan struct{})
+		go func() {
+			defer close(gone)
+			conn.SetReadLimit(512)
+			conn.SetReadDeadline(time.Now().Add(livePongWait))
+			conn.SetPongHandler(func(string) error {
+				return conn.SetReadDeadline(time.Now().Add(livePongWait))
+			})
+			for {
+				_, _, err := conn.NextReader()
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		ping := time.NewTicker(livePingPeriod)
+		defer ping.Stop()
+		for {
+			select {
+			case <-gone:
+				return
+			case event, ok := <-sub.events:
+				if !ok {
+					closeLive(conn, websocket.CloseTryAgainLater, "fell behind, reconnect")
+					return
+				}
+				conn.SetWriteDeadline(time.Now().Add(liveWriteWait))
+				err = conn.WriteJSON(event)
+				if err != nil {
+					return
+				}
+			case <-ping.C:
+				u, err := userFromRequest(auther, r)
+				if err == nil {
+					_, err = ownedIntegration(r, u)
+				}
+				if err != nil {
+					c.log.Infow("live connection no longer authorized", "integration_id", integration.ID, "user_id", integration.UserID, "err", err)
+					closeLive(conn, websocket.ClosePolicyViolation, "no longer authorized")
+					return
+				}
+				err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteWait))
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+	return fn
+}
+
+// withoutSessionsForWebSockets sends WebSocket upgrades past the session manager, which buffers responses and can't be hijacked
+func withoutSessionsForWebSockets(sessions http.Handler, next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		sessions.ServeHTTP(w, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+func closeLive(conn *websocket.Conn, code int, reason string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(liveWriteWait))
+}
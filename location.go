@@ -0,0 +1,173 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	vrc "github.com/nii236/vrchat-go/client"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Access types of instances
+const (
+	AccessPublic = "public"
+	// AccessFriends instances can be joined by friends of the owner, "Friends" in VRChat
+	AccessFriends = "friends"
+	// AccessHidden instances can be joined by friends of anyone in them, "Friends+" in VRChat
+	AccessHidden = "hidden"
+	// AccessPrivate instances need an invite, "Invite" and "Invite+" in VRChat
+	AccessPrivate = "private"
+	AccessGroup   = "group"
+)
+
+// defaultRegion of instances without a region tag
+const defaultRegion = "us"
+
+// worldCacheTTL is how long a world's name is used before looking it up again
+const worldCacheTTL = 7 * 24 * time.Hour
+
+// Location is a VRChat location string, taken apart. Only instances have a world, all fields are empty for other locations.
+//
+// Instances look like wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~hidden(usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469)~region(eu)~nonce(...),
+// everyone in the instance has the same string.
+type Location struct {
+	Raw     string
+	WorldID string
+	// InstanceID is everything after the colon, so instances with the same number but other tags are different instances
+	InstanceID string
+	Region     string
+	AccessType string
+	// OwnerID is the user who made a non public instance, or the group of a group instance
+	OwnerID string
+}
+
+// ParseLocation never fails, locations it doesn't understand are kept as Raw only
+func ParseLocation(s string) Location {
+	l := Location{Raw: s}
+	i := strings.Index(s, ":")
+	if !strings.HasPrefix(s, "wrld_") || i < 0 || i == len(s)-1 {
+		return l
+	}
+	l.WorldID, l.InstanceID = s[:i], s[i+1:]
+	l.Region = defaultRegion
+	l.AccessType = AccessPublic
+	for _, tag := range strings.Split(l.InstanceID, "~")[1:] {
+		name, arg := tag, ""
+		if j := strings.Index(tag, "("); j >= 0 && strings.HasSuffix(tag, ")") {
+			name, arg = tag[:j], tag[j+1:len(tag)-1]
+		}
+		switch name {
+		case "region":
+			l.Region = arg
+		case "friends":
+			l.AccessType, l.OwnerID = AccessFriends, arg
+		case "hidden":
+			l.AccessType, l.OwnerID = AccessHidden, arg
+		case "private":
+			l.AccessType, l.OwnerID = AccessPrivate, arg
+		case "group":
+			l.AccessType, l.OwnerID = AccessGroup, arg
+		}
+	}
+	return l
+}
+
+// IsInstance is false for offline, private, traveling and anything else without a world
+func (l Location) IsInstance() bool {
+	return l.WorldID != ""
+}
+
+// SameInstance is only true for instances. Friends in private worlds or traveling are never together, as where they are is unknown.
+func (l Location) SameInstance(other Location) bool {
+	return l.IsInstance() && l.WorldID == other.WorldID && l.InstanceID == other.InstanceID
+}
+
+// Status is the presence status of a friend at the location: online, offline, private, traveling or unknown
+func (l Location) Status() string {
+	switch {
+	case l.IsInstance():
+		return presenceOnline
+	case l.Raw == presenceOffline, l.Raw == presencePrivate:
+		return l.Raw
+	case strings.HasPrefix(l.Raw, presenceTraveling):
+		return presenceTraveling
+	}
+	return presenceUnknown
+}
+
+// setFriendLocation sets the location of the friend and the columns parsed from it, returning the columns to update
+func setFriendLocation(friend *db.Friend, location string) []string {
+	l := ParseLocation(location)
+	friend.VrchatLocation = l.Raw
+	friend.VrchatWorldID = l.WorldID
+	friend.VrchatInstanceID = l.InstanceID
+	friend.VrchatRegion = l.Region
+	friend.VrchatAccessType = l.AccessType
+	friend.VrchatOwnerID = l.OwnerID
+	return []string{
+		db.FriendColumns.VrchatLocation,
+		db.FriendColumns.VrchatWorldID,
+		db.FriendColumns.VrchatInstanceID,
+		db.FriendColumns.VrchatRegion,
+		db.FriendColumns.VrchatAccessType,
+		db.FriendColumns.VrchatOwnerID,
+	}
+}
+
+// newAttendance is a sample of the student in the teacher's instance
+func newAttendance(integrationID int64, at time.Time, student, teacher *db.Friend, l Location) *db.Attendance {
+	return &db.Attendance{
+		Timestamp:     at.Unix(),
+		IntegrationID: null.Int64From(integrationID),
+		FriendID:      null.Int64From(student.ID),
+		TeacherID:     null.Int64From(teacher.ID),
+		Location:      l.Raw,
+		WorldID:       l.WorldID,
+		InstanceID:    l.InstanceID,
+		Region:        l.Region,
+		AccessType:    l.AccessType,
+		OwnerID:       l.OwnerID,
+	}
+}
+
+// worldName from the worlds table, looking it up from VRChat when it isn't there or is older than worldCacheTTL.
+// A stale name is used when VRChat can't be asked. Worlds VRChat doesn't know, like private uploads, are cached without a name.
+func worldName(client *vrc.Client, worldID string) (string, error) {
+	world, err := db.Worlds(db.WorldWhere.VrchatID.EQ(worldID)).OneG()
+	if err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+	if world != nil && time.Since(world.FetchedAt) < worldCacheTTL {
+		return world.Name, nil
+	}
+	vrcWorld, err := client.WorldGet(worldID)
+	if vrcStatusCode(err) == http.StatusNotFound {
+		vrcWorld, err = &vrc.World{}, nil
+	}
+	if err != nil {
+		if world != nil {
+			return world.Name, nil
+		}
+		return "", err
+	}
+	if world == nil {
+		world = &db.World{VrchatID: worldID}
+	}
+	world.Name = vrcWorld.Name
+	world.AuthorName = vrcWorld.AuthorName
+	world.ThumbnailImageURL = vrcWorld.ThumbnailImageURL
+	world.FetchedAt = time.Now()
+	if world.ID == 0 {
+		err = world.InsertG(boil.Infer())
+	} else {
+		_, err = world.UpdateG(boil.Infer())
+	}
+	if err != nil {
+		return "", err
+	}
+	return world.Name, nil
+}
@@ -0,0 +1,97 @@
+package accumulator
+
+import "testing"
+
+func TestParseLocation(t *testing.T) {
+	const (
+		world = "wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd"
+		user  = "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"
+		other = "usr_8e3bd3c5-4c5a-4e4e-a7bb-1d1b7b3c8a2a"
+		group = "grp_71a7ff59-112c-4e78-a990-c7cc650776e5"
+	)
+	for _, test := range []struct {
+		location string
+		want     Location
+		status   string
+	}{
+		{"offline", Location{}, presenceOffline},
+		{"private", Location{}, presencePrivate},
+		{"traveling", Location{}, presenceTraveling},
+		{"traveling:" + world + ":12345", Location{}, presenceTraveling},
+		{"", Location{}, presenceUnknown},
+		{world, Location{}, presenceUnknown},
+		{world + ":", Location{}, presenceUnknown},
+		{world + ":12345", Location{WorldID: world, InstanceID: "12345", Region: "us", AccessType: AccessPublic}, presenceOnline},
+		{
+			world + ":12345~region(eu)",
+			Location{WorldID: world, InstanceID: "12345~region(eu)", Region: "eu", AccessType: AccessPublic},
+			presenceOnline,
+		},
+		{
+			world + ":12345~friends(" + user + ")~region(jp)~nonce(5e7ab9b6-0f6b-4bb6-b8c1-2f1e3f7e5a10)",
+			Location{WorldID: world, InstanceID: "12345~friends(" + user + ")~region(jp)~nonce(5e7ab9b6-0f6b-4bb6-b8c1-2f1e3f7e5a10)", Region: "jp", AccessType: AccessFriends, OwnerID: user},
+			presenceOnline,
+		},
+		{
+			world + ":12345~hidden(" + user + ")~region(use)",
+			Location{WorldID: world, InstanceID: "12345~hidden(" + user + ")~region(use)", Region: "use", AccessType: AccessHidden, OwnerID: user},
+			presenceOnline,
+		},
+		{
+			world + ":12345~private(" + user + ")~canRequestInvite~region(eu)",
+			Location{WorldID: world, InstanceID: "12345~private(" + user + ")~canRequestInvite~region(eu)", Region: "eu", AccessType: AccessPrivate, OwnerID: user},
+			presenceOnline,
+		},
+		{
+			world + ":12345~group(" + group + ")~groupAccessType(members)~region(eu)",
+			Location{WorldID: world, InstanceID: "12345~group(" + group + ")~groupAccessType(members)~region(eu)", Region: "eu", AccessType: AccessGroup, OwnerID: group},
+			presenceOnline,
+		},
+		{
+			world + ":12345~region(eu)~region(jp)",
+			Location{WorldID: world, InstanceID: "12345~region(eu)~region(jp)", Region: "jp", AccessType: AccessPublic},
+			presenceOnline,
+		},
+		{
+			world + ":12345~hidden(" + user + ")~friends(" + other + ")",
+			Location{WorldID: world, InstanceID: "12345~hidden(" + user + ")~friends(" + other + ")", Region: "us", AccessType: AccessFriends, OwnerID: other},
+			presenceOnline,
+		},
+	} {
+		test.want.Raw = test.location
+		got := ParseLocation(test.location)
+		if got != test.want {
+			t.Errorf("ParseLocation(%q) = %+v, want %+v", test.location, got, test.want)
+		}
+		if status := got.Status(); status != test.status {
+			t.Errorf("status of %q = %s, want %s", test.location, status, test.status)
+		}
+	}
+}
+
+func TestSameInstance(t *testing.T) {
+	const world = "wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd"
+	for _, test := range []struct {
+		a, b string
+		want bool
+	}{
+		{world + ":12345~region(eu)", world + ":12345~region(eu)", true},
+		{world + ":12345", world + ":12345", true},
+		{world + ":12345~region(eu)", world + ":67890~region(eu)", false},
+		{world + ":12345~region(eu)", world + ":12345~region(us)", false},
+		{world + ":12345~region(eu)", "wrld_1b9dd1ea-7d2f-4bcd-9b0e-88c7e6bd6a1b:12345~region(eu)", false},
+		{world + ":12345", "offline", false},
+		{"offline", "offline", false},
+		{"private", "private", false},
+		{"traveling", "traveling", false},
+		{"", "", false},
+	} {
+		a, b := ParseLocation(test.a), ParseLocation(test.b)
+		if got := a.SameInstance(b); got != test.want {
+			t.Errorf("%q in the same instance as %q = %v, want %v", test.a, test.b, got, test.want)
+		}
+		if got := b.SameInstance(a); got != test.want {
+			t.Errorf("%q in the same instance as %q = %v, want %v", test.b, test.a, got, test.want)
+		}
+	}
+}
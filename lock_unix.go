@@ -0,0 +1,28 @@
+//go:build !windows
+// +build !windows
+
+package accumulator
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive flock on the file, creating it if needed. The lock is released when
+// the process exits, so a crashed migration does not leave the database locked.
+func lockFile(path string) (func() error, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	unlock := func() error {
+		defer f.Close()
+		return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	}
+	return unlock, nil
+}
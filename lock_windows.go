@@ -0,0 +1,23 @@
+package accumulator
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+// lockFile creates the file exclusively and removes it to unlock, waiting while it exists.
+// Unlike flock the file is left behind if the process crashes, and has to be removed by hand.
+func lockFile(path string) (func() error, error) {
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0600)
+		if err == nil {
+			f.Close()
+			return func() error { return os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		time.Sleep(time.Second)
+	}
+}
@@ -1,6 +1,10 @@
 package accumulator
 
 import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -25,3 +29,26 @@ func NewLogToStdOut(tag, version string, prod bool) *zap.SugaredLogger {
 	}
 	return l.Sugar().With("version", version).With("tag", tag)
 }
+
+// requestLogger logs every request with its request ID, replacing chi's logger which writes to stdout
+func requestLogger(log *zap.SugaredLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+			defer func() {
+				log.Infow("request",
+					"request_id", middleware.GetReqID(r.Context()),
+					"method", r.Method,
+					"path", r.URL.Path,
+					"status", ww.Status(),
+					"bytes", ww.BytesWritten(),
+					"duration", time.Since(start),
+					"remote_addr", r.RemoteAddr,
+				)
+			}()
+			next.ServeHTTP(ww, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
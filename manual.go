@@ -0,0 +1,162 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// maxManualAttendance is the longest a manual attendance entry can be
+const maxManualAttendance = 24 * time.Hour
+
+// checkManualAttendance checks what the validate tags can't: the teacher is a teacher of the integration, the friend is a
+// student of it, and the entry ends after it starts, within a day and not in the future
+func checkManualAttendance(integrationID int64, req *v1ManualAttendanceRequest) error {
+	invalid := []FieldError{}
+	ok, err := isTeacherOf(integrationID, req.TeacherID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		invalid = append(invalid, FieldError{"teacher_id", "must be a teacher of the integration"})
+	}
+	n, err := db.Friends(
+		db.FriendWhere.ID.EQ(req.FriendID),
+		db.FriendWhere.IntegrationID.EQ(integrationID),
+		db.FriendWhere.IsTeacher.EQ(false),
+		db.FriendWhere.Archived.EQ(false),
+	).CountG()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		invalid = append(invalid, FieldError{"friend_id", "must be a student of the integration"})
+	}
+	switch {
+	case !req.EndsAt.After(req.StartsAt):
+		invalid = append(invalid, FieldError{"ends_at", "must be after starts_at"})
+	case req.EndsAt.Sub(req.StartsAt) > maxManualAttendance:
+		invalid = append(invalid, FieldError{"ends_at", "must be at most a day after starts_at"})
+	case req.EndsAt.After(time.Now()):
+		invalid = append(invalid, FieldError{"ends_at", "must not be in the future"})
+	}
+	if len(invalid) > 0 {
+		return &ValidationError{invalid}
+	}
+	return nil
+}
+
+// SaveManualAttendance adds the manual attendance, or changes it to the request, by the user. The student's sessions with
+// the teacher overlapping it before or after are evaluated again, so the entry counts for classes that are already over.
+func SaveManualAttendance(u *db.User, entry *db.ManualAttendance, req *v1ManualAttendanceRequest, step time.Duration) error {
+	var before interface{}
+	action := auditManualAttendanceCreated
+	if entry.ID != 0 {
+		before, action = toV1ManualAttendance(entry), auditManualAttendanceUpdated
+	}
+	old := *entry
+	entry.TeacherID = req.TeacherID
+	entry.FriendID = req.FriendID
+	entry.StartsAt = req.StartsAt.UTC()
+	entry.EndsAt = req.EndsAt.UTC()
+	entry.Reason = req.Reason
+	entry.AuthorID = u.ID
+
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	if entry.ID == 0 {
+		err = entry.Insert(tx, boil.Infer())
+	} else {
+		_, err = entry.Update(tx, boil.Infer())
+	}
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = audit(tx, u, entry.IntegrationID, action, entry.ID, entry.Reason, before, toV1ManualAttendance(entry))
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	if old.ID != 0 {
+		err = reevaluateSessions(old.IntegrationID, old.TeacherID, old.FriendID, old.StartsAt, old.EndsAt, step)
+		if err != nil {
+			return err
+		}
+	}
+	return reevaluateSessions(entry.IntegrationID, entry.TeacherID, entry.FriendID, entry.StartsAt, entry.EndsAt, step)
+}
+
+// ArchiveManualAttendance stops the manual attendance counting and evaluates the sessions it overlapped again.
+// It is kept for the audit log.
+func ArchiveManualAttendance(u *db.User, entry *db.ManualAttendance, step time.Duration) error {
+	before := toV1ManualAttendance(entry)
+	entry.Archived = true
+	entry.ArchivedAt = null.TimeFrom(time.Now())
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	_, err = entry.Update(tx, boil.Whitelist(db.ManualAttendanceColumns.Archived, db.ManualAttendanceColumns.ArchivedAt, db.ManualAttendanceColumns.UpdatedAt))
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = audit(tx, u, entry.IntegrationID, auditManualAttendanceDeleted, entry.ID, "", before, nil)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return reevaluateSessions(entry.IntegrationID, entry.TeacherID, entry.FriendID, entry.StartsAt, entry.EndsAt, step)
+}
+
+// OverrideSession sets the status of the session by the user, in place of the evaluated one.
+// Evaluating the session again keeps the override.
+func OverrideSession(u *db.User, session *db.ClassSession, status string, reason string) error {
+	before := toV1Session(session)
+	session.OverrideStatus = null.StringFrom(status)
+	session.OverrideReason = null.StringFrom(reason)
+	session.OverrideAuthorID = null.Int64From(u.ID)
+	session.OverriddenAt = null.TimeFrom(time.Now().UTC())
+	return updateOverride(u, session, auditSessionOverridden, reason, before)
+}
+
+// RemoveOverride of the session, its evaluated status counts again
+func RemoveOverride(u *db.User, session *db.ClassSession) error {
+	before := toV1Session(session)
+	session.OverrideStatus = null.String{}
+	session.OverrideReason = null.String{}
+	session.OverrideAuthorID = null.Int64{}
+	session.OverriddenAt = null.Time{}
+	return updateOverride(u, session, auditSessionOverrideRemoved, "", before)
+}
+
+func updateOverride(u *db.User, session *db.ClassSession, action string, reason string, before *v1Session) error {
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	_, err = session.Update(tx, boil.Whitelist(
+		db.ClassSessionColumns.OverrideStatus,
+		db.ClassSessionColumns.OverrideReason,
+		db.ClassSessionColumns.OverrideAuthorID,
+		db.ClassSessionColumns.OverriddenAt,
+		db.ClassSessionColumns.UpdatedAt,
+	))
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = audit(tx, u, session.IntegrationID, action, session.ID, reason, before, toV1Session(session))
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
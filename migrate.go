@@ -0,0 +1,271 @@
+package accumulator
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	migrate_bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
+	"github.com/jmoiron/sqlx"
+)
+
+// migrations has a directory per driver, each driver has its own copy of every migration under the same version
+//
+//go:embed migrations
+var migrations embed.FS
+
+// MigrateHook runs once the migration lock is held, before a migration changes an existing schema
+type MigrateHook func() error
+
+func migrationNames(driver string) ([]string, error) {
+	entries, err := fs.ReadDir(migrations, path.Join("migrations", driver))
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s: %w", driver, err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names, nil
+}
+
+func newMigrateInstance(conn *sqlx.DB) (*migrate.Migrate, error) {
+	dir := conn.DriverName()
+	names, err := migrationNames(dir)
+	if err != nil {
+		return nil, err
+	}
+	// the bindata source only needs the names and a way to read them, which the embedded files provide
+	s := migrate_bindata.Resource(names,
+		func(name string) ([]byte, error) {
+			return migrations.ReadFile(path.Join("migrations", dir, name))
+		})
+	d, err := migrate_bindata.WithInstance(s)
+	if err != nil {
+		return nil, fmt.Errorf("source instance: %w", err)
+	}
+	var dbDriver database.Driver
+	switch dir {
+	case driverPostgres:
+		dbDriver, err = postgres.WithInstance(conn.DB, &postgres.Config{})
+	default:
+		dbDriver, err = sqlite3.WithInstance(conn.DB, &sqlite3.Config{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("db instance: %w", err)
+	}
+	m, err := migrate.NewWithInstance("embed", d, dir, dbDriver)
+	if err != nil {
+		return nil, fmt.Errorf("migrate instance: %w", err)
+	}
+	return m, nil
+}
+
+// withMigrate runs fn on a connection of its own while holding the migration lock, so the server migrating
+// on boot and the admin CLI never run migrations at the same time.
+// SQLite migrations rebuild tables that others reference, which fails with foreign keys enforced and the pragma
+// can not be changed inside the migration's transaction, so they are turned off for this connection and checked once fn is done.
+func withMigrate(c *DatabaseConfig, fn func(m *migrate.Migrate) error) error {
+	unlock, err := lockMigrations(c)
+	if err != nil {
+		return fmt.Errorf("migration lock: %w", err)
+	}
+	defer unlock()
+	conn, err := c.open(false)
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	defer conn.Close()
+	m, err := newMigrateInstance(conn)
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	err = fn(m)
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	if c.Driver != driverSQLite {
+		return nil
+	}
+	rows, err := conn.Queryx("PRAGMA foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("foreign key check: %w", err)
+	}
+	defer rows.Close()
+	violations := []string{}
+	for rows.Next() {
+		cols, err := rows.SliceScan()
+		if err != nil {
+			return fmt.Errorf("foreign key check: %w", err)
+		}
+		violations = append(violations, fmt.Sprintf("%s row %v references %s", cols[0], cols[1], cols[2]))
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("foreign key check: %s", strings.Join(violations, ", "))
+	}
+	return rows.Err()
+}
+
+// beforeChange refuses to touch a dirty schema and runs the hook, unless the database has never been migrated
+// and there is nothing to lose
+func beforeChange(m *migrate.Migrate, hook MigrateHook) error {
+	_, dirty, err := m.Version()
+	if err == migrate.ErrNilVersion {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return errDirty(m)
+	}
+	if hook == nil {
+		return nil
+	}
+	return hook()
+}
+
+func errDirty(m *migrate.Migrate) error {
+	v, _, _ := m.Version()
+	return fmt.Errorf("schema version %d is dirty, a migration failed part way. Fix the schema by hand and run admin db force with the version it is now at", v)
+}
+
+// Migrate the database to the latest version, an up to date database is not an error
+func Migrate(c *DatabaseConfig, hook MigrateHook) error {
+	expected, err := ExpectedVersion(c.Driver)
+	if err != nil {
+		return err
+	}
+	return withMigrate(c, func(m *migrate.Migrate) error {
+		v, dirty, err := m.Version()
+		if err == nil && !dirty && v == expected {
+			return nil
+		}
+		err = beforeChange(m, hook)
+		if err != nil {
+			return err
+		}
+		err = m.Up()
+		if err == migrate.ErrNoChange {
+			return nil
+		}
+		return err
+	})
+}
+
+// MigrateSteps applies the next n migrations, or reverts the newest -n when n is negative
+func MigrateSteps(c *DatabaseConfig, n int, hook MigrateHook) error {
+	if n == 0 {
+		return nil
+	}
+	return withMigrate(c, func(m *migrate.Migrate) error {
+		err := beforeChange(m, hook)
+		if err != nil {
+			return err
+		}
+		return m.Steps(n)
+	})
+}
+
+// MigrateTo migrates up or down to the version
+func MigrateTo(c *DatabaseConfig, version uint, hook MigrateHook) error {
+	return withMigrate(c, func(m *migrate.Migrate) error {
+		v, dirty, err := m.Version()
+		if err == nil && !dirty && v == version {
+			return nil
+		}
+		err = beforeChange(m, hook)
+		if err != nil {
+			return err
+		}
+		err = m.Migrate(version)
+		if err == migrate.ErrNoChange {
+			return nil
+		}
+		return err
+	})
+}
+
+// Force sets the schema version and clears the dirty flag without running any migration.
+// It is for recovering from a failed migration once the schema has been fixed by hand.
+func Force(c *DatabaseConfig, version uint) error {
+	return withMigrate(c, func(m *migrate.Migrate) error {
+		return m.Force(int(version))
+	})
+}
+
+// Drop everything in the database
+func Drop(c *DatabaseConfig, hook MigrateHook) error {
+	return withMigrate(c, func(m *migrate.Migrate) error {
+		// a dirty schema is no reason not to drop it
+		_, _, err := m.Version()
+		if err == nil && hook != nil {
+			err = hook()
+			if err != nil {
+				return err
+			}
+		}
+		return m.Drop()
+	})
+}
+
+// Version of the database schema, and whether the last migration failed part way
+func Version(c *DatabaseConfig) (uint, bool, error) {
+	var v uint
+	var dirty bool
+	err := withMigrate(c, func(m *migrate.Migrate) error {
+		var err error
+		v, dirty, err = m.Version()
+		return err
+	})
+	return v, dirty, err
+}
+
+// ExpectedVersion is the version of the newest migration built into the binary
+func ExpectedVersion(driver string) (uint, error) {
+	names, err := migrationNames(driver)
+	if err != nil {
+		return 0, err
+	}
+	var latest uint64
+	for _, name := range names {
+		v, err := strconv.ParseUint(strings.SplitN(name, "_", 2)[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: %w", name, err)
+		}
+		if v > latest {
+			latest = v
+		}
+	}
+	return uint(latest), nil
+}
+
+// CheckVersion refuses to run against a schema this binary was not built for
+func CheckVersion(conn *sqlx.DB) error {
+	expected, err := ExpectedVersion(conn.DriverName())
+	if err != nil {
+		return err
+	}
+	current := struct {
+		Version uint64 `db:"version"`
+		Dirty   bool   `db:"dirty"`
+	}{}
+	err = conn.Get(&current, "SELECT version, dirty FROM schema_migrations LIMIT 1")
+	if err != nil {
+		return fmt.Errorf("read schema version, has the database been migrated? %w", err)
+	}
+	if current.Dirty {
+		return fmt.Errorf("schema version %d is dirty, a migration failed part way, see admin db force", current.Version)
+	}
+	if uint(current.Version) != expected {
+		return fmt.Errorf("schema version is %d but this build expects %d, run admin db migrate or set ACCUMULATOR_DATABASE_AUTOMIGRATE", current.Version, expected)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package accumulator
+
+import (
+	"context"
+	"fmt"
+)
+
+// migrationLockKey is the Postgres advisory lock held while migrating. It differs from the key golang-migrate
+// locks on internally, so holding both on different sessions can not deadlock.
+const migrationLockKey = 0x61636375 // "accu"
+
+// lockMigrations blocks until no other process is migrating the database and returns the function releasing the lock.
+// The golang-migrate SQLite driver only locks within the process, so SQLite uses a lock file next to the database instead.
+func lockMigrations(c *DatabaseConfig) (func() error, error) {
+	switch c.Driver {
+	case driverPostgres:
+		return lockPostgres(c)
+	case driverSQLite:
+		path := c.sqlitePath()
+		if path == "" || path == ":memory:" {
+			return func() error { return nil }, nil
+		}
+		return lockFile(path + ".migrate-lock")
+	}
+	return nil, fmt.Errorf("unknown database driver: %s", c.Driver)
+}
+
+func lockPostgres(c *DatabaseConfig) (func() error, error) {
+	pool, err := c.open(false)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	// advisory locks belong to the session, so the lock and unlock have to go through the same connection
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey)
+	if err != nil {
+		conn.Close()
+		pool.Close()
+		return nil, err
+	}
+	unlock := func() error {
+		defer pool.Close()
+		defer conn.Close()
+		_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockKey)
+		return err
+	}
+	return unlock, nil
+}
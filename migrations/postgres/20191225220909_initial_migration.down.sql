@@ -0,0 +1,5 @@
+DROP TABLE attendance;
+DROP TABLE friends;
+DROP TABLE integrations;
+DROP TABLE users;
+DROP TABLE blobs;
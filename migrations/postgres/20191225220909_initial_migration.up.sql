@@ -0,0 +1,72 @@
+CREATE TABLE blobs (
+    id BIGSERIAL PRIMARY KEY,
+    file_name VARCHAR UNIQUE NOT NULL,
+    mime_type VARCHAR NOT NULL,
+    file_size_bytes BIGINT NOT NULL,
+    "EXTENSION" VARCHAR NOT NULL,
+    file BYTEA NOT NULL,
+    views BIGINT DEFAULT 0,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    email VARCHAR UNIQUE NOT NULL,
+    password_hash VARCHAR NOT NULL,
+    role VARCHAR NOT NULL DEFAULT 'user',
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE integrations (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    username VARCHAR NOT NULL UNIQUE,
+    api_key VARCHAR NOT NULL,
+    auth_token BYTEA NOT NULL,
+    auth_token_nonce BYTEA NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE friends (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    is_teacher BOOLEAN NOT NULL DEFAULT FALSE,
+    vrchat_id VARCHAR UNIQUE NOT NULL,
+    vrchat_username VARCHAR NOT NULL,
+    vrchat_display_name VARCHAR NOT NULL,
+    vrchat_avatar_image_url VARCHAR NOT NULL,
+    vrchat_avatar_thumbnail_image_url VARCHAR NOT NULL,
+    vrchat_location VARCHAR NOT NULL,
+    avatar_blob_filename VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (integration_id, vrchat_id)
+);
+
+CREATE TABLE attendance (
+    "timestamp" BIGINT NOT NULL,
+    integration_id BIGINT REFERENCES integrations(id),
+    friend_id BIGINT REFERENCES friends(id),
+    teacher_id BIGINT REFERENCES friends(id),
+    location VARCHAR NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY ("timestamp", friend_id, integration_id)
+);
@@ -0,0 +1,5 @@
+-- Friends tracked by more than one integration keep their oldest row
+DELETE FROM attendance WHERE friend_id NOT IN (SELECT MIN(id) FROM friends GROUP BY vrchat_id)
+    OR teacher_id NOT IN (SELECT MIN(id) FROM friends GROUP BY vrchat_id);
+DELETE FROM friends WHERE id NOT IN (SELECT MIN(id) FROM friends GROUP BY vrchat_id);
+ALTER TABLE friends ADD CONSTRAINT friends_vrchat_id_key UNIQUE (vrchat_id);
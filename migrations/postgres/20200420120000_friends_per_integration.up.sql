@@ -0,0 +1 @@
+ALTER TABLE friends DROP CONSTRAINT friends_vrchat_id_key;
@@ -0,0 +1,4 @@
+DROP INDEX friends_avatar_blob_filename_idx;
+ALTER TABLE friends DROP COLUMN avatar_source_url;
+ALTER TABLE friends DROP COLUMN avatar_etag;
+ALTER TABLE friends DROP COLUMN avatar_last_modified;
@@ -0,0 +1,3 @@
+-- Only the database backend existed before, contents held elsewhere can not be kept
+DELETE FROM blobs WHERE backend <> 'sqlite';
+ALTER TABLE blobs DROP COLUMN backend;
@@ -0,0 +1 @@
+ALTER TABLE blobs ADD COLUMN backend VARCHAR NOT NULL DEFAULT 'sqlite';
@@ -0,0 +1,2 @@
+DROP INDEX blob_renditions_blob_file_name_idx;
+DROP TABLE blob_renditions;
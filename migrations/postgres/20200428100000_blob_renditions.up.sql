@@ -0,0 +1,12 @@
+CREATE TABLE blob_renditions (
+    id BIGSERIAL PRIMARY KEY,
+    parent_file_name VARCHAR NOT NULL,
+    size BIGINT NOT NULL,
+    blob_file_name VARCHAR NOT NULL,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (parent_file_name, size)
+);
+CREATE INDEX blob_renditions_blob_file_name_idx ON blob_renditions(blob_file_name);
@@ -0,0 +1 @@
+-- BIGSERIAL PRIMARY KEY columns are already NOT NULL, only SQLite needs its tables rebuilt
@@ -0,0 +1 @@
+-- Postgres has always enforced foreign keys, there are no orphaned rows to remove
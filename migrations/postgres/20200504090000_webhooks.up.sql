@@ -0,0 +1,36 @@
+CREATE TABLE webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    url VARCHAR NOT NULL,
+    -- comma separated event types the webhook is subscribed to
+    events VARCHAR NOT NULL,
+    secret BYTEA NOT NULL,
+    secret_nonce BYTEA NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX webhooks_integration_id_idx ON webhooks(integration_id);
+
+-- The delivery queue. Deliveries are pending until they succeed or run out of attempts.
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES webhooks(id),
+    event_id VARCHAR NOT NULL,
+    event VARCHAR NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,
+    last_error VARCHAR,
+    delivered_at TIMESTAMPTZ,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX webhook_deliveries_status_next_attempt_at_idx ON webhook_deliveries(status, next_attempt_at);
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries(webhook_id, created_at);
@@ -0,0 +1,12 @@
+DROP TABLE worlds;
+DROP INDEX attendance_world_id_instance_id_idx;
+ALTER TABLE attendance DROP COLUMN world_id;
+ALTER TABLE attendance DROP COLUMN instance_id;
+ALTER TABLE attendance DROP COLUMN region;
+ALTER TABLE attendance DROP COLUMN access_type;
+ALTER TABLE attendance DROP COLUMN owner_id;
+ALTER TABLE friends DROP COLUMN vrchat_world_id;
+ALTER TABLE friends DROP COLUMN vrchat_instance_id;
+ALTER TABLE friends DROP COLUMN vrchat_region;
+ALTER TABLE friends DROP COLUMN vrchat_access_type;
+ALTER TABLE friends DROP COLUMN vrchat_owner_id;
@@ -0,0 +1,53 @@
+-- Locations are parsed into the world, the instance in it, the region, the access type and the user or group owning
+-- the instance. They are empty for locations that are not an instance: offline, private, traveling.
+ALTER TABLE attendance ADD COLUMN world_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN instance_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN region VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN access_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN owner_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_world_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_instance_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_region VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_access_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_owner_id VARCHAR NOT NULL DEFAULT '';
+
+UPDATE attendance SET
+    world_id = split_part(location, ':', 1),
+    instance_id = substr(location, strpos(location, ':') + 1),
+    region = COALESCE(substring(location from '~region\(([^)]*)\)'), 'us'),
+    access_type = CASE
+        WHEN location LIKE '%~group(%' THEN 'group'
+        WHEN location LIKE '%~private(%' THEN 'private'
+        WHEN location LIKE '%~hidden(%' THEN 'hidden'
+        WHEN location LIKE '%~friends(%' THEN 'friends'
+        ELSE 'public' END,
+    owner_id = COALESCE(substring(location from '~(?:group|private|hidden|friends)\(([^)]*)\)'), '')
+WHERE location LIKE 'wrld\_%:%';
+
+UPDATE friends SET
+    vrchat_world_id = split_part(vrchat_location, ':', 1),
+    vrchat_instance_id = substr(vrchat_location, strpos(vrchat_location, ':') + 1),
+    vrchat_region = COALESCE(substring(vrchat_location from '~region\(([^)]*)\)'), 'us'),
+    vrchat_access_type = CASE
+        WHEN vrchat_location LIKE '%~group(%' THEN 'group'
+        WHEN vrchat_location LIKE '%~private(%' THEN 'private'
+        WHEN vrchat_location LIKE '%~hidden(%' THEN 'hidden'
+        WHEN vrchat_location LIKE '%~friends(%' THEN 'friends'
+        ELSE 'public' END,
+    vrchat_owner_id = COALESCE(substring(vrchat_location from '~(?:group|private|hidden|friends)\(([^)]*)\)'), '')
+WHERE vrchat_location LIKE 'wrld\_%:%';
+
+CREATE INDEX attendance_world_id_instance_id_idx ON attendance(world_id, instance_id);
+
+-- Names of worlds, looked up from VRChat once a week at most
+CREATE TABLE worlds (
+    id BIGSERIAL PRIMARY KEY,
+    vrchat_id VARCHAR NOT NULL UNIQUE,
+    name VARCHAR NOT NULL,
+    author_name VARCHAR NOT NULL,
+    thumbnail_image_url VARCHAR NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+ALTER TABLE integrations DROP COLUMN scheduled_only;
+DROP TABLE schedules;
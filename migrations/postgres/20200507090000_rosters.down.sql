@@ -0,0 +1,3 @@
+ALTER TABLE schedules DROP COLUMN roster_id;
+DROP TABLE enrolments;
+DROP TABLE rosters;
@@ -0,0 +1,37 @@
+-- Named classes of a teacher. Only students enrolled in one of a teacher's rosters are tracked with them.
+CREATE TABLE rosters (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    teacher_id BIGINT NOT NULL REFERENCES friends(id),
+    name VARCHAR NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX rosters_integration_id_idx ON rosters(integration_id);
+
+CREATE TABLE enrolments (
+    id BIGSERIAL PRIMARY KEY,
+    roster_id BIGINT NOT NULL REFERENCES rosters(id),
+    friend_id BIGINT NOT NULL REFERENCES friends(id),
+    -- comma separated
+    tags VARCHAR NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (roster_id, friend_id)
+);
+CREATE INDEX enrolments_friend_id_idx ON enrolments(friend_id);
+
+-- the students expected at the schedule's classes, all of the teacher's enrolled students when NULL
+ALTER TABLE schedules ADD COLUMN roster_id BIGINT REFERENCES rosters(id);
+
+-- Every student was tracked with every teacher before rosters, so each teacher gets a roster of the current students
+INSERT INTO rosters (integration_id, teacher_id, name)
+SELECT integration_id, id, vrchat_display_name FROM friends WHERE is_teacher AND NOT archived;
+INSERT INTO enrolments (roster_id, friend_id)
+SELECT rosters.id, friends.id FROM rosters JOIN friends ON friends.integration_id = rosters.integration_id
+WHERE NOT friends.is_teacher AND NOT friends.archived;
@@ -0,0 +1,7 @@
+DROP TABLE class_sessions;
+ALTER TABLE schedules DROP COLUMN min_presence_percent;
+ALTER TABLE schedules DROP COLUMN late_after_minutes;
+ALTER TABLE schedules DROP COLUMN left_early_minutes;
+ALTER TABLE integrations DROP COLUMN min_presence_percent;
+ALTER TABLE integrations DROP COLUMN late_after_minutes;
+ALTER TABLE integrations DROP COLUMN left_early_minutes;
@@ -0,0 +1,34 @@
+-- How the samples of a class are turned into a status, schedules without their own rules use the integration's
+ALTER TABLE integrations ADD COLUMN min_presence_percent INTEGER NOT NULL DEFAULT 70;
+ALTER TABLE integrations ADD COLUMN late_after_minutes INTEGER NOT NULL DEFAULT 10;
+ALTER TABLE integrations ADD COLUMN left_early_minutes INTEGER NOT NULL DEFAULT 10;
+ALTER TABLE schedules ADD COLUMN min_presence_percent INTEGER;
+ALTER TABLE schedules ADD COLUMN late_after_minutes INTEGER;
+ALTER TABLE schedules ADD COLUMN left_early_minutes INTEGER;
+
+-- The status of every expected student at a class of a schedule, evaluated once the class is over
+CREATE TABLE class_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    schedule_id BIGINT NOT NULL REFERENCES schedules(id),
+    teacher_id BIGINT NOT NULL REFERENCES friends(id),
+    friend_id BIGINT NOT NULL REFERENCES friends(id),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    -- present, late, left_early or absent
+    status VARCHAR NOT NULL,
+    first_seen_at TIMESTAMPTZ,
+    last_seen_at TIMESTAMPTZ,
+    samples INTEGER NOT NULL DEFAULT 0,
+    minutes_present INTEGER NOT NULL DEFAULT 0,
+    -- the rules the status was evaluated with
+    min_presence_percent INTEGER NOT NULL,
+    late_after_minutes INTEGER NOT NULL,
+    left_early_minutes INTEGER NOT NULL,
+
+    evaluated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (schedule_id, friend_id, starts_at)
+);
+CREATE INDEX class_sessions_integration_id_starts_at_idx ON class_sessions(integration_id, starts_at);
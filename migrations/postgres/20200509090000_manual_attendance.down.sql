@@ -0,0 +1,7 @@
+DROP TABLE audit_log;
+ALTER TABLE class_sessions DROP COLUMN manual_minutes;
+ALTER TABLE class_sessions DROP COLUMN override_status;
+ALTER TABLE class_sessions DROP COLUMN override_reason;
+ALTER TABLE class_sessions DROP COLUMN override_author_id;
+ALTER TABLE class_sessions DROP COLUMN overridden_at;
+DROP TABLE manual_attendance;
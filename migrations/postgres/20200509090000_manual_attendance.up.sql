@@ -0,0 +1,40 @@
+-- Attendance entered by a teacher, for when the tracker couldn't see a student who was there
+CREATE TABLE manual_attendance (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    teacher_id BIGINT NOT NULL REFERENCES friends(id),
+    friend_id BIGINT NOT NULL REFERENCES friends(id),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason VARCHAR NOT NULL,
+    author_id BIGINT NOT NULL REFERENCES users(id),
+
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    archived_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX manual_attendance_integration_id_friend_id_idx ON manual_attendance(integration_id, friend_id);
+
+-- minutes of the class the student was only present for by manual attendance
+ALTER TABLE class_sessions ADD COLUMN manual_minutes INTEGER NOT NULL DEFAULT 0;
+-- a status set by a user, which takes the place of the evaluated one
+ALTER TABLE class_sessions ADD COLUMN override_status VARCHAR;
+ALTER TABLE class_sessions ADD COLUMN override_reason VARCHAR;
+ALTER TABLE class_sessions ADD COLUMN override_author_id BIGINT REFERENCES users(id);
+ALTER TABLE class_sessions ADD COLUMN overridden_at TIMESTAMPTZ;
+
+-- Who changed attendance by hand, when and why, with the JSON of what changed
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    action VARCHAR NOT NULL,
+    subject_id BIGINT NOT NULL,
+    reason VARCHAR NOT NULL DEFAULT '',
+    old_value TEXT,
+    new_value TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX audit_log_integration_id_created_at_idx ON audit_log(integration_id, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE class_sessions DROP COLUMN gap_minutes;
+DROP TABLE tracker_heartbeats;
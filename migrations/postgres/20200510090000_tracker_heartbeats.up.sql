@@ -0,0 +1,17 @@
+-- Every tick of the tracker for an integration, to tell when attendance wasn't being recorded
+CREATE TABLE tracker_heartbeats (
+    id BIGSERIAL PRIMARY KEY,
+    integration_id BIGINT NOT NULL REFERENCES integrations(id),
+    ticked_at TIMESTAMPTZ NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    error_message VARCHAR,
+    -- friends VRChat listed
+    friends INTEGER NOT NULL DEFAULT 0,
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX tracker_heartbeats_integration_id_ticked_at_idx ON tracker_heartbeats(integration_id, ticked_at);
+
+-- minutes of the class the tracker wasn't recording
+ALTER TABLE class_sessions ADD COLUMN gap_minutes INTEGER NOT NULL DEFAULT 0;
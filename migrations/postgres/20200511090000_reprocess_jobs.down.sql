@@ -0,0 +1 @@
+DROP TABLE reprocess_jobs;
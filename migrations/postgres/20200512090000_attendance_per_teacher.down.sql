@@ -0,0 +1,5 @@
+-- Students with two teachers at once keep their sample with the teacher with the lowest ID
+DELETE FROM attendance a USING attendance b
+WHERE b."timestamp" = a."timestamp" AND b.friend_id = a.friend_id AND b.integration_id = a.integration_id AND b.teacher_id < a.teacher_id;
+ALTER TABLE attendance DROP CONSTRAINT attendance_pkey;
+ALTER TABLE attendance ADD PRIMARY KEY ("timestamp", friend_id, integration_id);
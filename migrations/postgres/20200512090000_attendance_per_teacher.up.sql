@@ -0,0 +1,5 @@
+-- A student with two of their teachers in the same instance is in both classes, so they have a sample with each of
+-- them every tick. The teacher is part of the key, and samples without one can't be attributed to a class.
+DELETE FROM attendance WHERE teacher_id IS NULL;
+ALTER TABLE attendance DROP CONSTRAINT attendance_pkey;
+ALTER TABLE attendance ADD PRIMARY KEY ("timestamp", friend_id, teacher_id, integration_id);
@@ -0,0 +1 @@
+DROP TABLE location_samples;
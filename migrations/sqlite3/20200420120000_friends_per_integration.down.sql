@@ -0,0 +1,24 @@
+CREATE TABLE friends_old (
+    id INTEGER PRIMARY KEY,
+    integration_id INT NOT NULL REFERENCES integrations(id),
+    is_teacher BOOLEAN NOT NULL DEFAULT 0,
+    vrchat_id VARCHAR UNIQUE NOT NULL,
+    vrchat_username VARCHAR NOT NULL,
+    vrchat_display_name VARCHAR NOT NULL,
+    vrchat_avatar_image_url VARCHAR NOT NULL,
+    vrchat_avatar_thumbnail_image_url VARCHAR NOT NULL,
+    vrchat_location VARCHAR NOT NULL,
+    avatar_blob_filename VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (integration_id, vrchat_id)
+);
+
+-- Friends tracked by more than one integration keep their oldest row
+INSERT INTO friends_old SELECT * FROM friends WHERE id IN (SELECT MIN(id) FROM friends GROUP BY vrchat_id);
+DROP TABLE friends;
+ALTER TABLE friends_old RENAME TO friends;
@@ -0,0 +1,40 @@
+DROP INDEX friends_avatar_blob_filename_idx;
+
+CREATE TABLE friends_old (
+    id INTEGER PRIMARY KEY,
+    integration_id INT NOT NULL REFERENCES integrations(id),
+    is_teacher BOOLEAN NOT NULL DEFAULT 0,
+    vrchat_id VARCHAR NOT NULL,
+    vrchat_username VARCHAR NOT NULL,
+    vrchat_display_name VARCHAR NOT NULL,
+    vrchat_avatar_image_url VARCHAR NOT NULL,
+    vrchat_avatar_thumbnail_image_url VARCHAR NOT NULL,
+    vrchat_location VARCHAR NOT NULL,
+    avatar_blob_filename VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (integration_id, vrchat_id)
+);
+
+INSERT INTO friends_old SELECT
+    id,
+    integration_id,
+    is_teacher,
+    vrchat_id,
+    vrchat_username,
+    vrchat_display_name,
+    vrchat_avatar_image_url,
+    vrchat_avatar_thumbnail_image_url,
+    vrchat_location,
+    avatar_blob_filename,
+    archived,
+    archived_at,
+    updated_at,
+    created_at
+FROM friends;
+DROP TABLE friends;
+ALTER TABLE friends_old RENAME TO friends;
@@ -0,0 +1,5 @@
+ALTER TABLE friends ADD COLUMN avatar_source_url VARCHAR;
+ALTER TABLE friends ADD COLUMN avatar_etag VARCHAR;
+ALTER TABLE friends ADD COLUMN avatar_last_modified VARCHAR;
+
+CREATE INDEX friends_avatar_blob_filename_idx ON friends (avatar_blob_filename);
@@ -0,0 +1,31 @@
+CREATE TABLE blobs_old (
+    id INTEGER PRIMARY KEY,
+    file_name VARCHAR UNIQUE NOT NULL,
+    mime_type VARCHAR NOT NULL,
+    file_size_bytes INT NOT NULL,
+    EXTENSION VARCHAR NOT NULL,
+    file BLOB NOT NULL,
+    views INTEGER DEFAULT 0,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Blobs stored outside of SQLite cannot be represented any more, move them back before migrating down
+INSERT INTO blobs_old SELECT
+    id,
+    file_name,
+    mime_type,
+    file_size_bytes,
+    EXTENSION,
+    file,
+    views,
+    archived,
+    archived_at,
+    updated_at,
+    created_at
+FROM blobs WHERE backend = 'sqlite';
+DROP TABLE blobs;
+ALTER TABLE blobs_old RENAME TO blobs;
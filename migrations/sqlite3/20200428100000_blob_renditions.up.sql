@@ -0,0 +1,12 @@
+CREATE TABLE blob_renditions (
+    id INTEGER PRIMARY KEY,
+    parent_file_name VARCHAR NOT NULL,
+    size INTEGER NOT NULL,
+    blob_file_name VARCHAR NOT NULL,
+
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (parent_file_name, size)
+);
+CREATE INDEX blob_renditions_blob_file_name_idx ON blob_renditions(blob_file_name);
@@ -0,0 +1,89 @@
+-- An INTEGER PRIMARY KEY column is nullable unless declared otherwise, which generated models with nullable ids
+-- that could not read back the rows they inserted. Rebuild every table with an id so it is declared NOT NULL.
+
+CREATE TABLE users_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    email VARCHAR UNIQUE NOT NULL,
+    password_hash VARCHAR NOT NULL,
+    role VARCHAR NOT NULL DEFAULT 'user',
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO users_new SELECT * FROM users;
+DROP TABLE users;
+ALTER TABLE users_new RENAME TO users;
+
+CREATE TABLE integrations_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    username VARCHAR NOT NULL UNIQUE,
+    api_key VARCHAR NOT NULL,
+    auth_token BLOB NOT NULL,
+    auth_token_nonce BLOB NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO integrations_new SELECT * FROM integrations;
+DROP TABLE integrations;
+ALTER TABLE integrations_new RENAME TO integrations;
+
+CREATE TABLE blobs_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    file_name VARCHAR UNIQUE NOT NULL,
+    mime_type VARCHAR NOT NULL,
+    file_size_bytes INT NOT NULL,
+    EXTENSION VARCHAR NOT NULL,
+    file BLOB NOT NULL,
+    views INTEGER DEFAULT 0,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    backend VARCHAR NOT NULL DEFAULT 'sqlite'
+);
+INSERT INTO blobs_new SELECT * FROM blobs;
+DROP TABLE blobs;
+ALTER TABLE blobs_new RENAME TO blobs;
+
+CREATE TABLE friends_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INT NOT NULL REFERENCES integrations(id),
+    is_teacher BOOLEAN NOT NULL DEFAULT 0,
+    vrchat_id VARCHAR NOT NULL,
+    vrchat_username VARCHAR NOT NULL,
+    vrchat_display_name VARCHAR NOT NULL,
+    vrchat_avatar_image_url VARCHAR NOT NULL,
+    vrchat_avatar_thumbnail_image_url VARCHAR NOT NULL,
+    vrchat_location VARCHAR NOT NULL,
+    avatar_blob_filename VARCHAR,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    avatar_source_url VARCHAR,
+    avatar_etag VARCHAR,
+    avatar_last_modified VARCHAR,
+    UNIQUE (integration_id, vrchat_id)
+);
+INSERT INTO friends_new SELECT * FROM friends;
+DROP TABLE friends;
+ALTER TABLE friends_new RENAME TO friends;
+CREATE INDEX friends_avatar_blob_filename_idx ON friends (avatar_blob_filename);
+
+CREATE TABLE blob_renditions_new (
+    id INTEGER PRIMARY KEY NOT NULL,
+    parent_file_name VARCHAR NOT NULL,
+    size INTEGER NOT NULL,
+    blob_file_name VARCHAR NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (parent_file_name, size)
+);
+INSERT INTO blob_renditions_new SELECT * FROM blob_renditions;
+DROP TABLE blob_renditions;
+ALTER TABLE blob_renditions_new RENAME TO blob_renditions;
+CREATE INDEX blob_renditions_blob_file_name_idx ON blob_renditions (blob_file_name);
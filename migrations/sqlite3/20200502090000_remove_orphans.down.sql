@@ -0,0 +1 @@
+-- The orphaned rows can not be restored
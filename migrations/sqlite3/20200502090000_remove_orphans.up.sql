@@ -0,0 +1,6 @@
+-- Foreign keys are enforced from now on. Deleting an integration used to leave its friends and attendance behind.
+DELETE FROM integrations WHERE user_id NOT IN (SELECT id FROM users);
+DELETE FROM friends WHERE integration_id NOT IN (SELECT id FROM integrations);
+DELETE FROM attendance WHERE integration_id NOT IN (SELECT id FROM integrations)
+    OR friend_id NOT IN (SELECT id FROM friends)
+    OR teacher_id NOT IN (SELECT id FROM friends);
@@ -0,0 +1,73 @@
+DROP TABLE worlds;
+DROP INDEX attendance_world_id_instance_id_idx;
+
+CREATE TABLE attendance_old (
+    timestamp INT NOT NULL,
+    integration_id INT NULL NULL REFERENCES integrations(id),
+    friend_id INT REFERENCES friends(id),
+    teacher_id INT REFERENCES friends(id),
+    location VARCHAR NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (timestamp, friend_id, integration_id)
+);
+INSERT INTO attendance_old SELECT
+    timestamp,
+    integration_id,
+    friend_id,
+    teacher_id,
+    location,
+    archived,
+    archived_at,
+    updated_at,
+    created_at
+FROM attendance;
+DROP TABLE attendance;
+ALTER TABLE attendance_old RENAME TO attendance;
+
+CREATE TABLE friends_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INT NOT NULL REFERENCES integrations(id),
+    is_teacher BOOLEAN NOT NULL DEFAULT 0,
+    vrchat_id VARCHAR NOT NULL,
+    vrchat_username VARCHAR NOT NULL,
+    vrchat_display_name VARCHAR NOT NULL,
+    vrchat_avatar_image_url VARCHAR NOT NULL,
+    vrchat_avatar_thumbnail_image_url VARCHAR NOT NULL,
+    vrchat_location VARCHAR NOT NULL,
+    avatar_blob_filename VARCHAR,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    avatar_source_url VARCHAR,
+    avatar_etag VARCHAR,
+    avatar_last_modified VARCHAR,
+    UNIQUE (integration_id, vrchat_id)
+);
+INSERT INTO friends_old SELECT
+    id,
+    integration_id,
+    is_teacher,
+    vrchat_id,
+    vrchat_username,
+    vrchat_display_name,
+    vrchat_avatar_image_url,
+    vrchat_avatar_thumbnail_image_url,
+    vrchat_location,
+    avatar_blob_filename,
+    archived,
+    archived_at,
+    updated_at,
+    created_at,
+    avatar_source_url,
+    avatar_etag,
+    avatar_last_modified
+FROM friends;
+DROP TABLE friends;
+ALTER TABLE friends_old RENAME TO friends;
+CREATE INDEX friends_avatar_blob_filename_idx ON friends (avatar_blob_filename);
@@ -0,0 +1,63 @@
+-- Locations are parsed into the world, the instance in it, the region, the access type and the user or group owning
+-- the instance. They are empty for locations that are not an instance: offline, private, traveling.
+ALTER TABLE attendance ADD COLUMN world_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN instance_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN region VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN access_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE attendance ADD COLUMN owner_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_world_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_instance_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_region VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_access_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE friends ADD COLUMN vrchat_owner_id VARCHAR NOT NULL DEFAULT '';
+
+UPDATE attendance SET
+    world_id = substr(location, 1, instr(location, ':') - 1),
+    instance_id = substr(location, instr(location, ':') + 1),
+    region = CASE WHEN instr(location, '~region(') > 0 THEN substr(location, instr(location, '~region(') + 8, instr(substr(location, instr(location, '~region(') + 8), ')') - 1) ELSE 'us' END,
+    access_type = CASE
+        WHEN instr(location, '~group(') > 0 THEN 'group'
+        WHEN instr(location, '~private(') > 0 THEN 'private'
+        WHEN instr(location, '~hidden(') > 0 THEN 'hidden'
+        WHEN instr(location, '~friends(') > 0 THEN 'friends'
+        ELSE 'public' END,
+    owner_id = CASE
+        WHEN instr(location, '~group(') > 0 THEN substr(location, instr(location, '~group(') + 7, instr(substr(location, instr(location, '~group(') + 7), ')') - 1)
+        WHEN instr(location, '~private(') > 0 THEN substr(location, instr(location, '~private(') + 9, instr(substr(location, instr(location, '~private(') + 9), ')') - 1)
+        WHEN instr(location, '~hidden(') > 0 THEN substr(location, instr(location, '~hidden(') + 8, instr(substr(location, instr(location, '~hidden(') + 8), ')') - 1)
+        WHEN instr(location, '~friends(') > 0 THEN substr(location, instr(location, '~friends(') + 9, instr(substr(location, instr(location, '~friends(') + 9), ')') - 1)
+        ELSE '' END
+WHERE location LIKE 'wrld~_%:%' ESCAPE '~';
+
+UPDATE friends SET
+    vrchat_world_id = substr(vrchat_location, 1, instr(vrchat_location, ':') - 1),
+    vrchat_instance_id = substr(vrchat_location, instr(vrchat_location, ':') + 1),
+    vrchat_region = CASE WHEN instr(vrchat_location, '~region(') > 0 THEN substr(vrchat_location, instr(vrchat_location, '~region(') + 8, instr(substr(vrchat_location, instr(vrchat_location, '~region(') + 8), ')') - 1) ELSE 'us' END,
+    vrchat_access_type = CASE
+        WHEN instr(vrchat_location, '~group(') > 0 THEN 'group'
+        WHEN instr(vrchat_location, '~private(') > 0 THEN 'private'
+        WHEN instr(vrchat_location, '~hidden(') > 0 THEN 'hidden'
+        WHEN instr(vrchat_location, '~friends(') > 0 THEN 'friends'
+        ELSE 'public' END,
+    vrchat_owner_id = CASE
+        WHEN instr(vrchat_location, '~group(') > 0 THEN substr(vrchat_location, instr(vrchat_location, '~group(') + 7, instr(substr(vrchat_location, instr(vrchat_location, '~group(') + 7), ')') - 1)
+        WHEN instr(vrchat_location, '~private(') > 0 THEN substr(vrchat_location, instr(vrchat_location, '~private(') + 9, instr(substr(vrchat_location, instr(vrchat_location, '~private(') + 9), ')') - 1)
+        WHEN instr(vrchat_location, '~hidden(') > 0 THEN substr(vrchat_location, instr(vrchat_location, '~hidden(') + 8, instr(substr(vrchat_location, instr(vrchat_location, '~hidden(') + 8), ')') - 1)
+        WHEN instr(vrchat_location, '~friends(') > 0 THEN substr(vrchat_location, instr(vrchat_location, '~friends(') + 9, instr(substr(vrchat_location, instr(vrchat_location, '~friends(') + 9), ')') - 1)
+        ELSE '' END
+WHERE vrchat_location LIKE 'wrld~_%:%' ESCAPE '~';
+
+CREATE INDEX attendance_world_id_instance_id_idx ON attendance(world_id, instance_id);
+
+-- Names of worlds, looked up from VRChat once a week at most
+CREATE TABLE worlds (
+    id INTEGER PRIMARY KEY NOT NULL,
+    vrchat_id VARCHAR NOT NULL UNIQUE,
+    name VARCHAR NOT NULL,
+    author_name VARCHAR NOT NULL,
+    thumbnail_image_url VARCHAR NOT NULL,
+    fetched_at DATETIME NOT NULL,
+
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,28 @@
+DROP TABLE schedules;
+
+CREATE TABLE integrations_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    username VARCHAR NOT NULL UNIQUE,
+    api_key VARCHAR NOT NULL,
+    auth_token BLOB NOT NULL,
+    auth_token_nonce BLOB NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO integrations_old SELECT
+    id,
+    user_id,
+    username,
+    api_key,
+    auth_token,
+    auth_token_nonce,
+    archived,
+    archived_at,
+    updated_at,
+    created_at
+FROM integrations;
+DROP TABLE integrations;
+ALTER TABLE integrations_old RENAME TO integrations;
@@ -0,0 +1,23 @@
+-- Recurring classes of a teacher. Times are wall clock times in the time zone, a class ending before it starts ends the next day.
+CREATE TABLE schedules (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    teacher_id INTEGER NOT NULL REFERENCES friends(id),
+    name VARCHAR NOT NULL,
+    -- comma separated days of the week the class is on: mon,tue,wed,thu,fri,sat,sun
+    weekdays VARCHAR NOT NULL,
+    start_time VARCHAR NOT NULL,
+    end_time VARCHAR NOT NULL,
+    time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+    -- only count the teacher's instances in this world, any world when NULL
+    world_id VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX schedules_integration_id_idx ON schedules(integration_id);
+
+-- Only record attendance inside the windows of the integration's schedules
+ALTER TABLE integrations ADD COLUMN scheduled_only BOOLEAN NOT NULL DEFAULT 0;
@@ -0,0 +1,37 @@
+CREATE TABLE schedules_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    teacher_id INTEGER NOT NULL REFERENCES friends(id),
+    name VARCHAR NOT NULL,
+    weekdays VARCHAR NOT NULL,
+    start_time VARCHAR NOT NULL,
+    end_time VARCHAR NOT NULL,
+    time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+    world_id VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO schedules_old SELECT
+    id,
+    integration_id,
+    teacher_id,
+    name,
+    weekdays,
+    start_time,
+    end_time,
+    time_zone,
+    world_id,
+    archived,
+    archived_at,
+    updated_at,
+    created_at
+FROM schedules;
+DROP TABLE schedules;
+ALTER TABLE schedules_old RENAME TO schedules;
+CREATE INDEX schedules_integration_id_idx ON schedules(integration_id);
+
+DROP TABLE enrolments;
+DROP TABLE rosters;
@@ -0,0 +1,67 @@
+DROP TABLE class_sessions;
+
+CREATE TABLE schedules_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    teacher_id INTEGER NOT NULL REFERENCES friends(id),
+    name VARCHAR NOT NULL,
+    weekdays VARCHAR NOT NULL,
+    start_time VARCHAR NOT NULL,
+    end_time VARCHAR NOT NULL,
+    time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+    world_id VARCHAR,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    roster_id INTEGER REFERENCES rosters(id)
+);
+INSERT INTO schedules_old SELECT
+    id,
+    integration_id,
+    teacher_id,
+    name,
+    weekdays,
+    start_time,
+    end_time,
+    time_zone,
+    world_id,
+    archived,
+    archived_at,
+    updated_at,
+    created_at,
+    roster_id
+FROM schedules;
+DROP TABLE schedules;
+ALTER TABLE schedules_old RENAME TO schedules;
+CREATE INDEX schedules_integration_id_idx ON schedules(integration_id);
+
+CREATE TABLE integrations_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    username VARCHAR NOT NULL UNIQUE,
+    api_key VARCHAR NOT NULL,
+    auth_token BLOB NOT NULL,
+    auth_token_nonce BLOB NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    scheduled_only BOOLEAN NOT NULL DEFAULT 0
+);
+INSERT INTO integrations_old SELECT
+    id,
+    user_id,
+    username,
+    api_key,
+    auth_token,
+    auth_token_nonce,
+    archived,
+    archived_at,
+    updated_at,
+    created_at,
+    scheduled_only
+FROM integrations;
+DROP TABLE integrations;
+ALTER TABLE integrations_old RENAME TO integrations;
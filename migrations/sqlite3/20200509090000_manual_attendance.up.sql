@@ -0,0 +1,40 @@
+-- Attendance entered by a teacher, for when the tracker couldn't see a student who was there
+CREATE TABLE manual_attendance (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    teacher_id INTEGER NOT NULL REFERENCES friends(id),
+    friend_id INTEGER NOT NULL REFERENCES friends(id),
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    reason VARCHAR NOT NULL,
+    author_id INTEGER NOT NULL REFERENCES users(id),
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX manual_attendance_integration_id_friend_id_idx ON manual_attendance(integration_id, friend_id);
+
+-- minutes of the class the student was only present for by manual attendance
+ALTER TABLE class_sessions ADD COLUMN manual_minutes INTEGER NOT NULL DEFAULT 0;
+-- a status set by a user, which takes the place of the evaluated one
+ALTER TABLE class_sessions ADD COLUMN override_status VARCHAR;
+ALTER TABLE class_sessions ADD COLUMN override_reason VARCHAR;
+ALTER TABLE class_sessions ADD COLUMN override_author_id INTEGER REFERENCES users(id);
+ALTER TABLE class_sessions ADD COLUMN overridden_at DATETIME;
+
+-- Who changed attendance by hand, when and why, with the JSON of what changed
+CREATE TABLE audit_log (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    action VARCHAR NOT NULL,
+    subject_id INTEGER NOT NULL,
+    reason VARCHAR NOT NULL DEFAULT '',
+    old_value TEXT,
+    new_value TEXT,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX audit_log_integration_id_created_at_idx ON audit_log(integration_id, created_at);
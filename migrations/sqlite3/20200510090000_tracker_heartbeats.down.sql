@@ -0,0 +1,59 @@
+DROP TABLE tracker_heartbeats;
+
+CREATE TABLE class_sessions_old (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    schedule_id INTEGER NOT NULL REFERENCES schedules(id),
+    teacher_id INTEGER NOT NULL REFERENCES friends(id),
+    friend_id INTEGER NOT NULL REFERENCES friends(id),
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    -- present, late, left_early or absent
+    status VARCHAR NOT NULL,
+    first_seen_at DATETIME,
+    last_seen_at DATETIME,
+    samples INTEGER NOT NULL DEFAULT 0,
+    minutes_present INTEGER NOT NULL DEFAULT 0,
+    -- the rules the status was evaluated with
+    min_presence_percent INTEGER NOT NULL,
+    late_after_minutes INTEGER NOT NULL,
+    left_early_minutes INTEGER NOT NULL,
+
+    evaluated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    manual_minutes INTEGER NOT NULL DEFAULT 0,
+    override_status VARCHAR,
+    override_reason VARCHAR,
+    override_author_id INTEGER REFERENCES users(id),
+    overridden_at DATETIME,
+    UNIQUE (schedule_id, friend_id, starts_at)
+);
+INSERT INTO class_sessions_old SELECT
+    id,
+    integration_id,
+    schedule_id,
+    teacher_id,
+    friend_id,
+    starts_at,
+    ends_at,
+    status,
+    first_seen_at,
+    last_seen_at,
+    samples,
+    minutes_present,
+    min_presence_percent,
+    late_after_minutes,
+    left_early_minutes,
+    evaluated_at,
+    updated_at,
+    created_at,
+    manual_minutes,
+    override_status,
+    override_reason,
+    override_author_id,
+    overridden_at
+FROM class_sessions;
+DROP TABLE class_sessions;
+ALTER TABLE class_sessions_old RENAME TO class_sessions;
+CREATE INDEX class_sessions_integration_id_starts_at_idx ON class_sessions(integration_id, starts_at);
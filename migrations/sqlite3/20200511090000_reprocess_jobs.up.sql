@@ -0,0 +1,24 @@
+-- Jobs evaluating the classes of an integration that ended in a period again, from the samples and the rules of now
+CREATE TABLE reprocess_jobs (
+    id INTEGER PRIMARY KEY NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    -- the user who asked for it, null when run from the admin command
+    user_id INTEGER REFERENCES users(id),
+    period_start DATETIME NOT NULL,
+    period_end DATETIME NOT NULL,
+    -- queued, running, succeeded or failed
+    status VARCHAR NOT NULL DEFAULT 'queued',
+    total_classes INTEGER NOT NULL DEFAULT 0,
+    processed_classes INTEGER NOT NULL DEFAULT 0,
+    sessions_created INTEGER NOT NULL DEFAULT 0,
+    sessions_changed INTEGER NOT NULL DEFAULT 0,
+    sessions_deleted INTEGER NOT NULL DEFAULT 0,
+    error_message VARCHAR,
+    started_at DATETIME,
+    finished_at DATETIME,
+
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX reprocess_jobs_status_idx ON reprocess_jobs(status, created_at);
+CREATE INDEX reprocess_jobs_integration_id_idx ON reprocess_jobs(integration_id, created_at);
@@ -0,0 +1,27 @@
+-- Students with two teachers at once keep their sample with the teacher with the lowest ID
+CREATE TABLE attendance_old (
+    timestamp INT NOT NULL,
+    integration_id INT NULL NULL REFERENCES integrations(id),
+    friend_id INT REFERENCES friends(id),
+    teacher_id INT REFERENCES friends(id),
+    location VARCHAR NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    world_id VARCHAR NOT NULL DEFAULT '',
+    instance_id VARCHAR NOT NULL DEFAULT '',
+    region VARCHAR NOT NULL DEFAULT '',
+    access_type VARCHAR NOT NULL DEFAULT '',
+    owner_id VARCHAR NOT NULL DEFAULT '',
+
+    PRIMARY KEY (timestamp, friend_id, integration_id)
+);
+INSERT INTO attendance_old SELECT * FROM attendance a WHERE NOT EXISTS (
+    SELECT 1 FROM attendance b
+    WHERE b.timestamp = a.timestamp AND b.friend_id = a.friend_id AND b.integration_id = a.integration_id AND b.teacher_id < a.teacher_id
+);
+DROP TABLE attendance;
+ALTER TABLE attendance_old RENAME TO attendance;
+CREATE INDEX attendance_world_id_instance_id_idx ON attendance(world_id, instance_id);
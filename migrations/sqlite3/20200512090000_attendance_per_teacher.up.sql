@@ -0,0 +1,25 @@
+-- A student with two of their teachers in the same instance is in both classes, so they have a sample with each of
+-- them every tick. The teacher is part of the key.
+CREATE TABLE attendance_new (
+    timestamp INT NOT NULL,
+    integration_id INT NULL NULL REFERENCES integrations(id),
+    friend_id INT REFERENCES friends(id),
+    teacher_id INT REFERENCES friends(id),
+    location VARCHAR NOT NULL,
+
+    archived BOOLEAN NOT NULL DEFAULT 0,
+    archived_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    world_id VARCHAR NOT NULL DEFAULT '',
+    instance_id VARCHAR NOT NULL DEFAULT '',
+    region VARCHAR NOT NULL DEFAULT '',
+    access_type VARCHAR NOT NULL DEFAULT '',
+    owner_id VARCHAR NOT NULL DEFAULT '',
+
+    PRIMARY KEY (timestamp, friend_id, teacher_id, integration_id)
+);
+INSERT INTO attendance_new SELECT * FROM attendance;
+DROP TABLE attendance;
+ALTER TABLE attendance_new RENAME TO attendance;
+CREATE INDEX attendance_world_id_instance_id_idx ON attendance(world_id, instance_id);
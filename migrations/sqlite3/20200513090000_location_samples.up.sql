@@ -0,0 +1,12 @@
+-- Where the tracker saw each friend of the integration who was in an instance at every tick, whether or not they were
+-- a teacher or enrolled at the time, so attendance can be rebuilt after teachers or rosters change
+CREATE TABLE location_samples (
+    timestamp INT NOT NULL,
+    integration_id INTEGER NOT NULL REFERENCES integrations(id),
+    friend_id INTEGER NOT NULL REFERENCES friends(id),
+    location VARCHAR NOT NULL,
+
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (integration_id, timestamp, friend_id)
+);
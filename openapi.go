@@ -0,0 +1,503 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null"
+)
+
+// apiRoute describes a route registered in the API router for the OpenAPI spec.
+// Request and Response are zero values of the JSON bodies, nil when there is none.
+type apiRoute struct {
+	Method  string
+	Pattern string
+	// Name is the operation ID, the same as in the frontend's API documentation
+	Name     string
+	Summary  string
+	Tag      string
+	Public   bool
+	Query    []apiQueryParam
+	Request  interface{}
+	Response interface{}
+	// ContentType of responses that are not JSON
+	ContentType string
+	// Successor is the /api/v1 route external integrations should use instead, see deprecations
+	Successor string
+}
+
+type apiQueryParam struct {
+	Name        string
+	Description string
+	Type        string
+}
+
+// sessionQueryParams are the filters shared by the sessions list and export
+var sessionQueryParams = []apiQueryParam{
+	{"schedule_id", "Only the classes of the schedule", "integer"},
+	{"teacher_id", "Only the classes of the teacher", "integer"},
+	{"friend_id", "Only the sessions of the student, by ID", "integer"},
+	{"status", "Only present, late, left_early or absent students", "string"},
+	{"from", "RFC 3339 time classes start at or after", "string"},
+	{"to", "RFC 3339 time classes start before", "string"},
+}
+
+// apiRoutes must list every route registered in the API router, TestRoutesDocumented fails otherwise
+var apiRoutes = []apiRoute{
+	{Method: http.MethodPost, Pattern: "/api/auth/sign_out", Name: "signOut", Summary: "Sign out by clearing the JWT cookie", Tag: "auth", Public: true, Response: &successResponse{}},
+	{Method: http.MethodGet, Pattern: "/api/auth/check", Name: "check", Summary: "The signed in user", Tag: "auth", Response: &userResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/auth/set_password", Name: "setPassword", Summary: "Change the signed in user's password", Tag: "auth", Request: &setPasswordRequest{}},
+	{Method: http.MethodGet, Pattern: "/api/auth/jwt", Name: "userJWT", Summary: "A JWT for the Authorization header, valid for 30 days", Tag: "auth", Response: &jwtResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/auth/sign_in", Name: "signIn", Summary: "Sign in, setting the JWT cookie", Tag: "auth", Public: true, Request: &credentialsRequest{}, Response: &successResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/auth/sign_up", Name: "signUp", Summary: "Sign up, not available yet", Tag: "auth", Public: true, Request: &credentialsRequest{}},
+
+	{
+		Method: http.MethodGet, Pattern: "/api/blobs/{blob_id}", Name: "blob", Summary: "Download a blob, authenticated or with a signed URL", Tag: "blobs",
+		Query: []apiQueryParam{
+			{"size", "Width of a resized rendition of an image", "integer"},
+			{"expires", "Unix time the signed URL expires at", "integer"},
+			{"signature", "Signature of a signed URL", "string"},
+		},
+		ContentType: "application/octet-stream",
+	},
+	{Method: http.MethodPost, Pattern: "/api/blobs/{blob_id}/sign", Name: "blobSign", Summary: "A signed URL for the blob that works without authentication until it expires", Tag: "blobs", Response: &blobSignResponse{}},
+
+	{Method: http.MethodGet, Pattern: "/api/users/list", Name: "userList", Summary: "List users (admin only)", Tag: "users", Response: &usersResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/users/impersonate/{user_id}", Name: "userImpersonate", Summary: "Sign in as another user (admin only)", Tag: "users", Response: &tokenResponse{}},
+
+	{Method: http.MethodGet, Pattern: "/api/integrations/list", Name: "integrationsList", Summary: "List the signed in user's VRChat integrations", Tag: "integrations", Response: &integrationsResponse{}, Successor: "/api/v1/integrations"},
+	{Method: http.MethodPost, Pattern: "/api/integrations/add_username", Name: "integrationsAddUsername", Summary: "Add a VRChat integration, or move an existing one to the signed in user", Tag: "integrations", Request: &addUsernameRequest{}, Response: &db.Integration{}},
+	{Method: http.MethodPost, Pattern: "/api/integrations/{integration_id}/update_friends", Name: "integrationUpdateFriends", Summary: "Fetch the integration's friends from VRChat, done automatically every 5 minutes", Tag: "integrations", Response: &successResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/integrations/{integration_id}/delete", Name: "integrationsDelete", Summary: "Delete an integration with its friends and attendance", Tag: "integrations"},
+
+	{Method: http.MethodGet, Pattern: "/api/integrations/{integration_id}/live", Name: "live", Summary: "WebSocket streaming the integration's events as JSON messages shaped like webhook deliveries", Tag: "attendance", ContentType: "application/json"},
+	{Method: http.MethodGet, Pattern: "/api/integrations/{integration_id}/attendance/{teacher_id}/list", Name: "attendanceList", Summary: "Attendance recorded in the teacher's instances", Tag: "attendance", Response: &attendanceResponse{}, Successor: "/api/v1/integrations/{integration_id}/attendance?teacher_id={teacher_id}"},
+
+	{Method: http.MethodGet, Pattern: "/api/integrations/{integration_id}/friends/list", Name: "friendList", Summary: "List the integration's friends", Tag: "friends", Response: &friendsResponse{}, Successor: "/api/v1/integrations/{integration_id}/friends"},
+	{Method: http.MethodPost, Pattern: "/api/integrations/{integration_id}/friends/refresh", Name: "friendRefresh", Summary: "List the integration's friends, refreshing their locations is not done yet", Tag: "friends", Response: &friendsResponse{}, Successor: "/api/v1/integrations/{integration_id}/friends"},
+	{Method: http.MethodPost, Pattern: "/api/integrations/{integration_id}/friends/{vrchat_id}/promote", Name: "friendPromote", Summary: "Make a friend a teacher", Tag: "friends", Response: &db.Friend{}, Successor: "/api/v1/integrations/{integration_id}/friends?vrchat_id={vrchat_id}"},
+	{Method: http.MethodPost, Pattern: "/api/integrations/{integration_id}/friends/{vrchat_id}/demote", Name: "friendDemote", Summary: "Make a teacher a student", Tag: "friends", Response: &db.Friend{}, Successor: "/api/v1/integrations/{integration_id}/friends?vrchat_id={vrchat_id}"},
+
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations", Name: "v1IntegrationsList", Summary: "List the VRChat integrations of the authenticated user", Tag: "v1", Response: &v1IntegrationsResponse{}},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}", Name: "v1Integration", Summary: "An integration", Tag: "v1", Response: &v1IntegrationResponse{}},
+	{Method: http.MethodPatch, Pattern: "/api/v1/integrations/{integration_id}", Name: "v1IntegrationUpdate", Summary: "Record attendance only during scheduled classes, or all the time", Tag: "v1", Request: &v1IntegrationUpdateRequest{}, Response: &v1IntegrationResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/friends", Name: "v1FriendsList", Summary: "List the integration's friends", Tag: "v1",
+		Query: []apiQueryParam{
+			{"is_teacher", "Only teachers, or only students", "boolean"},
+			{"vrchat_id", "Only the friend with the VRChat user ID, to look up their ID", "string"},
+		},
+		Response: &v1FriendsResponse{},
+	},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/friends/{friend_id}", Name: "v1Friend", Summary: "A friend", Tag: "v1", Response: &v1FriendResponse{}},
+	{Method: http.MethodPatch, Pattern: "/api/v1/integrations/{integration_id}/friends/{friend_id}", Name: "v1FriendUpdate", Summary: "Make a friend a teacher or a student", Tag: "v1", Request: &v1FriendUpdateRequest{}, Response: &v1FriendResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/attendance", Name: "v1AttendanceList", Summary: "Page through attendance in time order", Tag: "v1",
+		Query: []apiQueryParam{
+			{"teacher_id", "Only attendance of the teacher's classes", "integer"},
+			{"roster_id", "Only attendance of the roster's students in its teacher's classes", "integer"},
+			{"friend_id", "Only attendance of the friend, by ID", "integer"},
+			{"from", "RFC 3339 time to start at, inclusive", "string"},
+			{"to", "RFC 3339 time to end at, exclusive", "string"},
+			{"limit", "Records per page, 1000 by default and at most 10000", "integer"},
+			{"offset", "Records to skip", "integer"},
+		},
+		Response: &v1AttendanceResponse{},
+	},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/presence", Name: "v1Presence", Summary: "Where the teachers are and which students are with them, as of the tracker's last tick", Tag: "v1", Response: &v1PresenceResponse{}},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/webhooks", Name: "v1WebhooksList", Summary: "List the integration's webhooks", Tag: "v1", Response: &v1WebhooksResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/webhooks", Name: "v1WebhookCreate", Summary: "Subscribe a URL to events, the response has the secret deliveries are signed with", Tag: "v1", Request: &v1WebhookCreateRequest{}, Response: &v1WebhookResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/webhooks/{webhook_id}", Name: "v1WebhookDelete", Summary: "Delete a webhook, its pending deliveries are not sent", Tag: "v1", Response: &successResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/webhooks/{webhook_id}/deliveries", Name: "v1WebhookDeliveries", Summary: "The newest deliveries of a webhook", Tag: "v1",
+		Query: []apiQueryParam{
+			{"status", "Only pending, delivered or failed deliveries", "string"},
+			{"limit", "Deliveries to return, 50 by default and at most 500", "integer"},
+		},
+		Response: &v1WebhookDeliveriesResponse{},
+	},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/replay", Name: "v1WebhookReplay", Summary: "Send a delivery again as a new delivery of the same event", Tag: "v1", Response: &v1WebhookDeliveryResponse{}},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/schedules", Name: "v1SchedulesList", Summary: "List the integration's class schedules", Tag: "v1", Response: &v1SchedulesResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/schedules", Name: "v1ScheduleCreate", Summary: "Add a recurring class of a teacher", Tag: "v1", Request: &v1ScheduleRequest{}, Response: &v1ScheduleResponse{}},
+	{Method: http.MethodPut, Pattern: "/api/v1/integrations/{integration_id}/schedules/{schedule_id}", Name: "v1ScheduleUpdate", Summary: "Replace a class schedule", Tag: "v1", Request: &v1ScheduleRequest{}, Response: &v1ScheduleResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/schedules/{schedule_id}", Name: "v1ScheduleDelete", Summary: "Archive a class schedule", Tag: "v1", Response: &successResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/schedules/{schedule_id}/occurrences", Name: "v1Occurrences", Summary: "The classes of a schedule with who attended and who was absent", Tag: "v1",
+		Query: []apiQueryParam{
+			{"from", "RFC 3339 time to start at, four weeks ago by default", "string"},
+			{"to", "RFC 3339 time to end at, now by default and at most 92 days after from", "string"},
+		},
+		Response: &v1OccurrencesResponse{},
+	},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/rosters", Name: "v1RostersList", Summary: "List the integration's rosters", Tag: "v1",
+		Query:    []apiQueryParam{{"teacher_id", "Only the teacher's rosters", "integer"}},
+		Response: &v1RostersResponse{},
+	},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/rosters", Name: "v1RosterCreate", Summary: "Add a roster of a teacher", Tag: "v1", Request: &v1RosterRequest{}, Response: &v1RosterResponse{}},
+	{Method: http.MethodPut, Pattern: "/api/v1/integrations/{integration_id}/rosters/{roster_id}", Name: "v1RosterUpdate", Summary: "Rename a roster or give it to another teacher", Tag: "v1", Request: &v1RosterRequest{}, Response: &v1RosterResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/rosters/{roster_id}", Name: "v1RosterDelete", Summary: "Archive a roster, its students are no longer tracked with its teacher", Tag: "v1", Response: &successResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/rosters/{roster_id}/students", Name: "v1EnrolmentsList", Summary: "List the students enrolled in a roster", Tag: "v1",
+		Query:    []apiQueryParam{{"tag", "Only students with the tag", "string"}},
+		Response: &v1EnrolmentsResponse{},
+	},
+	{Method: http.MethodPut, Pattern: "/api/v1/integrations/{integration_id}/rosters/{roster_id}/students/{friend_id}", Name: "v1Enrol", Summary: "Enrol a student, or replace the tags and notes of their enrolment", Tag: "v1", Request: &v1EnrolmentRequest{}, Response: &v1EnrolmentResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/rosters/{roster_id}/students/{friend_id}", Name: "v1Unenrol", Summary: "Remove a student from a roster", Tag: "v1", Response: &successResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/sessions", Name: "v1SessionsList", Summary: "Page through the status of every expected student at the classes that have been evaluated", Tag: "v1",
+		Query:    append(sessionQueryParams, apiQueryParam{"limit", "Sessions per page, 1000 by default and at most 10000", "integer"}, apiQueryParam{"offset", "Sessions to skip", "integer"}),
+		Response: &v1SessionsResponse{},
+	},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/sessions/export", Name: "v1SessionsExport", Summary: "The sessions as CSV, with the names of schedules, teachers and students", Tag: "v1",
+		Query: sessionQueryParams, ContentType: "text/csv",
+	},
+	{Method: http.MethodPut, Pattern: "/api/v1/integrations/{integration_id}/sessions/{session_id}/override", Name: "v1SessionOverride", Summary: "Set the status of a session by hand, like excusing a student", Tag: "v1", Request: &v1SessionOverrideRequest{}, Response: &v1SessionResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/sessions/{session_id}/override", Name: "v1SessionOverrideDelete", Summary: "Remove the override of a session, its evaluated status counts again", Tag: "v1", Response: &v1SessionResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/manual_attendance", Name: "v1ManualAttendanceList", Summary: "List attendance entered by hand in time order", Tag: "v1",
+		Query: []apiQueryParam{
+			{"teacher_id", "Only manual attendance with the teacher", "integer"},
+			{"friend_id", "Only manual attendance of the student, by ID", "integer"},
+			{"from", "RFC 3339 time manual attendance ends after", "string"},
+			{"to", "RFC 3339 time manual attendance starts before", "string"},
+		},
+		Response: &v1ManualAttendancesResponse{},
+	},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/manual_attendance", Name: "v1ManualAttendanceCreate", Summary: "Enter attendance of a student the tracker couldn't see", Tag: "v1", Request: &v1ManualAttendanceRequest{}, Response: &v1ManualAttendanceResponse{}},
+	{Method: http.MethodPut, Pattern: "/api/v1/integrations/{integration_id}/manual_attendance/{entry_id}", Name: "v1ManualAttendanceUpdate", Summary: "Replace attendance entered by hand", Tag: "v1", Request: &v1ManualAttendanceRequest{}, Response: &v1ManualAttendanceResponse{}},
+	{Method: http.MethodDelete, Pattern: "/api/v1/integrations/{integration_id}/manual_attendance/{entry_id}", Name: "v1ManualAttendanceDelete", Summary: "Stop attendance entered by hand counting", Tag: "v1", Response: &successResponse{}},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/audit", Name: "v1Audit", Summary: "The newest changes to attendance made by hand", Tag: "v1",
+		Query: []apiQueryParam{
+			{"action", "Only changes of the action", "string"},
+			{"subject_id", "Only changes of the manual attendance or session, by ID", "integer"},
+			{"limit", "Changes to return, 50 by default and at most 500", "integer"},
+		},
+		Response: &v1AuditResponse{},
+	},
+	{
+		Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/coverage", Name: "v1Coverage", Summary: "Times the tracker missed recording attendance", Tag: "v1",
+		Query: []apiQueryParam{
+			{"from", "RFC 3339 time to report from, a day ago by default", "string"},
+			{"to", "RFC 3339 time to report until, now by default", "string"},
+		},
+		Response: &v1CoverageResponse{},
+	},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/reprocess_jobs", Name: "v1ReprocessJobsList", Summary: "List the newest jobs reprocessing attendance history", Tag: "v1", Response: &v1ReprocessJobsResponse{}},
+	{Method: http.MethodPost, Pattern: "/api/v1/integrations/{integration_id}/reprocess_jobs", Name: "v1ReprocessJobCreate", Summary: "Queue a job evaluating the classes of a period again with the rules of now", Tag: "v1", Request: &v1ReprocessRequest{}, Response: &v1ReprocessJobResponse{}},
+	{Method: http.MethodGet, Pattern: "/api/v1/integrations/{integration_id}/reprocess_jobs/{job_id}", Name: "v1ReprocessJob", Summary: "Show a reprocess job with its progress", Tag: "v1", Response: &v1ReprocessJobResponse{}},
+
+	{Method: http.MethodGet, Pattern: "/api/metrics", Name: "metrics", Summary: "Prometheus metrics", Tag: "meta", Public: true, ContentType: "text/plain"},
+	{Method: http.MethodGet, Pattern: "/api/openapi.json", Name: "openAPI", Summary: "This document", Tag: "meta", Public: true, ContentType: "application/json"},
+}
+
+// pathParamSchemas of the path parameters that are not IDs parsed with urlParamID
+var pathParamSchemas = map[string]*openAPISchema{
+	"blob_id":   {Type: "string"},
+	"vrchat_id": {Type: "string", Description: "VRChat user ID"},
+}
+
+var pathParamPattern = regexp.MustCompile(`{([^}]+)}`)
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+	Security    []map[string][]string       `json:"security,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref         string                    `json:"$ref,omitempty"`
+	Type        string                    `json:"type,omitempty"`
+	Format      string                    `json:"format,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Nullable    bool                      `json:"nullable,omitempty"`
+	Enum        []string                  `json:"enum,omitempty"`
+	MinLength   int                       `json:"minLength,omitempty"`
+	MinItems    int                       `json:"minItems,omitempty"`
+	MaxLength   int                       `json:"maxLength,omitempty"`
+	Pattern     string                    `json:"pattern,omitempty"`
+	Minimum     *int64                    `json:"minimum,omitempty"`
+	Maximum     *int64                    `json:"maximum,omitempty"`
+	Items       *openAPISchema            `json:"items,omitempty"`
+	Properties  map[string]*openAPISchema `json:"properties,omitempty"`
+	Required    []string                  `json:"required,omitempty"`
+}
+
+// knownSchemas are types that marshal differently from their Go structure
+var knownSchemas = map[reflect.Type]*openAPISchema{
+	reflect.TypeOf(time.Time{}):       {Type: "string", Format: "date-time"},
+	reflect.TypeOf(null.Time{}):       {Type: "string", Format: "date-time", Nullable: true},
+	reflect.TypeOf(null.String{}):     {Type: "string", Nullable: true},
+	reflect.TypeOf(null.Int64{}):      {Type: "integer", Format: "int64", Nullable: true},
+	reflect.TypeOf(null.Int{}):        {Type: "integer", Nullable: true},
+	reflect.TypeOf(null.Bool{}):       {Type: "boolean", Nullable: true},
+	reflect.TypeOf([]byte{}):          {Type: "string", Format: "byte"},
+	reflect.TypeOf(json.RawMessage{}): {Type: "object"},
+	reflect.TypeOf(ErrorCode("")):     {Type: "string", Enum: errorCodeNames()},
+}
+
+func errorCodeNames() []string {
+	names := []string{}
+	for _, code := range errorCodes {
+		names = append(names, string(code))
+	}
+	return names
+}
+
+// openAPISpec describes the routes in apiRoutes as an OpenAPI 3 document
+func openAPISpec() *openAPIDocument {
+	schemas := map[string]*openAPISchema{}
+	doc := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title: "Accumulator",
+			Description: "Attendance of VRChat classes. Authenticate with the jwt cookie set by sign in, or with a JWT from /api/auth/jwt in the Authorization header. " +
+				"Routes under /api/v1 are the stable API for external integrations, the other routes serve the UI and may change. " +
+				"Ask for a version of /api/v1 with Accept: application/vnd.accumulator.v1+json, responses name their version in the API-Version header.",
+			Version: "0.0.1",
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Schemas: schemas,
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"cookieAuth": {Type: "apiKey", In: "cookie", Name: "jwt"},
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	errorResponse := &openAPIResponse{
+		Description: "Error, see the code",
+		Content:     map[string]*openAPIMediaType{"application/json": {schemaOf(reflect.TypeOf(ErrorResponse{}), schemas)}},
+	}
+
+	for _, route := range apiRoutes {
+		op := &openAPIOperation{
+			OperationID: route.Name,
+			Summary:     route.Summary,
+			Tags:        []string{route.Tag},
+			Responses:   map[string]*openAPIResponse{"default": errorResponse},
+		}
+		if route.Successor != "" {
+			op.Deprecated = true
+			op.Description = fmt.Sprintf("Kept for the UI. External integrations should use %s, calls authenticated with the Authorization header get Deprecation and Link headers pointing to it.", route.Successor)
+		}
+		if !route.Public {
+			op.Security = []map[string][]string{{"cookieAuth": {}}, {"bearerAuth": {}}}
+		}
+		for _, match := range pathParamPattern.FindAllStringSubmatch(route.Pattern, -1) {
+			schema, ok := pathParamSchemas[match[1]]
+			if !ok {
+				schema = &openAPISchema{Type: "integer", Format: "int64"}
+			}
+			op.Parameters = append(op.Parameters, &openAPIParameter{Name: match[1], In: "path", Required: true, Schema: schema})
+		}
+		for _, q := range route.Query {
+			op.Parameters = append(op.Parameters, &openAPIParameter{Name: q.Name, In: "query", Description: q.Description, Schema: &openAPISchema{Type: q.Type}})
+		}
+		if route.Request != nil {
+			op.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  map[string]*openAPIMediaType{"application/json": {schemaOf(reflect.TypeOf(route.Request), schemas)}},
+			}
+		}
+		ok := &openAPIResponse{Description: "OK"}
+		switch {
+		case route.Response != nil:
+			ok.Content = map[string]*openAPIMediaType{"application/json": {schemaOf(reflect.TypeOf(route.Response), schemas)}}
+		case route.ContentType != "":
+			ok.Content = map[string]*openAPIMediaType{route.ContentType: {&openAPISchema{Type: "string"}}}
+		}
+		op.Responses["200"] = ok
+
+		if doc.Paths[route.Pattern] == nil {
+			doc.Paths[route.Pattern] = map[string]*openAPIOperation{}
+		}
+		doc.Paths[route.Pattern][strings.ToLower(route.Method)] = op
+	}
+	return doc
+}
+
+// schemaOf derives the schema of a type from how encoding/json marshals it.
+// Named structs are added to schemas and referenced.
+func schemaOf(t reflect.Type, schemas map[string]*openAPISchema) *openAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if schema, ok := knownSchemas[t]; ok {
+		return schema
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &openAPISchema{Type: "number"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &openAPISchema{Type: "array", Items: schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return &openAPISchema{Type: "object"}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return structSchema(t, schemas)
+		}
+		name := strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+		if _, ok := schemas[name]; !ok {
+			// placeholder for types referring to themselves
+			schemas[name] = &openAPISchema{}
+			schemas[name] = structSchema(t, schemas)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + name}
+	}
+	return &openAPISchema{}
+}
+
+func structSchema(t reflect.Type, schemas map[string]*openAPISchema) *openAPISchema {
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, omitempty, skip := jsonField(f)
+		if skip {
+			continue
+		}
+		schema.Properties[name] = withRules(schemaOf(f.Type, schemas), f.Tag.Get("validate"))
+		if !omitempty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// jsonField is the name encoding/json uses for the field, and whether it is left out
+func jsonField(f reflect.StructField) (name string, omitempty bool, skip bool) {
+	tag := f.Tag.Get("json")
+	if f.PkgPath != "" || tag == "-" {
+		return "", false, true
+	}
+	name, opts := tag, ""
+	if i := strings.Index(tag, ","); i >= 0 {
+		name, opts = tag[:i], tag[i:]
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, strings.Contains(opts, ",omitempty"), false
+}
+
+// withRules adds the constraints of the validate tag checked by decodeJSON to a copy of the schema
+func withRules(schema *openAPISchema, tag string) *openAPISchema {
+	if tag == "" {
+		return schema
+	}
+	s := *schema
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg := rule, ""
+		if i := strings.Index(rule, "="); i >= 0 {
+			name, arg = rule[:i], rule[i+1:]
+		}
+		n, _ := strconv.ParseInt(arg, 10, 64)
+		switch {
+		case name == "required" && s.Type == "string":
+			s.MinLength = 1
+		case name == "required" && s.Type == "array":
+			s.MinItems = 1
+		case name == "email":
+			s.Format = "email"
+		case name == "oneof" && s.Type == "array":
+			items := *s.Items
+			items.Enum = strings.Fields(arg)
+			s.Items = &items
+		case name == "oneof":
+			s.Enum = strings.Fields(arg)
+		case name == "url":
+			s.Format = "uri"
+		case name == "timeofday":
+			s.Pattern = timeOfDayPattern
+		case name == "timezone":
+			s.Format = "time-zone"
+		case name == "min" && s.Type == "string":
+			s.MinLength = int(n)
+		case name == "max" && s.Type == "string":
+			s.MaxLength = int(n)
+		case name == "min":
+			s.Minimum = &n
+		case name == "max":
+			s.Maximum = &n
+		}
+	}
+	return &s
+}
+
+func (c *API) openAPIHandler(spec *openAPIDocument) func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request) (interface{}, int, error) {
+		return spec, http.StatusOK, nil
+	}
+	return fn
+}
@@ -0,0 +1,59 @@
+package accumulator
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+// TestRoutesDocumented walks the router and compares it with apiRoutes, so routes can't be added without describing them
+func TestRoutesDocumented(t *testing.T) {
+	c := &API{zap.NewNop().Sugar(), nil, 5 * time.Minute}
+	r := c.router(NewAuther("secret"), nil, nil)
+
+	documented := map[string]bool{}
+	for _, route := range apiRoutes {
+		documented[route.Method+" "+route.Pattern] = true
+	}
+	undocumented := []string{}
+	err := chi.Walk(r, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		// chi v4 keeps the wildcard of the subrouter mounted by Route in the pattern
+		key := method + " " + strings.Replace(route, "/*/", "/", -1)
+		if !documented[key] {
+			undocumented = append(undocumented, key)
+		}
+		delete(documented, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing := []string{}
+	for key := range documented {
+		missing = append(missing, key)
+	}
+	sort.Strings(missing)
+	for _, key := range undocumented {
+		t.Errorf("route %s is not in apiRoutes", key)
+	}
+	for _, key := range missing {
+		t.Errorf("apiRoutes has %s, which is not registered", key)
+	}
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	spec := openAPISpec()
+	for _, route := range apiRoutes {
+		if route.Summary == "" {
+			t.Errorf("%s %s has no summary", route.Method, route.Pattern)
+		}
+	}
+	if len(spec.Paths) == 0 {
+		t.Error("spec has no paths")
+	}
+}
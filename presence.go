@@ -0,0 +1,119 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"net/http"
+	"time"
+
+	"github.com/volatiletech/null"
+)
+
+// Presence statuses of friends, from the location VRChat reports
+const (
+	presenceOnline    = "online"
+	presenceOffline   = "offline"
+	presencePrivate   = "private"
+	presenceTraveling = "traveling"
+	// presenceUnknown friends were not in VRChat's friend list, usually because they unfriended the integration
+	presenceUnknown = "unknown"
+)
+
+// v1Presence is who was where at the last tick of the tracker. ObservedAt is null before the first tick.
+type v1Presence struct {
+	ObservedAt null.Time `json:"observed_at"`
+	// Teachers with the students in their instance
+	Teachers []*v1TeacherPresence `json:"teachers"`
+	// StudentsElsewhere are online, but not with any teacher
+	StudentsElsewhere []*v1FriendPresence `json:"students_elsewhere"`
+}
+
+type v1TeacherPresence struct {
+	Teacher *v1Friend `json:"teacher"`
+	// Status is online, offline, private, traveling or unknown
+	Status   string          `json:"status"`
+	World    v1WorldPresence `json:"world"`
+	Students []*v1Friend     `json:"students"`
+}
+
+type v1FriendPresence struct {
+	Friend *v1Friend       `json:"friend"`
+	Status string          `json:"status"`
+	World  v1WorldPresence `json:"world"`
+}
+
+// v1WorldPresence is the instance a friend is in, empty unless they are online.
+// WorldName is empty until the world has been looked up from VRChat.
+type v1WorldPresence struct {
+	Location   string `json:"location"`
+	WorldID    string `json:"world_id"`
+	WorldName  string `json:"world_name"`
+	InstanceID string `json:"instance_id"`
+	Region     string `json:"region"`
+	AccessType string `json:"access_type"`
+	OwnerID    string `json:"owner_id"`
+}
+
+type v1PresenceResponse struct {
+	Data *v1Presence `json:"data"`
+}
+
+func worldPresence(l Location, worldNames map[string]string) v1WorldPresence {
+	if !l.IsInstance() {
+		return v1WorldPresence{}
+	}
+	return v1WorldPresence{l.Raw, l.WorldID, worldNames[l.WorldID], l.InstanceID, l.Region, l.AccessType, l.OwnerID}
+}
+
+// newPresence from what a tick saw. Students are with a teacher when they are in the same instance, as in the attendance.
+func newPresence(seen *observation, at time.Time) *v1Presence {
+	result := &v1Presence{
+		ObservedAt:        null.TimeFrom(at.UTC()),
+		Teachers:          []*v1TeacherPresence{},
+		StudentsElsewhere: []*v1FriendPresence{},
+	}
+	inClass := map[int64]bool{}
+	for _, friend := range sortedFriends(seen.friends) {
+		if !friend.IsTeacher {
+			continue
+		}
+		location := seen.locations[friend.ID]
+		teacher := &v1TeacherPresence{
+			Teacher:  toV1Friend(friend),
+			Status:   location.Status(),
+			World:    worldPresence(location, seen.worldNames),
+			Students: []*v1Friend{},
+		}
+		if class := seen.classes[friend.ID]; class != nil {
+			for _, student := range sortedFriends(class.students) {
+				teacher.Students = append(teacher.Students, toV1Friend(student))
+				inClass[student.ID] = true
+			}
+		}
+		result.Teachers = append(result.Teachers, teacher)
+	}
+	for _, friend := range sortedFriends(seen.friends) {
+		location := seen.locations[friend.ID]
+		status := location.Status()
+		if friend.IsTeacher || inClass[friend.ID] || status == presenceOffline || status == presenceUnknown {
+			continue
+		}
+		result.StudentsElsewhere = append(result.StudentsElsewhere, &v1FriendPresence{toV1Friend(friend), status, worldPresence(location, seen.worldNames)})
+	}
+	return result
+}
+
+// v1PresenceHandler answers from the snapshot kept by the tracker, without asking VRChat
+func (c *API) v1PresenceHandler(hub *Hub) func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request, u *db.User) (interface{}, int, error) {
+		integration, err := ownedIntegration(r, u)
+		if err != nil {
+			return nil, http.StatusForbidden, err
+		}
+		presence := hub.presence(integration.ID)
+		if presence == nil {
+			presence = &v1Presence{Teachers: []*v1TeacherPresence{}, StudentsElsewhere: []*v1FriendPresence{}}
+		}
+		return &v1PresenceResponse{presence}, http.StatusOK, nil
+	}
+	return fn
+}
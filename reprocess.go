@@ -0,0 +1,467 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"go.uber.org/zap"
+)
+
+// Statuses of reprocess jobs
+const (
+	jobQueued    = "queued"
+	jobRunning   = "running"
+	jobSucceeded = "succeeded"
+	jobFailed    = "failed"
+)
+
+// maxReprocessRange is the longest period a job reprocesses
+const maxReprocessRange = 366 * 24 * time.Hour
+
+// reprocessPoll is how often queued jobs are looked for
+const reprocessPoll = 5 * time.Second
+
+// reprocessStale is how long a running job goes without progress before it is taken to have stopped with its process,
+// and queued again
+const reprocessStale = 10 * time.Minute
+
+// reprocessCounts of the sessions of a class that were added, had their status changed, and were deleted
+type reprocessCounts struct {
+	created int64
+	changed int64
+	deleted int64
+}
+
+// checkReprocess checks the period of a job: it ends after it starts, at most maxReprocessRange later, and doesn't start
+// in the future
+func checkReprocess(from, to time.Time) error {
+	invalid := []FieldError{}
+	switch {
+	case from.After(time.Now()):
+		invalid = append(invalid, FieldError{"from", "must not be in the future"})
+	case !to.After(from):
+		invalid = append(invalid, FieldError{"to", "must be after from"})
+	case to.Sub(from) > maxReprocessRange:
+		invalid = append(invalid, FieldError{"to", fmt.Sprintf("must be at most %d days after from", maxReprocessRange/(24*time.Hour))})
+	}
+	if len(invalid) > 0 {
+		return &ValidationError{invalid}
+	}
+	return nil
+}
+
+// QueueReprocess adds a job evaluating the classes of the integration that ended from until to again, asked for by the
+// user, or from the admin command when nil. An integration has one job queued or running at a time.
+func QueueReprocess(integrationID int64, u *db.User, from, to time.Time) (*db.ReprocessJob, error) {
+	err := checkReprocess(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if to.After(time.Now()) {
+		to = time.Now()
+	}
+	pending, err := db.ReprocessJobs(
+		db.ReprocessJobWhere.IntegrationID.EQ(integrationID),
+		qm.WhereIn(db.ReprocessJobColumns.Status+" IN ?", jobQueued, jobRunning),
+	).ExistsG()
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, errConflict("the integration already has a reprocess job queued or running", nil)
+	}
+	job := &db.ReprocessJob{
+		IntegrationID: integrationID,
+		PeriodStart:   from.UTC(),
+		PeriodEnd:     to.UTC(),
+		Status:        jobQueued,
+	}
+	if u != nil {
+		job.UserID = null.Int64From(u.ID)
+	}
+	err = job.InsertG(boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// RunReprocessJobs runs the queued reprocess jobs oldest first, until the context is done.
+// Jobs that stopped with the process running them are queued again, and started over.
+func RunReprocessJobs(ctx context.Context, stepMinutes int, log *zap.SugaredLogger) error {
+	log.Infow("start reprocess jobs", "poll_seconds", int(reprocessPoll/time.Second))
+	step := time.Duration(stepMinutes) * time.Minute
+	t := time.NewTicker(reprocessPoll)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			n, err := db.ReprocessJobs(
+				db.ReprocessJobWhere.Status.EQ(jobRunning),
+				db.ReprocessJobWhere.UpdatedAt.LT(time.Now().Add(-reprocessStale).UTC()),
+			).UpdateAllG(db.M{db.ReprocessJobColumns.Status: jobQueued})
+			if err != nil {
+				log.Errorw("queue stale reprocess jobs", "err", err)
+				continue
+			}
+			if n > 0 {
+				log.Infow("queued stale reprocess jobs again", "jobs", n)
+			}
+			job, err := db.ReprocessJobs(
+				db.ReprocessJobWhere.Status.EQ(jobQueued),
+				qm.OrderBy(db.ReprocessJobColumns.CreatedAt+", "+db.ReprocessJobColumns.ID),
+			).OneG()
+			if err != nil {
+				if err != sql.ErrNoRows {
+					log.Errorw("find queued reprocess job", "err", err)
+				}
+				continue
+			}
+			log.Infow("run reprocess job", "job_id", job.ID, "integration_id", job.IntegrationID)
+			err = RunReprocessJob(ctx, job, step, nil)
+			if err != nil {
+				log.Errorw("reprocess job", "job_id", job.ID, "integration_id", job.IntegrationID, "err", err)
+				continue
+			}
+			log.Infow("finished reprocess job", "job_id", job.ID, "status", job.Status, "classes", job.ProcessedClasses,
+				"created", job.SessionsCreated, "changed", job.SessionsChanged, "deleted", job.SessionsDeleted)
+		}
+	}
+}
+
+// RunReprocessJob claims the queued job and evaluates the classes of its integration's schedules that ended in its period
+// again, with the rules and students expected now, calling progress after every class when not nil.
+// Only errors recording the job are returned, a job that fails is marked failed. A job stopped by the context is queued
+// again. It can be run any number of times and while the tracker is running: every class is evaluated in a transaction,
+// classes that haven't ended are left to the tracker, and overrides are kept.
+// The attendance of the period is rebuilt first from where the tracker saw each friend, so promoting a teacher or
+// enrolling a student counts the classes they were already in.
+func RunReprocessJob(ctx context.Context, job *db.ReprocessJob, step time.Duration, progress func(*db.ReprocessJob)) error {
+	now := time.Now().UTC()
+	claimed, err := db.ReprocessJobs(
+		db.ReprocessJobWhere.ID.EQ(job.ID),
+		db.ReprocessJobWhere.Status.EQ(jobQueued),
+	).UpdateAllG(db.M{
+		db.ReprocessJobColumns.Status:           jobRunning,
+		db.ReprocessJobColumns.TotalClasses:     0,
+		db.ReprocessJobColumns.ProcessedClasses: 0,
+		db.ReprocessJobColumns.SessionsCreated:  0,
+		db.ReprocessJobColumns.SessionsChanged:  0,
+		db.ReprocessJobColumns.SessionsDeleted:  0,
+		db.ReprocessJobColumns.ErrorMessage:     null.String{},
+		db.ReprocessJobColumns.StartedAt:        null.TimeFrom(now),
+		db.ReprocessJobColumns.FinishedAt:       null.Time{},
+		db.ReprocessJobColumns.UpdatedAt:        now,
+	})
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return errConflict("the job isn't queued", nil)
+	}
+	err = job.ReloadG()
+	if err != nil {
+		return err
+	}
+	err = reprocess(ctx, job, step, progress)
+	switch {
+	case ctx.Err() != nil:
+		job.Status = jobQueued
+	case err != nil:
+		job.Status = jobFailed
+		job.ErrorMessage = null.StringFrom(err.Error())
+		job.FinishedAt = null.TimeFrom(time.Now().UTC())
+	default:
+		job.Status = jobSucceeded
+		job.FinishedAt = null.TimeFrom(time.Now().UTC())
+	}
+	_, err = job.UpdateG(boil.Infer())
+	if err != nil {
+		return err
+	}
+	if progress != nil {
+		progress(job)
+	}
+	return nil
+}
+
+// reprocess the classes of the job, recording its progress
+func reprocess(ctx context.Context, job *db.ReprocessJob, step time.Duration, progress func(*db.ReprocessJob)) error {
+	integration, err := db.FindIntegrationG(job.IntegrationID)
+	if err != nil {
+		return err
+	}
+	schedules, err := db.Schedules(
+		db.ScheduleWhere.IntegrationID.EQ(integration.ID),
+		db.ScheduleWhere.Archived.EQ(false),
+		qm.OrderBy(db.ScheduleColumns.ID),
+	).AllG()
+	if err != nil {
+		return err
+	}
+	from, to := job.PeriodStart, job.PeriodEnd
+	if now := time.Now(); to.After(now) {
+		to = now
+	}
+	classes := map[int64][]*occurrence{}
+	for _, s := range schedules {
+		found, err := occurrences(s, from, to)
+		if err != nil {
+			return err
+		}
+		for _, o := range found {
+			if !o.end.After(to) {
+				classes[s.ID] = append(classes[s.ID], o)
+			}
+		}
+		job.TotalClasses += int64(len(classes[s.ID]))
+	}
+	err = updateJobProgress(job, progress)
+	if err != nil {
+		return err
+	}
+
+	// classes that ended in the period may have started before it
+	rebuildFrom := from
+	for _, found := range classes {
+		for _, o := range found {
+			if o.start.Before(rebuildFrom) {
+				rebuildFrom = o.start
+			}
+		}
+	}
+	err = rebuildAttendance(ctx, integration, rebuildFrom, to)
+	if err != nil {
+		return fmt.Errorf("rebuild attendance: %w", err)
+	}
+
+	for _, s := range schedules {
+		students, err := expectedStudents(s)
+		if err != nil {
+			return err
+		}
+		p := policyOf(integration, s)
+		for _, o := range classes[s.ID] {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			counts, err := reprocessClass(s, o, students, p, step)
+			if isConstraintViolation(err) {
+				// the tracker evaluated the class at the same time, its sessions are there now
+				counts, err = reprocessClass(s, o, students, p, step)
+			}
+			if err != nil {
+				return err
+			}
+			job.ProcessedClasses++
+			job.SessionsCreated += counts.created
+			job.SessionsChanged += counts.changed
+			job.SessionsDeleted += counts.deleted
+			err = updateJobProgress(job, progress)
+			if err != nil {
+				return err
+			}
+		}
+		deleted, err := deleteMovedSessions(s, classes[s.ID], from, to)
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			job.SessionsDeleted += deleted
+			err = updateJobProgress(job, progress)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildAttendance of the integration from until to from the location samples of its friends, with its teachers,
+// rosters and schedules as they are now. Ticks from before location samples were stored keep the attendance they have.
+func rebuildAttendance(ctx context.Context, integration *db.Integration, from, to time.Time) error {
+	rules, err := loadTrackingRules(integration)
+	if err != nil {
+		return err
+	}
+	for start := from; start.Before(to); start = start.Add(24 * time.Hour) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := start.Add(24 * time.Hour)
+		if end.After(to) {
+			end = to
+		}
+		err = rebuildTicks(rules, start, end)
+		if isConstraintViolation(err) {
+			// the tracker recorded a tick at the same time, its attendance is there now
+			err = rebuildTicks(rules, start, end)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildTicks replaces the attendance of the ticks from until to that have location samples in one transaction
+func rebuildTicks(rules *trackingRules, from, to time.Time) error {
+	integrationID := rules.integration.ID
+	samples, err := db.LocationSamples(
+		db.LocationSampleWhere.IntegrationID.EQ(integrationID),
+		db.LocationSampleWhere.Timestamp.GTE(from.Unix()),
+		db.LocationSampleWhere.Timestamp.LT(to.Unix()),
+		qm.OrderBy(db.LocationSampleColumns.Timestamp),
+	).AllG()
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+	ticks := []int64{}
+	locations := map[int64]map[int64]Location{}
+	for _, sample := range samples {
+		if locations[sample.Timestamp] == nil {
+			ticks = append(ticks, sample.Timestamp)
+			locations[sample.Timestamp] = map[int64]Location{}
+		}
+		locations[sample.Timestamp][sample.FriendID] = ParseLocation(sample.Location)
+	}
+
+	tx, err := beginTx()
+	if err != nil {
+		return err
+	}
+	_, err = db.Attendances(
+		db.AttendanceWhere.IntegrationID.EQ(null.Int64From(integrationID)),
+		db.AttendanceWhere.Timestamp.GTE(from.Unix()),
+		db.AttendanceWhere.Timestamp.LT(to.Unix()),
+		qm.Where(`"timestamp" IN (SELECT "timestamp" FROM location_samples WHERE integration_id = ?)`, integrationID),
+	).DeleteAll(tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	for _, at := range ticks {
+		_, attendance, err := rules.classes(time.Unix(at, 0), locations[at])
+		if err != nil {
+			return rollback(tx, err)
+		}
+		// the samples are there already
+		err = recordTick(tx, integrationID, time.Unix(at, 0), nil, attendance)
+		if err != nil {
+			return rollback(tx, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func updateJobProgress(job *db.ReprocessJob, progress func(*db.ReprocessJob)) error {
+	_, err := job.UpdateG(boil.Whitelist(
+		db.ReprocessJobColumns.TotalClasses,
+		db.ReprocessJobColumns.ProcessedClasses,
+		db.ReprocessJobColumns.SessionsCreated,
+		db.ReprocessJobColumns.SessionsChanged,
+		db.ReprocessJobColumns.SessionsDeleted,
+		db.ReprocessJobColumns.UpdatedAt,
+	))
+	if err != nil {
+		return err
+	}
+	if progress != nil {
+		progress(job)
+	}
+	return nil
+}
+
+// reprocessClass evaluates the sessions of the class again in one transaction. Expected students without a session get
+// one, and sessions of students no longer expected are deleted unless a user overrode them.
+func reprocessClass(s *db.Schedule, o *occurrence, students db.FriendSlice, p attendancePolicy, step time.Duration) (reprocessCounts, error) {
+	counts := reprocessCounts{}
+	present, err := classPresence(s, o)
+	if err != nil {
+		return counts, err
+	}
+	missed, err := classGapMinutes(s.IntegrationID, o, step)
+	if err != nil {
+		return counts, err
+	}
+	tx, err := beginTx()
+	if err != nil {
+		return counts, err
+	}
+	existing, err := db.ClassSessions(
+		db.ClassSessionWhere.ScheduleID.EQ(s.ID),
+		db.ClassSessionWhere.StartsAt.EQ(o.start.UTC()),
+	).All(tx)
+	if err != nil {
+		return counts, rollback(tx, err)
+	}
+	sessions := map[int64]*db.ClassSession{}
+	for _, session := range existing {
+		sessions[session.FriendID] = session
+	}
+	expected := map[int64]bool{}
+	for _, student := range students {
+		expected[student.ID] = true
+		if sessions[student.ID] != nil {
+			continue
+		}
+		err = newClassSession(s, o, student, present[student.ID], p, step, missed).Insert(tx, boil.Infer())
+		if err != nil {
+			return counts, rollback(tx, err)
+		}
+		counts.created++
+	}
+	for _, session := range existing {
+		if !expected[session.FriendID] && !session.OverrideStatus.Valid {
+			_, err = session.Delete(tx)
+			if err != nil {
+				return counts, rollback(tx, err)
+			}
+			counts.deleted++
+			continue
+		}
+		before := session.Status
+		setEvaluation(session, o, present[session.FriendID], p, step, missed)
+		session.TeacherID = s.TeacherID
+		// the class may end at a different time now
+		session.StartsAt = o.start.UTC()
+		session.EndsAt = o.end.UTC()
+		_, err = session.Update(tx, boil.Infer())
+		if err != nil {
+			return counts, rollback(tx, err)
+		}
+		if session.Status != before {
+			counts.changed++
+		}
+	}
+	return counts, tx.Commit()
+}
+
+// deleteMovedSessions of the schedule that ended from until to at a time it no longer has a class, because its days or
+// times changed. Sessions a user overrode are kept.
+func deleteMovedSessions(s *db.Schedule, classes []*occurrence, from, to time.Time) (int64, error) {
+	starts := []interface{}{}
+	for _, o := range classes {
+		starts = append(starts, o.start.UTC())
+	}
+	mods := []qm.QueryMod{
+		db.ClassSessionWhere.ScheduleID.EQ(s.ID),
+		db.ClassSessionWhere.EndsAt.GT(from.UTC()),
+		db.ClassSessionWhere.EndsAt.LTE(to.UTC()),
+		db.ClassSessionWhere.OverrideStatus.IsNull(),
+	}
+	if len(starts) > 0 {
+		mods = append(mods, qm.WhereIn(db.ClassSessionColumns.StartsAt+" NOT IN ?", starts...))
+	}
+	return db.ClassSessions(mods...).DeleteAll(boil.GetDB())
+}
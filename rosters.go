@@ -0,0 +1,187 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"database/sql"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// maxTagLength is the longest tag of an enrolment
+const maxTagLength = 64
+
+// enrolled are the students of the integration's rosters, by teacher ID and then student ID
+type enrolled map[int64]map[int64]bool
+
+// any teacher has the student in one of their rosters
+func (e enrolled) any(studentID int64) bool {
+	for _, students := range e {
+		if students[studentID] {
+			return true
+		}
+	}
+	return false
+}
+
+// enrolledStudents of the integration's rosters that aren't archived
+func enrolledStudents(integrationID int64) (enrolled, error) {
+	rosters, err := db.Rosters(
+		db.RosterWhere.IntegrationID.EQ(integrationID),
+		db.RosterWhere.Archived.EQ(false),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+	teachers := map[int64]int64{}
+	for _, roster := range rosters {
+		teachers[roster.ID] = roster.TeacherID
+	}
+	enrolments, err := db.Enrolments(
+		qm.Where("roster_id IN (SELECT id FROM rosters WHERE integration_id = ? AND archived = ?)", integrationID, false),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+	result := enrolled{}
+	for _, enrolment := range enrolments {
+		teacherID := teachers[enrolment.RosterID]
+		if result[teacherID] == nil {
+			result[teacherID] = map[int64]bool{}
+		}
+		result[teacherID][enrolment.FriendID] = true
+	}
+	return result, nil
+}
+
+// enrolledAttendance limits attendance to samples of students enrolled in one of the sample teacher's rosters
+func enrolledAttendance() qm.QueryMod {
+	return qm.Where(`EXISTS (SELECT 1 FROM enrolments JOIN rosters ON rosters.id = enrolments.roster_id
+		WHERE enrolments.friend_id = attendance.friend_id AND rosters.teacher_id = attendance.teacher_id AND rosters.archived = ?)`, false)
+}
+
+// rosterAttendance limits attendance to the classes of the roster's teacher and the students enrolled in it
+func rosterAttendance(roster *db.Roster) qm.QueryMod {
+	return qm.Where("attendance.teacher_id = ? AND attendance.friend_id IN (SELECT friend_id FROM enrolments WHERE roster_id = ?)", roster.TeacherID, roster.ID)
+}
+
+// expectedStudents at the classes of a schedule: those enrolled in its roster, or in any of the teacher's rosters when it has none
+func expectedStudents(s *db.Schedule) (db.FriendSlice, error) {
+	subquery := "SELECT enrolments.friend_id FROM enrolments JOIN rosters ON rosters.id = enrolments.roster_id WHERE rosters.teacher_id = ? AND rosters.archived = ?"
+	args := []interface{}{s.TeacherID, false}
+	if s.RosterID.Valid {
+		subquery, args = "SELECT friend_id FROM enrolments WHERE roster_id = ?", []interface{}{s.RosterID.Int64}
+	}
+	return db.Friends(
+		db.FriendWhere.IntegrationID.EQ(s.IntegrationID),
+		db.FriendWhere.IsTeacher.EQ(false),
+		db.FriendWhere.Archived.EQ(false),
+		qm.Where("friends.id IN ("+subquery+")", args...),
+		qm.OrderBy(db.FriendColumns.ID),
+	).AllG()
+}
+
+// isTeacherOf is true when the friend is a teacher of the integration that isn't archived
+func isTeacherOf(integrationID int64, friendID int64) (bool, error) {
+	teacher, err := db.Friends(
+		db.FriendWhere.ID.EQ(friendID),
+		db.FriendWhere.IntegrationID.EQ(integrationID),
+		db.FriendWhere.Archived.EQ(false),
+	).OneG()
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return teacher.IsTeacher, nil
+}
+
+// checkRoster checks the teacher of a roster request is a teacher of the integration
+func checkRoster(integrationID int64, req *v1RosterRequest) error {
+	ok, err := isTeacherOf(integrationID, req.TeacherID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ValidationError{[]FieldError{{"teacher_id", "must be a teacher of the integration"}}}
+	}
+	return nil
+}
+
+// ArchiveRoster stops its students being tracked with its teacher, unless they are in another of the teacher's rosters.
+// Its enrolments are kept.
+func ArchiveRoster(roster *db.Roster) error {
+	roster.Archived = true
+	roster.ArchivedAt = null.TimeFrom(time.Now())
+	_, err := roster.UpdateG(boil.Whitelist(db.RosterColumns.Archived, db.RosterColumns.ArchivedAt, db.RosterColumns.UpdatedAt))
+	return err
+}
+
+// enrolmentTags without repeats, empty tags or surrounding space
+func enrolmentTags(tags []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func hasTag(enrolment *db.Enrolment, tag string) bool {
+	for _, t := range strings.Split(enrolment.Tags, ",") {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkEnrolment checks the tags of an enrolment request, which are stored comma separated
+func checkEnrolment(req *v1EnrolmentRequest) error {
+	for _, tag := range req.Tags {
+		msg := checkRules(reflect.ValueOf(tag), []string{"max=" + strconv.Itoa(maxTagLength)})
+		if msg == "" && strings.Contains(tag, ",") {
+			msg = "must not contain commas"
+		}
+		if msg != "" {
+			return &ValidationError{[]FieldError{{"tags", msg}}}
+		}
+	}
+	return nil
+}
+
+// Enrol the student in the roster, or set the tags and notes of their enrolment
+func Enrol(roster *db.Roster, student *db.Friend, tags []string, notes string) (*db.Enrolment, error) {
+	enrolment, err := db.Enrolments(
+		db.EnrolmentWhere.RosterID.EQ(roster.ID),
+		db.EnrolmentWhere.FriendID.EQ(student.ID),
+	).OneG()
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if enrolment == nil {
+		enrolment = &db.Enrolment{RosterID: roster.ID, FriendID: student.ID}
+	}
+	enrolment.Tags = strings.Join(enrolmentTags(tags), ",")
+	enrolment.Notes = notes
+	if enrolment.ID == 0 {
+		err = enrolment.InsertG(boil.Infer())
+	} else {
+		_, err = enrolment.UpdateG(boil.Infer())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return enrolment, nil
+}
@@ -0,0 +1,196 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// s3Stub is an in-memory bucket answering the requests S3BlobStore makes
+type s3Stub struct {
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	// fail answers every request with a 500 when set
+	fail bool
+}
+
+func newS3Stub(t *testing.T, bucket string) (*s3Stub, *httptest.Server) {
+	stub := &s3Stub{bucket: bucket, objects: map[string][]byte{}}
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+	return stub, srv
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		http.Error(w, "<Error><Code>InternalError</Code></Error>", http.StatusInternalServerError)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		r.Header.Get("x-amz-date") == "" || r.Header.Get("x-amz-content-sha256") != s3UnsignedPayload {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/"+s.bucket+"/")
+	if key == r.URL.Path {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+	object, ok := s.objects[key]
+	switch r.Method {
+	case http.MethodPut:
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = b
+	case http.MethodGet:
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Write(object)
+	case http.MethodDelete:
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *s3Stub) object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.objects[key]
+	return b, ok
+}
+
+func TestS3BlobStore(t *testing.T) {
+	stub, srv := newS3Stub(t, "accumulator")
+	store := NewS3BlobStore(srv.URL+"/", "us-east-1", "accumulator", "access", "secret")
+
+	content := []byte("avatar bytes")
+	err := store.Put("key", bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	stored, ok := stub.object("key")
+	if !ok || !bytes.Equal(stored, content) {
+		t.Fatalf("got %q stored, want %q", stored, content)
+	}
+
+	rdr, err := store.Open("key")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	b, err := ioutil.ReadAll(rdr)
+	rdr.Close()
+	if err != nil || !bytes.Equal(b, content) {
+		t.Fatalf("got %q (%v) opening, want %q", b, err, content)
+	}
+
+	err = store.Delete("key")
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, ok := stub.object("key"); ok {
+		t.Error("object still stored after deleting it")
+	}
+	_, err = store.Open("key")
+	if err != ErrBlobNotFound {
+		t.Errorf("got %v opening a deleted object, want ErrBlobNotFound", err)
+	}
+	err = store.Delete("key")
+	if err != ErrBlobNotFound {
+		t.Errorf("got %v deleting a deleted object, want ErrBlobNotFound", err)
+	}
+
+	stub.mu.Lock()
+	stub.fail = true
+	stub.mu.Unlock()
+	err = store.Put("key", bytes.NewReader(content), int64(len(content)))
+	if err == nil || err == ErrBlobNotFound || !strings.Contains(err.Error(), "500") {
+		t.Errorf("got %v from a failing bucket, want the status", err)
+	}
+}
+
+func TestBlobStorageMigrate(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		stub, srv := newS3Stub(t, "accumulator")
+		blobs, err := NewBlobStorage(&BlobStoreConfig{
+			Backend:     blobBackendSQLite,
+			Path:        t.TempDir(),
+			S3Endpoint:  srv.URL,
+			S3Region:    "us-east-1",
+			S3Bucket:    "accumulator",
+			S3AccessKey: "access",
+			S3SecretKey: "secret",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents := map[string][]byte{}
+		for _, content := range [][]byte{[]byte("first"), []byte("second")} {
+			blob, err := blobs.Put(content, "text/plain", "txt")
+			if err != nil {
+				t.Fatal(err)
+			}
+			contents[blob.FileName] = content
+		}
+		log := zap.NewNop().Sugar()
+
+		// from SQLite to S3, then on to the filesystem
+		for _, backend := range []string{blobBackendS3, blobBackendFilesystem} {
+			moved, err := blobs.Migrate(backend, log)
+			if err != nil {
+				t.Fatalf("migrate to %s: %v", backend, err)
+			}
+			if moved != len(contents) {
+				t.Errorf("moved %d blobs to %s, want %d", moved, backend, len(contents))
+			}
+			for fileName, content := range contents {
+				blob, err := db.Blobs(db.BlobWhere.FileName.EQ(fileName)).OneG()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if blob.Backend != backend || len(blob.File) != 0 {
+					t.Errorf("blob %s is in %s with %d bytes in its row, want it in %s", fileName, blob.Backend, len(blob.File), backend)
+				}
+				stored, inBucket := stub.object(fileName)
+				if inBucket != (backend == blobBackendS3) || inBucket && !bytes.Equal(stored, content) {
+					t.Errorf("blob %s in the bucket %v with %q, after migrating to %s", fileName, inBucket, stored, backend)
+				}
+				rdr, err := blobs.Open(blob)
+				if err != nil {
+					t.Fatalf("open from %s: %v", backend, err)
+				}
+				b, err := ioutil.ReadAll(rdr)
+				rdr.Close()
+				if err != nil || !bytes.Equal(b, content) {
+					t.Errorf("got %q (%v) from %s, want %q", b, err, backend, content)
+				}
+			}
+		}
+
+		moved, err := blobs.Migrate(blobBackendFilesystem, log)
+		if err != nil || moved != 0 {
+			t.Errorf("moved %d blobs (%v) migrating again, want none", moved, err)
+		}
+	})
+}
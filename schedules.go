@@ -0,0 +1,172 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"strings"
+	"time"
+
+	// Schedules name IANA time zones, which minimal containers don't have
+	_ "time/tzdata"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// weekdayNames as stored in schedules, in the order of time.Weekday
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// maxOccurrenceRange is the longest period occurrences are reported for
+const maxOccurrenceRange = 92 * 24 * time.Hour
+
+// occurrence is one class of a schedule
+type occurrence struct {
+	schedule *db.Schedule
+	start    time.Time
+	end      time.Time
+}
+
+// scheduleWeekdays in week order starting on Monday, without repeats
+func scheduleWeekdays(weekdays []string) []string {
+	result := []string{}
+	for _, day := range append(weekdayNames[1:], weekdayNames[0]) {
+		for _, w := range weekdays {
+			if w == day {
+				result = append(result, day)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// clock is the hour and minute of a HH:MM time
+func clock(s string) (int, int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, err
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// occurrences of the schedule that overlap from until to, in time order.
+// Start and end are wall clock times in the schedule's time zone, so classes keep their local time when daylight saving changes.
+// A class ending at or before its start time ends the next day.
+func occurrences(s *db.Schedule, from, to time.Time) ([]*occurrence, error) {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	startHour, startMinute, err := clock(s.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endHour, endMinute, err := clock(s.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	days := map[string]bool{}
+	for _, day := range strings.Split(s.Weekdays, ",") {
+		days[day] = true
+	}
+	result := []*occurrence{}
+	y, m, d := from.In(loc).Date()
+	// from the day before, for a class that started then and is still going
+	for i := -1; ; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if !day.Before(to) {
+			break
+		}
+		if !days[weekdayNames[day.Weekday()]] {
+			continue
+		}
+		start := time.Date(y, m, d+i, startHour, startMinute, 0, 0, loc)
+		end := time.Date(y, m, d+i, endHour, endMinute, 0, 0, loc)
+		if !end.After(start) {
+			end = time.Date(y, m, d+i+1, endHour, endMinute, 0, 0, loc)
+		}
+		if end.After(from) && start.Before(to) {
+			result = append(result, &occurrence{s, start, end})
+		}
+	}
+	return result, nil
+}
+
+// inSchedule is true when the teacher is in a class of one of their schedules at t, in the schedule's world if it has one
+func inSchedule(schedules db.ScheduleSlice, teacherID int64, l Location, t time.Time) (bool, error) {
+	for _, s := range schedules {
+		if s.TeacherID != teacherID || s.WorldID.Valid && s.WorldID.String != l.WorldID {
+			continue
+		}
+		found, err := occurrences(s, t, t.Add(time.Nanosecond))
+		if err != nil {
+			return false, err
+		}
+		if len(found) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setSchedule sets the fields of the schedule from a request, which has been checked by checkSchedule
+func setSchedule(s *db.Schedule, req *v1ScheduleRequest) {
+	s.TeacherID = req.TeacherID
+	s.Name = req.Name
+	s.Weekdays = strings.Join(scheduleWeekdays(req.Weekdays), ",")
+	s.StartTime = req.StartTime
+	s.EndTime = req.EndTime
+	s.TimeZone = req.TimeZone
+	if s.TimeZone == "" {
+		s.TimeZone = "UTC"
+	}
+	s.WorldID = req.WorldID
+	s.RosterID = req.RosterID
+	s.MinPresencePercent = null.Int64FromPtr(req.MinPresencePercent)
+	s.LateAfterMinutes = null.Int64FromPtr(req.LateAfterMinutes)
+	s.LeftEarlyMinutes = null.Int64FromPtr(req.LeftEarlyMinutes)
+}
+
+// checkSchedule checks what the validate tags can't: the teacher is a teacher of the integration, the roster is theirs,
+// the world is a world and the class doesn't end when it starts
+func checkSchedule(integrationID int64, req *v1ScheduleRequest) error {
+	invalid := []FieldError{}
+	ok, err := isTeacherOf(integrationID, req.TeacherID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		invalid = append(invalid, FieldError{"teacher_id", "must be a teacher of the integration"})
+	}
+	if req.RosterID.Valid {
+		n, err := db.Rosters(
+			db.RosterWhere.ID.EQ(req.RosterID.Int64),
+			db.RosterWhere.IntegrationID.EQ(integrationID),
+			db.RosterWhere.TeacherID.EQ(req.TeacherID),
+			db.RosterWhere.Archived.EQ(false),
+		).CountG()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			invalid = append(invalid, FieldError{"roster_id", "must be a roster of the teacher"})
+		}
+	}
+	if req.WorldID.Valid && !strings.HasPrefix(req.WorldID.String, "wrld_") {
+		invalid = append(invalid, FieldError{"world_id", "must be a VRChat world ID"})
+	}
+	if req.StartTime == req.EndTime {
+		invalid = append(invalid, FieldError{"end_time", "must not be the same as start_time"})
+	}
+	if len(invalid) > 0 {
+		return &ValidationError{invalid}
+	}
+	return nil
+}
+
+// ArchiveSchedule stops the schedule counting, its past attendance is kept
+func ArchiveSchedule(s *db.Schedule) error {
+	s.Archived = true
+	s.ArchivedAt = null.TimeFrom(time.Now())
+	_, err := s.UpdateG(boil.Whitelist(db.ScheduleColumns.Archived, db.ScheduleColumns.ArchivedAt, db.ScheduleColumns.UpdatedAt))
+	return err
+}
@@ -0,0 +1,101 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"testing"
+	"time"
+)
+
+func TestOccurrences(t *testing.T) {
+	utc := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2020, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	for _, test := range []struct {
+		name     string
+		schedule *db.Schedule
+		from     time.Time
+		to       time.Time
+		want     [][2]time.Time
+	}{
+		{
+			"weekdays",
+			&db.Schedule{Weekdays: "mon,wed", StartTime: "18:00", EndTime: "19:00", TimeZone: "UTC"},
+			utc(4, 20, 0, 0), utc(4, 27, 0, 0),
+			[][2]time.Time{{utc(4, 20, 18, 0), utc(4, 20, 19, 0)}, {utc(4, 22, 18, 0), utc(4, 22, 19, 0)}},
+		},
+		{
+			"overlapping the period",
+			&db.Schedule{Weekdays: "mon,wed", StartTime: "18:00", EndTime: "19:00", TimeZone: "UTC"},
+			utc(4, 20, 18, 30), utc(4, 22, 18, 0),
+			[][2]time.Time{{utc(4, 20, 18, 0), utc(4, 20, 19, 0)}},
+		},
+		{
+			"keeps its local time over spring forward",
+			&db.Schedule{Weekdays: "mon", StartTime: "18:00", EndTime: "19:00", TimeZone: "America/New_York"},
+			utc(3, 2, 0, 0), utc(3, 17, 0, 0),
+			[][2]time.Time{{utc(3, 2, 23, 0), utc(3, 3, 0, 0)}, {utc(3, 9, 22, 0), utc(3, 9, 23, 0)}, {utc(3, 16, 22, 0), utc(3, 16, 23, 0)}},
+		},
+		{
+			"overnight over spring forward is an hour shorter",
+			&db.Schedule{Weekdays: "sat", StartTime: "23:00", EndTime: "03:00", TimeZone: "Europe/London"},
+			utc(3, 28, 0, 0), utc(3, 30, 0, 0),
+			[][2]time.Time{{utc(3, 28, 23, 0), utc(3, 29, 2, 0)}},
+		},
+		{
+			"overnight over fall back is an hour longer",
+			&db.Schedule{Weekdays: "sat", StartTime: "23:00", EndTime: "03:00", TimeZone: "Europe/London"},
+			utc(10, 24, 0, 0), utc(10, 26, 0, 0),
+			[][2]time.Time{{utc(10, 24, 22, 0), utc(10, 25, 3, 0)}},
+		},
+		{
+			"overnight ends the next day",
+			&db.Schedule{Weekdays: "fri", StartTime: "23:00", EndTime: "01:00", TimeZone: "Asia/Tokyo"},
+			utc(4, 24, 0, 0), utc(4, 25, 0, 0),
+			[][2]time.Time{{utc(4, 24, 14, 0), utc(4, 24, 16, 0)}},
+		},
+		{
+			"started the day before",
+			&db.Schedule{Weekdays: "fri", StartTime: "23:00", EndTime: "01:00", TimeZone: "Asia/Tokyo"},
+			utc(4, 24, 15, 30), utc(4, 24, 16, 30),
+			[][2]time.Time{{utc(4, 24, 14, 0), utc(4, 24, 16, 0)}},
+		},
+		{
+			"ending when it starts lasts a day",
+			&db.Schedule{Weekdays: "sun", StartTime: "12:00", EndTime: "12:00", TimeZone: "UTC"},
+			utc(4, 26, 0, 0), utc(4, 27, 0, 0),
+			[][2]time.Time{{utc(4, 26, 12, 0), utc(4, 27, 12, 0)}},
+		},
+		{
+			"ended at the start of the period",
+			&db.Schedule{Weekdays: "fri", StartTime: "23:00", EndTime: "01:00", TimeZone: "Asia/Tokyo"},
+			utc(4, 24, 16, 0), utc(4, 25, 0, 0),
+			[][2]time.Time{},
+		},
+		{
+			"starts at the end of the period",
+			&db.Schedule{Weekdays: "mon", StartTime: "18:00", EndTime: "19:00", TimeZone: "UTC"},
+			utc(4, 20, 0, 0), utc(4, 20, 18, 0),
+			[][2]time.Time{},
+		},
+	} {
+		found, err := occurrences(test.schedule, test.from, test.to)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		got := [][2]time.Time{}
+		for _, o := range found {
+			got = append(got, [2]time.Time{o.start.UTC(), o.end.UTC()})
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if !got[i][0].Equal(test.want[i][0]) || !got[i][1].Equal(test.want[i][1]) {
+				t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+				break
+			}
+		}
+	}
+}
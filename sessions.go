@@ -0,0 +1,442 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"sort"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// Statuses of class sessions
+const (
+	sessionPresent   = "present"
+	sessionLate      = "late"
+	sessionLeftEarly = "left_early"
+	sessionAbsent    = "absent"
+	// sessionIncomplete is a session the tracker missed some of, that would otherwise not be present
+	sessionIncomplete = "incomplete"
+	// sessionExcused is only set by overrides
+	sessionExcused = "excused"
+)
+
+// sessionStatuses in the order they are documented
+var sessionStatuses = []string{sessionPresent, sessionLate, sessionLeftEarly, sessionAbsent, sessionIncomplete, sessionExcused}
+
+// sessionLookback is how long after a class ended the tracker still evaluates it, for when it wasn't running at the time
+const sessionLookback = 24 * time.Hour
+
+// attendancePolicy turns the samples of a student at a class into a status
+type attendancePolicy struct {
+	MinPresencePercent int64
+	LateAfterMinutes   int64
+	LeftEarlyMinutes   int64
+}
+
+// policyOf the schedule, with the integration's rules where it has none of its own
+func policyOf(integration *db.Integration, s *db.Schedule) attendancePolicy {
+	p := attendancePolicy{integration.MinPresencePercent, integration.LateAfterMinutes, integration.LeftEarlyMinutes}
+	if s.MinPresencePercent.Valid {
+		p.MinPresencePercent = s.MinPresencePercent.Int64
+	}
+	if s.LateAfterMinutes.Valid {
+		p.LateAfterMinutes = s.LateAfterMinutes.Int64
+	}
+	if s.LeftEarlyMinutes.Valid {
+		p.LeftEarlyMinutes = s.LeftEarlyMinutes.Int64
+	}
+	return p
+}
+
+// presence of a student at a class: the samples of the tracker in time order, and the manual attendance entered for them
+type presence struct {
+	samples []time.Time
+	manual  db.ManualAttendanceSlice
+}
+
+func (pr *presence) manualIntervals() []interval {
+	result := []interval{}
+	for _, m := range pr.manual {
+		result = append(result, interval{m.StartsAt, m.EndsAt})
+	}
+	return result
+}
+
+// manualMinutes of the class the manual attendance covers
+func (pr *presence) manualMinutes(o *occurrence) int64 {
+	manual, _, _ := covered(pr.manualIntervals(), o.start, o.end)
+	return int64(manual / time.Minute)
+}
+
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// covered is how much of from until to the intervals cover, with the start of the first and the end of the last
+func covered(intervals []interval, from, to time.Time) (time.Duration, time.Time, time.Time) {
+	clipped := []interval{}
+	for _, i := range intervals {
+		if i.start.Before(from) {
+			i.start = from
+		}
+		if i.end.After(to) {
+			i.end = to
+		}
+		if i.end.After(i.start) {
+			clipped = append(clipped, i)
+		}
+	}
+	if len(clipped) == 0 {
+		return 0, time.Time{}, time.Time{}
+	}
+	sort.Slice(clipped, func(i, j int) bool { return clipped[i].start.Before(clipped[j].start) })
+	total, first, last := time.Duration(0), clipped[0].start, clipped[0].start
+	for _, i := range clipped {
+		if i.start.Before(last) {
+			i.start = last
+		}
+		if i.end.After(i.start) {
+			total += i.end.Sub(i.start)
+			last = i.end
+		}
+	}
+	return total, first, last
+}
+
+// evaluate the presence of a student at a class, returning the status, the minutes present and the minutes manual attendance
+// covers. Every sample stands for the step after it, so how late or early a student was is only known to a step.
+//
+// Students are absent when they were there for less than MinPresencePercent of the class, late when first there more than
+// LateAfterMinutes after it started, and left early when gone more than LeftEarlyMinutes before it ended.
+// Students who were late and left early are late.
+func (p attendancePolicy) evaluate(o *occurrence, pr *presence, step time.Duration) (string, int64, int64) {
+	all := []interval{}
+	for _, t := range pr.samples {
+		all = append(all, interval{t, t.Add(step)})
+	}
+	all = append(all, pr.manualIntervals()...)
+	present, first, last := covered(all, o.start, o.end)
+	minutes := int64(present / time.Minute)
+	manual := pr.manualMinutes(o)
+	duration := o.end.Sub(o.start)
+	switch {
+	case present == 0 || present*100 < duration*time.Duration(p.MinPresencePercent):
+		return sessionAbsent, minutes, manual
+	case first.Sub(o.start) > time.Duration(p.LateAfterMinutes)*time.Minute:
+		return sessionLate, minutes, manual
+	case o.end.Sub(last) > time.Duration(p.LeftEarlyMinutes)*time.Minute:
+		return sessionLeftEarly, minutes, manual
+	}
+	return sessionPresent, minutes, manual
+}
+
+// classSamples of each student in the teacher's instance from until to, in the schedule's world if it has one.
+// Each student's samples are in time order.
+func classSamples(s *db.Schedule, from, to time.Time) (map[int64][]time.Time, error) {
+	mods := []qm.QueryMod{
+		db.AttendanceWhere.IntegrationID.EQ(null.Int64From(s.IntegrationID)),
+		db.AttendanceWhere.TeacherID.EQ(null.Int64From(s.TeacherID)),
+		db.AttendanceWhere.Timestamp.GTE(from.Unix()),
+		db.AttendanceWhere.Timestamp.LT(to.Unix()),
+		qm.OrderBy(db.AttendanceColumns.Timestamp),
+	}
+	if s.WorldID.Valid {
+		mods = append(mods, db.AttendanceWhere.WorldID.EQ(s.WorldID.String))
+	}
+	records, err := db.Attendances(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+	result := map[int64][]time.Time{}
+	for _, record := range records {
+		samples := result[record.FriendID.Int64]
+		at := time.Unix(record.Timestamp, 0).UTC()
+		// samples of the same tick are only counted once
+		if len(samples) > 0 && samples[len(samples)-1].Equal(at) {
+			continue
+		}
+		result[record.FriendID.Int64] = append(samples, at)
+	}
+	return result, nil
+}
+
+// classManual is the manual attendance of each student with the schedule's teacher that overlaps from until to
+func classManual(s *db.Schedule, from, to time.Time) (map[int64]db.ManualAttendanceSlice, error) {
+	entries, err := db.ManualAttendances(
+		db.ManualAttendanceWhere.IntegrationID.EQ(s.IntegrationID),
+		db.ManualAttendanceWhere.TeacherID.EQ(s.TeacherID),
+		db.ManualAttendanceWhere.Archived.EQ(false),
+		db.ManualAttendanceWhere.StartsAt.LT(to.UTC()),
+		db.ManualAttendanceWhere.EndsAt.GT(from.UTC()),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+	result := map[int64]db.ManualAttendanceSlice{}
+	for _, entry := range entries {
+		result[entry.FriendID] = append(result[entry.FriendID], entry)
+	}
+	return result, nil
+}
+
+// classPresence of each student at the class, tracked and entered by hand
+func classPresence(s *db.Schedule, o *occurrence) (map[int64]*presence, error) {
+	samples, err := classSamples(s, o.start, o.end)
+	if err != nil {
+		return nil, err
+	}
+	manual, err := classManual(s, o.start, o.end)
+	if err != nil {
+		return nil, err
+	}
+	result := map[int64]*presence{}
+	for id, seen := range samples {
+		result[id] = &presence{samples: seen}
+	}
+	for id, entries := range manual {
+		if result[id] == nil {
+			result[id] = &presence{}
+		}
+		result[id].manual = entries
+	}
+	return result, nil
+}
+
+// setEvaluation sets the evaluated fields of the session from the presence of its student, an override is kept.
+// A student who wasn't present for all of a class the tracker missed gapMinutes of is incomplete rather than absent,
+// late or left early, as they may have been there when it wasn't looking.
+func setEvaluation(session *db.ClassSession, o *occurrence, pr *presence, p attendancePolicy, step time.Duration, gapMinutes int64) {
+	if pr == nil {
+		pr = &presence{}
+	}
+	status, minutes, manual := p.evaluate(o, pr, step)
+	if gapMinutes > 0 && status != sessionPresent {
+		status = sessionIncomplete
+	}
+	session.Status = status
+	session.GapMinutes = gapMinutes
+	session.Samples = int64(len(pr.samples))
+	session.MinutesPresent = minutes
+	session.ManualMinutes = manual
+	session.MinPresencePercent = p.MinPresencePercent
+	session.LateAfterMinutes = p.LateAfterMinutes
+	session.LeftEarlyMinutes = p.LeftEarlyMinutes
+	session.EvaluatedAt = time.Now().UTC()
+	session.FirstSeenAt, session.LastSeenAt = null.Time{}, null.Time{}
+	if len(pr.samples) > 0 {
+		session.FirstSeenAt = null.TimeFrom(pr.samples[0])
+		session.LastSeenAt = null.TimeFrom(pr.samples[len(pr.samples)-1])
+	}
+}
+
+func newClassSession(s *db.Schedule, o *occurrence, student *db.Friend, pr *presence, p attendancePolicy, step time.Duration, gapMinutes int64) *db.ClassSession {
+	session := &db.ClassSession{
+		IntegrationID: s.IntegrationID,
+		ScheduleID:    s.ID,
+		TeacherID:     s.TeacherID,
+		FriendID:      student.ID,
+		StartsAt:      o.start.UTC(),
+		EndsAt:        o.end.UTC(),
+	}
+	setEvaluation(session, o, pr, p, step, gapMinutes)
+	return session
+}
+
+// evaluateSessions stores the status of every expected student at the classes of the schedule that ended from until to
+// and haven't been evaluated yet, returning how many classes were evaluated. Step is the time between the tracker's samples.
+func evaluateSessions(integration *db.Integration, s *db.Schedule, from, to time.Time, step time.Duration) (int, error) {
+	found, err := occurrences(s, from, to)
+	if err != nil {
+		return 0, err
+	}
+	students, err := expectedStudents(s)
+	if err != nil {
+		return 0, err
+	}
+	p := policyOf(integration, s)
+	evaluated := 0
+	for _, o := range found {
+		if o.end.After(to) {
+			continue
+		}
+		done, err := db.ClassSessions(
+			db.ClassSessionWhere.ScheduleID.EQ(s.ID),
+			db.ClassSessionWhere.StartsAt.EQ(o.start.UTC()),
+		).ExistsG()
+		if err != nil {
+			return evaluated, err
+		}
+		if done || len(students) == 0 {
+			continue
+		}
+		present, err := classPresence(s, o)
+		if err != nil {
+			return evaluated, err
+		}
+		missed, err := classGapMinutes(s.IntegrationID, o, step)
+		if err != nil {
+			return evaluated, err
+		}
+		tx, err := beginTx()
+		if err != nil {
+			return evaluated, err
+		}
+		for _, student := range students {
+			err = newClassSession(s, o, student, present[student.ID], p, step, missed).Insert(tx, boil.Infer())
+			if err != nil {
+				return evaluated, rollback(tx, err)
+			}
+		}
+		err = tx.Commit()
+		if err != nil {
+			return evaluated, err
+		}
+		evaluated++
+	}
+	return evaluated, nil
+}
+
+// reevaluateSessions of the student with the teacher at classes overlapping from until to, with the policy they were
+// evaluated with, after their manual attendance changed. Overrides are kept.
+func reevaluateSessions(integrationID, teacherID, friendID int64, from, to time.Time, step time.Duration) error {
+	sessions, err := db.ClassSessions(
+		db.ClassSessionWhere.IntegrationID.EQ(integrationID),
+		db.ClassSessionWhere.TeacherID.EQ(teacherID),
+		db.ClassSessionWhere.FriendID.EQ(friendID),
+		db.ClassSessionWhere.StartsAt.LT(to.UTC()),
+		db.ClassSessionWhere.EndsAt.GT(from.UTC()),
+		qm.Load(db.ClassSessionRels.Schedule),
+	).AllG()
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		o := &occurrence{session.R.Schedule, session.StartsAt, session.EndsAt}
+		present, err := classPresence(session.R.Schedule, o)
+		if err != nil {
+			return err
+		}
+		missed, err := classGapMinutes(integrationID, o, step)
+		if err != nil {
+			return err
+		}
+		p := attendancePolicy{session.MinPresencePercent, session.LateAfterMinutes, session.LeftEarlyMinutes}
+		setEvaluation(session, o, present[friendID], p, step, missed)
+		_, err = session.UpdateG(boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sessionStatus is the override of the session, or the evaluated status when it has none
+func sessionStatus(session *db.ClassSession) string {
+	if session.OverrideStatus.Valid {
+		return session.OverrideStatus.String
+	}
+	return session.Status
+}
+
+// occurrenceReport is who attended each class of the schedule that overlaps from until to, and who was expected and didn't.
+// Classes that have been evaluated are reported with the statuses stored then, or their overrides. Classes that haven't,
+// like one still going, have the students seen in the teacher's instance present without a status, in the schedule's world
+// if it has one, and those with manual attendance.
+// Only the students enrolled in the schedule's roster are expected, or those in any of the teacher's rosters when it has none.
+// Expected students who weren't seen at a class the tracker missed some of are incomplete rather than absent.
+func occurrenceReport(s *db.Schedule, from, to time.Time, step time.Duration) ([]*v1Occurrence, error) {
+	found, err := occurrences(s, from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := []*v1Occurrence{}
+	if len(found) == 0 {
+		return result, nil
+	}
+	students, err := expectedStudents(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range found {
+		report := &v1Occurrence{
+			ScheduleID: s.ID,
+			Start:      o.start,
+			End:        o.end,
+			Present:    []*v1OccurrenceAttendee{},
+			Absent:     []*v1Friend{},
+			Incomplete: []*v1Friend{},
+			Excused:    []*v1Friend{},
+		}
+		sessions, err := db.ClassSessions(
+			db.ClassSessionWhere.ScheduleID.EQ(s.ID),
+			db.ClassSessionWhere.StartsAt.EQ(o.start.UTC()),
+			qm.Load(db.ClassSessionRels.Friend),
+			qm.OrderBy(db.ClassSessionColumns.FriendID),
+		).AllG()
+		if err != nil {
+			return nil, err
+		}
+		report.Evaluated = len(sessions) > 0
+		for _, session := range sessions {
+			report.GapMinutes = session.GapMinutes
+			switch sessionStatus(session) {
+			case sessionAbsent:
+				report.Absent = append(report.Absent, toV1Friend(session.R.Friend))
+				continue
+			case sessionIncomplete:
+				report.Incomplete = append(report.Incomplete, toV1Friend(session.R.Friend))
+				continue
+			case sessionExcused:
+				report.Excused = append(report.Excused, toV1Friend(session.R.Friend))
+				continue
+			}
+			report.Present = append(report.Present, &v1OccurrenceAttendee{
+				Student:       toV1Friend(session.R.Friend),
+				SessionID:     null.Int64From(session.ID),
+				FirstSeen:     session.FirstSeenAt,
+				LastSeen:      session.LastSeenAt,
+				Samples:       int(session.Samples),
+				ManualMinutes: session.ManualMinutes,
+				Status:        null.StringFrom(sessionStatus(session)),
+				Override:      toV1SessionOverride(session),
+			})
+		}
+		if !report.Evaluated {
+			present, err := classPresence(s, o)
+			if err != nil {
+				return nil, err
+			}
+			report.GapMinutes, err = classGapMinutes(s.IntegrationID, o, step)
+			if err != nil {
+				return nil, err
+			}
+			for _, student := range students {
+				pr := present[student.ID]
+				if pr == nil && report.GapMinutes > 0 {
+					report.Incomplete = append(report.Incomplete, toV1Friend(student))
+					continue
+				}
+				if pr == nil {
+					report.Absent = append(report.Absent, toV1Friend(student))
+					continue
+				}
+				attendee := &v1OccurrenceAttendee{
+					Student:       toV1Friend(student),
+					Samples:       len(pr.samples),
+					ManualMinutes: pr.manualMinutes(o),
+				}
+				if len(pr.samples) > 0 {
+					attendee.FirstSeen = null.TimeFrom(pr.samples[0])
+					attendee.LastSeen = null.TimeFrom(pr.samples[len(pr.samples)-1])
+				}
+				report.Present = append(report.Present, attendee)
+			}
+		}
+		sort.Slice(report.Present, func(i, j int) bool { return report.Present[i].Student.ID < report.Present[j].Student.ID })
+		result = append(result, report)
+	}
+	return result, nil
+}
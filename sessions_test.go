@@ -0,0 +1,100 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"testing"
+	"time"
+)
+
+// classAt is 18:00 on the day of the class the sessions tests evaluate
+var classAt = time.Date(2020, 4, 26, 18, 0, 0, 0, time.UTC)
+
+// minutes after the class starts
+func minutes(m int) time.Time {
+	return classAt.Add(time.Duration(m) * time.Minute)
+}
+
+// samplesFrom every step from the minute until before the other
+func samplesFrom(from, until int, step time.Duration) []time.Time {
+	samples := []time.Time{}
+	for t := minutes(from); t.Before(minutes(until)); t = t.Add(step) {
+		samples = append(samples, t)
+	}
+	return samples
+}
+
+func TestEvaluate(t *testing.T) {
+	step := 5 * time.Minute
+	o := &occurrence{start: minutes(0), end: minutes(60)}
+	lenient := attendancePolicy{MinPresencePercent: 75, LateAfterMinutes: 60, LeftEarlyMinutes: 60}
+	strict := attendancePolicy{MinPresencePercent: 25, LateAfterMinutes: 10, LeftEarlyMinutes: 10}
+	for _, test := range []struct {
+		name          string
+		policy        attendancePolicy
+		samples       []time.Time
+		manual        [][2]int
+		status        string
+		present       int64
+		manualMinutes int64
+	}{
+		{"no samples", lenient, nil, nil, sessionAbsent, 0, 0},
+		{"the whole class", strict, samplesFrom(0, 60, step), nil, sessionPresent, 60, 0},
+		{"at the minimum presence", lenient, samplesFrom(0, 45, step), nil, sessionPresent, 45, 0},
+		{"under the minimum presence", lenient, samplesFrom(0, 40, step), nil, sessionAbsent, 40, 0},
+		{"only before the class", lenient, samplesFrom(-30, 0, step), nil, sessionAbsent, 0, 0},
+		{"arrived at the late threshold", strict, samplesFrom(10, 60, step), nil, sessionPresent, 50, 0},
+		{"arrived after the late threshold", strict, samplesFrom(15, 60, step), nil, sessionLate, 45, 0},
+		{"left at the early threshold", strict, samplesFrom(0, 50, step), nil, sessionPresent, 50, 0},
+		{"left before the early threshold", strict, samplesFrom(0, 45, step), nil, sessionLeftEarly, 45, 0},
+		{"late and left early is late", strict, samplesFrom(15, 45, step), nil, sessionLate, 30, 0},
+		{"manual attendance before the samples", strict, samplesFrom(20, 60, step), [][2]int{{0, 30}}, sessionPresent, 60, 30},
+		{"manual attendance over the class", strict, nil, [][2]int{{-30, 90}}, sessionPresent, 60, 60},
+		{"overlapping manual attendance", strict, nil, [][2]int{{0, 40}, {30, 60}}, sessionPresent, 60, 60},
+		{"manual attendance under the minimum", lenient, nil, [][2]int{{0, 20}, {10, 30}}, sessionAbsent, 30, 30},
+	} {
+		pr := &presence{samples: test.samples}
+		for _, m := range test.manual {
+			pr.manual = append(pr.manual, &db.ManualAttendance{StartsAt: minutes(m[0]), EndsAt: minutes(m[1])})
+		}
+		status, present, manual := test.policy.evaluate(o, pr, step)
+		if status != test.status || present != test.present || manual != test.manualMinutes {
+			t.Errorf("%s: got %s with %d minutes present and %d manual, want %s with %d and %d",
+				test.name, status, present, manual, test.status, test.present, test.manualMinutes)
+		}
+	}
+}
+
+func TestCovered(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		intervals [][2]int
+		total     int
+		first     int
+		last      int
+	}{
+		{"one interval", [][2]int{{10, 20}}, 10, 10, 20},
+		{"apart", [][2]int{{0, 10}, {30, 40}}, 20, 0, 40},
+		{"overlapping", [][2]int{{0, 30}, {20, 40}}, 40, 0, 40},
+		{"touching", [][2]int{{0, 30}, {30, 40}}, 40, 0, 40},
+		{"inside another", [][2]int{{0, 60}, {10, 20}}, 60, 0, 60},
+		{"out of order", [][2]int{{30, 40}, {0, 10}, {5, 15}}, 25, 0, 40},
+		{"clipped to the class", [][2]int{{-30, 10}, {50, 90}}, 20, 0, 60},
+	} {
+		intervals := []interval{}
+		for _, i := range test.intervals {
+			intervals = append(intervals, interval{minutes(i[0]), minutes(i[1])})
+		}
+		total, first, last := covered(intervals, minutes(0), minutes(60))
+		if total != time.Duration(test.total)*time.Minute || !first.Equal(minutes(test.first)) || !last.Equal(minutes(test.last)) {
+			t.Errorf("%s: got %v from %v until %v, want %d minutes from %v until %v",
+				test.name, total, first, last, test.total, minutes(test.first), minutes(test.last))
+		}
+	}
+
+	for _, intervals := range [][]interval{nil, {{minutes(-30), minutes(0)}}, {{minutes(60), minutes(90)}}, {{minutes(20), minutes(10)}}} {
+		total, first, last := covered(intervals, minutes(0), minutes(60))
+		if total != 0 || !first.IsZero() || !last.IsZero() {
+			t.Errorf("got %v from %v until %v of %v outside the class, want nothing", total, first, last, intervals)
+		}
+	}
+}
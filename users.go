@@ -1,4 +1,96 @@
 package accumulator
 
+import (
+	"accumulator/db"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
 const roleAdmin = "admin"
 const roleUser = "user"
+
+// Roles a user can be given
+var Roles = []string{roleAdmin, roleUser}
+
+func validateRole(role string) error {
+	for _, r := range Roles {
+		if role == r {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown role %s, expected one of %v", role, Roles)
+}
+
+// FindUserByEmail returns the user with the email
+func FindUserByEmail(email string) (*db.User, error) {
+	u, err := db.Users(db.UserWhere.Email.EQ(email)).OneG()
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no user with email %s", email)
+	}
+	return u, err
+}
+
+// CreateUser with the role and password
+func CreateUser(email, password, role string) (*db.User, error) {
+	err := validateRole(role)
+	if err != nil {
+		return nil, err
+	}
+	u := &db.User{
+		Email:        email,
+		PasswordHash: HashPassword(password),
+		Role:         role,
+	}
+	err = u.InsertG(boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SetRole of the user with the email
+func SetRole(email, role string) (*db.User, error) {
+	err := validateRole(role)
+	if err != nil {
+		return nil, err
+	}
+	u, err := FindUserByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u.Role = role
+	_, err = u.UpdateG(boil.Whitelist(db.UserColumns.Role, db.UserColumns.UpdatedAt))
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SetPassword of the user with the email
+func SetPassword(email, password string) (*db.User, error) {
+	u, err := FindUserByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u.PasswordHash = HashPassword(password)
+	_, err = u.UpdateG(boil.Whitelist(db.UserColumns.PasswordHash, db.UserColumns.UpdatedAt))
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// RandomPassword for password resets, 18 random bytes in URL safe base64
+func RandomPassword() (string, error) {
+	b := make([]byte, 18)
+	_, err := io.ReadFull(rand.Reader, b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
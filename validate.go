@@ -0,0 +1,352 @@
+package accumulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// maxRequestBytes is the largest request body decodeJSON reads
+const maxRequestBytes = 1 << 20
+
+// timeOfDayPattern is what the timeofday rule accepts
+const timeOfDayPattern = `^([01][0-9]|2[0-3]):[0-5][0-9]$`
+
+var timeOfDay = regexp.MustCompile(timeOfDayPattern)
+
+// FieldError is a problem with one field of a request body
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError lists the fields of a request body that are invalid
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := []string{}
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+" "+f.Message)
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// decodeJSON decodes the request body into v and checks the validate tags of its fields.
+// Bodies larger than maxRequestBytes, unknown fields and anything after the JSON value are refused.
+//
+// Supported rules are required, email, url (http or https), min=N and max=N (characters of strings, or values of numbers),
+// maxbytes=N (bytes of strings, for bcrypt's limit on passwords), oneof=a b c, which checks every element of string slices,
+// timeofday (HH:MM on a 24 hour clock) and timezone (an IANA time zone name).
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBytes)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err != nil {
+		return decodeError(err)
+	}
+	err = dec.Decode(&struct{}{})
+	if err != io.EOF {
+		if err != nil && isBodyTooLarge(err) {
+			return decodeError(err)
+		}
+		return errBadRequest(err, "request body must be a single JSON value")
+	}
+	fields := validate(v)
+	if len(fields) > 0 {
+		return &ValidationError{fields}
+	}
+	return nil
+}
+
+// decodeError explains why the body could not be decoded, naming the field when there is one
+func decodeError(err error) error {
+	syntaxErr := &json.SyntaxError{}
+	typeErr := &json.UnmarshalTypeError{}
+	switch {
+	case err == io.EOF:
+		return errBadRequest(err, "request body is required")
+	case err == io.ErrUnexpectedEOF:
+		return errBadRequest(err, "request body is not complete JSON")
+	case isBodyTooLarge(err):
+		return &AppError{http.StatusRequestEntityTooLarge, CodeTooLarge, fmt.Sprintf("request body is larger than %d bytes", maxRequestBytes), err}
+	case errors.As(err, &syntaxErr):
+		return errBadRequest(err, fmt.Sprintf("request body is malformed JSON at byte %d", syntaxErr.Offset))
+	case errors.As(err, &typeErr):
+		if typeErr.Field == "" {
+			return errBadRequest(err, "request body must be a JSON "+jsonTypeName(typeErr.Type))
+		}
+		return &ValidationError{[]FieldError{{typeErr.Field, "must be " + withArticle(jsonTypeName(typeErr.Type))}}}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return &ValidationError{[]FieldError{{field, "is not a known field"}}}
+	}
+	return errBadRequest(err, "request body is malformed JSON")
+}
+
+// isBodyTooLarge matches the error of http.MaxBytesReader, which has no type before Go 1.19
+func isBodyTooLarge(err error) bool {
+	return err.Error() == "http: request body too large"
+}
+
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
+
+func withArticle(s string) string {
+	if strings.ContainsAny(s[:1], "aeiou") {
+		return "an " + s
+	}
+	return "a " + s
+}
+
+// requestBodies are the types decodeJSON decodes. Their validate tags are checked when the package is loaded, so a rule
+// that doesn't fit its field stops the server from starting rather than failing requests.
+var requestBodies = []interface{}{
+	credentialsRequest{},
+	setPasswordRequest{},
+	addUsernameRequest{},
+	v1IntegrationUpdateRequest{},
+	v1FriendUpdateRequest{},
+	v1WebhookCreateRequest{},
+	v1ScheduleRequest{},
+	v1RosterRequest{},
+	v1EnrolmentRequest{},
+	v1SessionOverrideRequest{},
+	v1ManualAttendanceRequest{},
+	v1ReprocessRequest{},
+}
+
+// structRules are the parsed validate tags of the fields of each struct type decoded so far
+var structRules sync.Map
+
+func init() {
+	for _, body := range requestBodies {
+		_, err := parseStruct(reflect.TypeOf(body))
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// rule is one parsed rule of a validate tag
+type rule struct {
+	name    string
+	n       int64
+	options []string
+}
+
+// fieldRules are the rules of the field of a struct with the index
+type fieldRules struct {
+	index int
+	name  string
+	rules []rule
+}
+
+// parseStruct parses the validate tags of the fields of a struct type once, the result is kept in structRules
+func parseStruct(t reflect.Type) ([]fieldRules, error) {
+	if cached, ok := structRules.Load(t); ok {
+		return cached.([]fieldRules), nil
+	}
+	result := []fieldRules{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+		rules, err := parseRules(f.Type, strings.Split(tag, ","))
+		if err != nil {
+			return nil, fmt.Errorf("validate: %s.%s: %w", t.Name(), f.Name, err)
+		}
+		name, _, _ := jsonField(f)
+		result = append(result, fieldRules{i, name, rules})
+	}
+	structRules.Store(t, result)
+	return result, nil
+}
+
+// parseRules of a validate tag, checking they can be applied to values of the type.
+// Optional fields are pointers, the rules other than required are applied to what they point to.
+func parseRules(t reflect.Type, rules []string) ([]rule, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	isInt := false
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		isInt = true
+	}
+	isString := t.Kind() == reflect.String
+	isStrings := t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String
+	result := []rule{}
+	for _, s := range rules {
+		r := rule{name: s}
+		arg := ""
+		if i := strings.Index(s, "="); i >= 0 {
+			r.name, arg = s[:i], s[i+1:]
+		}
+		fits := isString
+		switch r.name {
+		case "required":
+			fits = true
+		case "email", "url", "timeofday", "timezone":
+		case "min", "max", "maxbytes":
+			n, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad %s", s)
+			}
+			r.n = n
+			if r.name != "maxbytes" {
+				fits = isString || isInt
+			}
+		case "oneof":
+			r.options = strings.Fields(arg)
+			if len(r.options) == 0 {
+				return nil, fmt.Errorf("bad %s", s)
+			}
+			fits = isString || isStrings
+		default:
+			return nil, fmt.Errorf("unknown rule %s", s)
+		}
+		if !fits {
+			return nil, fmt.Errorf("%s on %s", r.name, t)
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// validate checks the validate tags of a struct, reporting the first broken rule of each field
+func validate(v interface{}) []FieldError {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	parsed, err := parseStruct(rv.Type())
+	if err != nil {
+		panic(err)
+	}
+	fields := []FieldError{}
+	for _, f := range parsed {
+		msg := applyRules(rv.Field(f.index), f.rules)
+		if msg != "" {
+			fields = append(fields, FieldError{f.name, msg})
+		}
+	}
+	return fields
+}
+
+// checkRules checks a value against the rules of a validate tag, for values that aren't fields of a request body
+func checkRules(v reflect.Value, rules []string) string {
+	parsed, err := parseRules(v.Type(), rules)
+	if err != nil {
+		panic(fmt.Sprintf("validate: %v", err))
+	}
+	return applyRules(v, parsed)
+}
+
+// applyRules to a value, returning the message of the first it breaks
+func applyRules(v reflect.Value, rules []rule) string {
+	// optional fields are pointers, which are only required to be there
+	if v.Kind() == reflect.Ptr {
+		others := []rule{}
+		for _, r := range rules {
+			if r.name == "required" && v.IsNil() {
+				return "is required"
+			}
+			if r.name != "required" {
+				others = append(others, r)
+			}
+		}
+		if v.IsNil() {
+			return ""
+		}
+		v, rules = v.Elem(), others
+	}
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			if v.Kind() == reflect.String && strings.TrimSpace(v.String()) == "" || v.Kind() == reflect.Slice && v.Len() == 0 || v.IsZero() {
+				return "is required"
+			}
+		case "email":
+			addr, err := mail.ParseAddress(v.String())
+			if v.String() != "" && (err != nil || addr.Address != v.String()) {
+				return "must be an email address"
+			}
+		case "url":
+			u, err := url.Parse(v.String())
+			if v.String() != "" && (err != nil || u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+				return "must be an http or https URL"
+			}
+		case "min", "max":
+			got, unit := int64(0), ""
+			if v.Kind() == reflect.String {
+				got, unit = int64(utf8.RuneCountInString(v.String())), " characters"
+			} else {
+				got = v.Int()
+			}
+			if r.name == "min" && got < r.n {
+				return fmt.Sprintf("must be at least %d%s", r.n, unit)
+			}
+			if r.name == "max" && got > r.n {
+				return fmt.Sprintf("must be at most %d%s", r.n, unit)
+			}
+		case "maxbytes":
+			if int64(len(v.String())) > r.n {
+				return fmt.Sprintf("must be at most %d bytes", r.n)
+			}
+		case "oneof":
+			values := []string{v.String()}
+			if v.Kind() == reflect.Slice {
+				values = v.Interface().([]string)
+			}
+			for _, value := range values {
+				found := false
+				for _, o := range r.options {
+					found = found || value == o
+				}
+				if !found {
+					return fmt.Sprintf("must be one of %s, got %q", strings.Join(r.options, ", "), value)
+				}
+			}
+		case "timeofday":
+			if v.String() != "" && !timeOfDay.MatchString(v.String()) {
+				return "must be a time of day like 09:30"
+			}
+		case "timezone":
+			// LoadLocation takes "Local" to be the server's time zone
+			_, err := time.LoadLocation(v.String())
+			if v.String() != "" && (err != nil || v.String() == "Local") {
+				return "must be a time zone like Europe/London"
+			}
+		}
+	}
+	return ""
+}
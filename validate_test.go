@@ -0,0 +1,205 @@
+package accumulator
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestRequestBodiesChecked finds every struct of the package with validate tags, so request bodies can't be added without
+// their tags being checked when the package is loaded
+func TestRequestBodiesChecked(t *testing.T) {
+	checked := map[string]bool{}
+	for _, body := range requestBodies {
+		checked[reflect.TypeOf(body).Name()] = true
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagged := []string{}
+	for _, pkg := range pkgs {
+		ast.Inspect(pkg, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, f := range st.Fields.List {
+				if f.Tag != nil && strings.Contains(f.Tag.Value, `validate:"`) {
+					tagged = append(tagged, spec.Name.Name)
+					break
+				}
+			}
+			return true
+		})
+	}
+	sort.Strings(tagged)
+	if len(tagged) == 0 {
+		t.Fatal("found no structs with validate tags")
+	}
+	for _, name := range tagged {
+		if !checked[name] {
+			t.Errorf("%s has validate tags and is not in requestBodies", name)
+		}
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	typeOf := func(v interface{}) reflect.Type {
+		return reflect.TypeOf(v).Elem()
+	}
+	for _, test := range []struct {
+		t   reflect.Type
+		tag string
+		ok  bool
+	}{
+		{typeOf((*string)(nil)), "required,email,max=254", true},
+		{typeOf((*string)(nil)), "required,min=8,maxbytes=72", true},
+		{typeOf((*string)(nil)), "url,timeofday,timezone", true},
+		{typeOf((*int64)(nil)), "min=0,max=100", true},
+		{typeOf((**int64)(nil)), "required,min=0,max=1440", true},
+		{typeOf((*[]string)(nil)), "required,oneof=mon tue", true},
+		{typeOf((*bool)(nil)), "required", true},
+		{typeOf((*bool)(nil)), "min=1", false},
+		{typeOf((*float64)(nil)), "max=1", false},
+		{typeOf((*[]int64)(nil)), "oneof=1 2", false},
+		{typeOf((*int64)(nil)), "oneof=1 2", false},
+		{typeOf((*int64)(nil)), "email", false},
+		{typeOf((*[]string)(nil)), "maxbytes=10", false},
+		{typeOf((*string)(nil)), "max=ten", false},
+		{typeOf((*string)(nil)), "max", false},
+		{typeOf((*string)(nil)), "oneof=", false},
+		{typeOf((*string)(nil)), "unique", false},
+	} {
+		_, err := parseRules(test.t, strings.Split(test.tag, ","))
+		if (err == nil) != test.ok {
+			t.Errorf("%s on %s: got error %v, want ok %v", test.tag, test.t, err, test.ok)
+		}
+	}
+}
+
+// validated has a field for each rule
+type validated struct {
+	Email    string   `json:"email" validate:"required,email,max=254"`
+	Password string   `json:"password" validate:"required,min=8,maxbytes=72"`
+	Webhook  string   `json:"webhook" validate:"url"`
+	Weekdays []string `json:"weekdays" validate:"required,oneof=mon tue"`
+	Start    string   `json:"start" validate:"timeofday"`
+	TimeZone string   `json:"time_zone" validate:"timezone"`
+	Percent  *int64   `json:"percent,omitempty" validate:"min=0,max=100"`
+	Name     *string  `json:"name,omitempty" validate:"required,max=3"`
+}
+
+func TestValidateMessages(t *testing.T) {
+	for _, test := range []struct {
+		body string
+		want map[string]string
+	}{
+		{
+			`{"email":"a@example.com","password":"password","weekdays":["mon"],"name":"abc"}`,
+			map[string]string{},
+		},
+		{
+			`{"email":"  ","password":"","weekdays":[]}`,
+			map[string]string{"email": "is required", "password": "is required", "weekdays": "is required", "name": "is required"},
+		},
+		{
+			`{"email":"Someone <a@example.com>","password":"short","weekdays":["mon","sat"],"name":"abcd","percent":101}`,
+			map[string]string{
+				"email":    "must be an email address",
+				"password": "must be at least 8 characters",
+				"weekdays": `must be one of mon, tue, got "sat"`,
+				"name":     "must be at most 3 characters",
+				"percent":  "must be at most 100",
+			},
+		},
+		{
+			`{"email":"a@example.com","password":"` + strings.Repeat("é", 40) + `","weekdays":["tue"],"name":"é","percent":-1}`,
+			map[string]string{"password": "must be at most 72 bytes", "percent": "must be at least 0"},
+		},
+		{
+			`{"email":"a@example.com","password":"password","weekdays":["mon"],"name":"abc",` +
+				`"webhook":"ftp://example.com","start":"24:00","time_zone":"Local"}`,
+			map[string]string{"webhook": "must be an http or https URL", "start": "must be a time of day like 09:30", "time_zone": "must be a time zone like Europe/London"},
+		},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+		err := decodeJSON(httptest.NewRecorder(), r, &validated{})
+		got := map[string]string{}
+		validationErr := &ValidationError{}
+		if errors.As(err, &validationErr) {
+			for _, f := range validationErr.Fields {
+				got[f.Field] = f.Message
+			}
+		} else if err != nil {
+			t.Errorf("%s: got %v, want a validation error", test.body, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.body, got, test.want)
+		}
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	valid := `{"email":"a@example.com","password":"password","weekdays":["mon"],"name":"abc"}`
+	for _, test := range []struct {
+		name    string
+		body    string
+		status  int
+		message string
+	}{
+		{"empty body", "", http.StatusBadRequest, "request body is required"},
+		{"incomplete", `{"email":`, http.StatusBadRequest, "request body is not complete JSON"},
+		{"malformed", `{"email" "a@example.com"}`, http.StatusBadRequest, "request body is malformed JSON at byte 10"},
+		{"not an object", `[]`, http.StatusBadRequest, "request body must be a JSON object"},
+		{"trailing value", valid + `{}`, http.StatusBadRequest, "request body must be a single JSON value"},
+		{"trailing garbage", valid + `x`, http.StatusBadRequest, "request body must be a single JSON value"},
+		{"too large", `{"email":"` + strings.Repeat("a", maxRequestBytes) + `"}`, http.StatusRequestEntityTooLarge, "request body is larger than 1048576 bytes"},
+		{"too large after the value", valid + strings.Repeat(" ", maxRequestBytes), http.StatusRequestEntityTooLarge, "request body is larger than 1048576 bytes"},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+		err := decodeJSON(httptest.NewRecorder(), r, &validated{})
+		appErr := &AppError{}
+		if !errors.As(err, &appErr) || appErr.Status != test.status || appErr.Message != test.message {
+			t.Errorf("%s: got %v, want %d %q", test.name, err, test.status, test.message)
+		}
+	}
+
+	for _, test := range []struct {
+		name  string
+		body  string
+		field string
+		want  string
+	}{
+		{"unknown field", `{"email":"a@example.com","admin":true}`, "admin", "is not a known field"},
+		{"wrong type", `{"percent":"ten"}`, "percent", "must be an integer"},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+		err := decodeJSON(httptest.NewRecorder(), r, &validated{})
+		validationErr := &ValidationError{}
+		if !errors.As(err, &validationErr) || len(validationErr.Fields) != 1 || validationErr.Fields[0] != (FieldError{test.field, test.want}) {
+			t.Errorf("%s: got %v, want %s %s", test.name, err, test.field, test.want)
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(valid))
+	err := decodeJSON(httptest.NewRecorder(), r, &validated{})
+	if err != nil {
+		t.Errorf("valid body: %v", err)
+	}
+}
@@ -2,6 +2,8 @@ import * as React from "react"
 interface Props { }
 export const APIDocumentation = (props: Props) => {
     return (<div>
+        <p>The OpenAPI 3 specification with request and response schemas is served at <a href="/api/openapi.json">/api/openapi.json</a>.</p>
+        <p>The routes below serve this UI and may change. Integrations with other servers should use the stable routes under /api/v1, listed in the specification.</p>
 
         <h2>Private Routes</h2>
         <ul>
@@ -88,6 +90,13 @@ export const APIDocumentation = (props: Props) => {
             <li>Comment: </li>
         </ul>
 
+        <ul>
+            <li>Name: live</li>
+            <li>Method: Get</li>
+            <li>URL: /api/integrations/{`{integration_id}`}/live</li>
+            <li>Comment: WebSocket of the integration's events</li>
+        </ul>
+
         <ul>
             <li>Name: friendList</li>
             <li>Method: Get</li>
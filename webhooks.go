@@ -0,0 +1,438 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mrand "math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"go.uber.org/zap"
+)
+
+// Event types sent to webhooks
+const (
+	EventStudentJoined = "student.joined"
+	EventStudentLeft   = "student.left"
+	EventClassStarted  = "class.started"
+	EventClassEnded    = "class.ended"
+	EventAuthExpired   = "integration.auth_expired"
+)
+
+// EventTypes webhooks can subscribe to
+var EventTypes = []string{EventStudentJoined, EventStudentLeft, EventClassStarted, EventClassEnded, EventAuthExpired}
+
+// Statuses of webhook deliveries
+const (
+	deliveryPending   = "pending"
+	deliveryDelivered = "delivered"
+	deliveryFailed    = "failed"
+)
+
+// WebhookConfig controls how webhook deliveries are sent and retried.
+// Up to Workers deliveries are sent at once. Failed attempts are retried after BackoffSeconds, doubling each time up to
+// MaxBackoffMinutes.
+type WebhookConfig struct {
+	PollSeconds       int `default:"5"`
+	Workers           int `default:"8"`
+	TimeoutSeconds    int `default:"10"`
+	MaxAttempts       int `default:"8"`
+	BackoffSeconds    int `default:"30"`
+	MaxBackoffMinutes int `default:"360"`
+}
+
+// Event is the body of a webhook delivery
+type Event struct {
+	ID            string      `json:"id"`
+	Type          string      `json:"type"`
+	CreatedAt     time.Time   `json:"created_at"`
+	IntegrationID int64       `json:"integration_id"`
+	Data          interface{} `json:"data"`
+}
+
+// classEventData is the data of class.started and class.ended, with the students in the class
+type classEventData struct {
+	Teacher  *v1Friend   `json:"teacher"`
+	Location string      `json:"location"`
+	Students []*v1Friend `json:"students"`
+}
+
+// studentEventData is the data of student.joined and student.left
+type studentEventData struct {
+	Teacher  *v1Friend `json:"teacher"`
+	Student  *v1Friend `json:"student"`
+	Location string    `json:"location"`
+}
+
+type authExpiredEventData struct {
+	Integration *v1Integration `json:"integration"`
+	Error       string         `json:"error"`
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// signPayload is the hex HMAC-SHA256 of "<timestamp>.<payload>", so a captured delivery can't be sent again later
+func signPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func webhookEvents(webhook *db.Webhook) []string {
+	return strings.Split(webhook.Events, ",")
+}
+
+func subscribed(webhook *db.Webhook, eventType string) bool {
+	for _, e := range webhookEvents(webhook) {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// blockedNetworks are webhook targets inside the server's own network rather than on the internet: loopback, private,
+// shared, link-local (including the cloud metadata service at 169.254.169.254), multicast and reserved addresses
+var blockedNetworks = parseCIDRs(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.0.0.0/24",
+	"192.168.0.0/16", "198.18.0.0/15", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+// errPrivateTarget is returned when a webhook URL is, or resolves to, an address in blockedNetworks
+var errPrivateTarget = errors.New("webhook target is not a public address")
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// publicIP is false for addresses in blockedNetworks, IPv4 addresses mapped into IPv6 included
+func publicIP(ip net.IP) bool {
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWebhookURL rejects URLs whose host is, or resolves to, an address that isn't public.
+// The host can resolve differently by the time a delivery is sent, so webhookClient checks again when it connects.
+func checkWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return &ValidationError{[]FieldError{{"url", "must be an http or https URL"}}}
+	}
+	ips := []net.IP{}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+		if err != nil {
+			return &ValidationError{[]FieldError{{"url", "must have a host that resolves"}}}
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	for _, ip := range ips {
+		if !publicIP(ip) {
+			return &ValidationError{[]FieldError{{"url", "must not point at a private, loopback or link-local address"}}}
+		}
+	}
+	return nil
+}
+
+// webhookClient sends deliveries, refusing to connect to addresses that aren't public whatever the URL resolves to
+// at the time, or redirects to
+func webhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// called with the resolved address of every connection
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !publicIP(ip) {
+				return fmt.Errorf("%w: %s", errPrivateTarget, host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// no proxy, the dialer would only check the proxy's address
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// CreateWebhook subscribes the URL to the events of the integration. The secret is returned only here, it is stored encrypted.
+func CreateWebhook(d *Darer, integrationID int64, url string, events []string) (*db.Webhook, string, error) {
+	secret := "whsec_" + randomHex(24)
+	encrypted, nonce, err := d.encrypt([]byte(secret))
+	if err != nil {
+		return nil, "", err
+	}
+	webhook := &db.Webhook{
+		IntegrationID: integrationID,
+		URL:           url,
+		Events:        strings.Join(events, ","),
+		Secret:        encrypted,
+		SecretNonce:   nonce,
+	}
+	err = webhook.InsertG(boil.Infer())
+	if err != nil {
+		return nil, "", err
+	}
+	return webhook, secret, nil
+}
+
+// DeleteWebhook archives the webhook, keeping its deliveries. Pending deliveries are not sent.
+func DeleteWebhook(webhook *db.Webhook) error {
+	webhook.Archived = true
+	webhook.ArchivedAt = null.TimeFrom(time.Now())
+	_, err := webhook.UpdateG(boil.Whitelist(db.WebhookColumns.Archived, db.WebhookColumns.ArchivedAt, db.WebhookColumns.UpdatedAt))
+	return err
+}
+
+func newEvent(integrationID int64, eventType string, data interface{}) *Event {
+	return &Event{
+		ID:            "evt_" + randomHex(16),
+		Type:          eventType,
+		CreatedAt:     time.Now().UTC(),
+		IntegrationID: integrationID,
+		Data:          data,
+	}
+}
+
+// emit queues the event for every webhook of its integration subscribed to it
+func emit(event *Event) error {
+	webhooks, err := db.Webhooks(
+		db.WebhookWhere.IntegrationID.EQ(event.IntegrationID),
+		db.WebhookWhere.Archived.EQ(false),
+	).AllG()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks {
+		if !subscribed(webhook, event.Type) {
+			continue
+		}
+		delivery := &db.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			Event:         event.Type,
+			Payload:       string(payload),
+			Status:        deliveryPending,
+			NextAttemptAt: time.Now().UTC(),
+		}
+		err = delivery.InsertG(boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplayDelivery queues the payload of a delivery again as a new delivery, keeping the event ID so receivers can deduplicate
+func ReplayDelivery(delivery *db.WebhookDelivery) (*db.WebhookDelivery, error) {
+	replay := &db.WebhookDelivery{
+		WebhookID:     delivery.WebhookID,
+		EventID:       delivery.EventID,
+		Event:         delivery.Event,
+		Payload:       delivery.Payload,
+		Status:        deliveryPending,
+		NextAttemptAt: time.Now().UTC(),
+	}
+	err := replay.InsertG(boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+// RunWebhookDeliveries sends the queued webhook deliveries that are due, until the context is done
+func RunWebhookDeliveries(ctx context.Context, c *WebhookConfig, d *Darer, log *zap.SugaredLogger) error {
+	log.Infow("start webhook deliveries", "poll_seconds", c.PollSeconds, "workers", c.Workers, "max_attempts", c.MaxAttempts)
+	client := webhookClient(time.Duration(c.TimeoutSeconds) * time.Second)
+	t := time.NewTicker(time.Duration(c.PollSeconds) * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			deliveries, err := db.WebhookDeliveries(
+				db.WebhookDeliveryWhere.Status.EQ(deliveryPending),
+				db.WebhookDeliveryWhere.NextAttemptAt.LTE(time.Now().UTC()),
+				qm.OrderBy(db.WebhookDeliveryColumns.NextAttemptAt),
+				qm.Limit(100),
+			).AllG()
+			if err != nil {
+				log.Errorw("find due webhook deliveries", "err", err)
+				continue
+			}
+			sendDeliveries(ctx, client, c, d, deliveries, log)
+		}
+	}
+}
+
+// sendDeliveries attempts the deliveries with up to c.Workers at a time, so a few slow receivers don't hold up the rest.
+// It returns once every delivery has been attempted, or the context is done, before the next poll can find them again.
+func sendDeliveries(ctx context.Context, client *http.Client, c *WebhookConfig, d *Darer, deliveries db.WebhookDeliverySlice, log *zap.SugaredLogger) {
+	workers := c.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	queue := make(chan *db.WebhookDelivery)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range queue {
+				err := deliver(ctx, client, c, d, delivery)
+				if err != nil {
+					log.Errorw("deliver webhook", "delivery_id", delivery.ID, "webhook_id", delivery.WebhookID, "event", delivery.Event, "err", err)
+				}
+			}
+		}()
+	}
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- delivery
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// deliver makes one attempt at sending the delivery and records the outcome.
+// Only errors recording the outcome are returned, failed attempts are retried.
+func deliver(ctx context.Context, client *http.Client, c *WebhookConfig, d *Darer, delivery *db.WebhookDelivery) error {
+	webhook, err := db.FindWebhookG(delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = null.TimeFrom(now)
+	status, err := post(ctx, client, d, webhook, delivery)
+	delivery.ResponseStatus = null.Int64{}
+	if status != 0 {
+		delivery.ResponseStatus = null.Int64From(int64(status))
+	}
+	switch {
+	case webhook.Archived:
+		delivery.Status = deliveryFailed
+		delivery.LastError = null.StringFrom("webhook was deleted")
+	case err == nil:
+		delivery.Status = deliveryDelivered
+		delivery.DeliveredAt = null.TimeFrom(now)
+		delivery.LastError = null.String{}
+	case delivery.Attempts >= int64(c.MaxAttempts):
+		delivery.Status = deliveryFailed
+		delivery.LastError = null.StringFrom(err.Error())
+	default:
+		delivery.LastError = null.StringFrom(err.Error())
+		delivery.NextAttemptAt = now.Add(backoff(c, delivery.Attempts))
+	}
+	_, err = delivery.UpdateG(boil.Infer())
+	return err
+}
+
+// post sends the delivery, succeeding on any 2xx response
+func post(ctx context.Context, client *http.Client, d *Darer, webhook *db.Webhook, delivery *db.WebhookDelivery) (int, error) {
+	if webhook.Archived {
+		return 0, nil
+	}
+	secret, err := d.decrypt(webhook.Secret, webhook.SecretNonce)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Accumulator-Webhooks/0.0.1")
+	req.Header.Set("X-Accumulator-Event", delivery.Event)
+	req.Header.Set("X-Accumulator-Delivery", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set("X-Accumulator-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Accumulator-Signature", "sha256="+signPayload(string(secret), timestamp, []byte(delivery.Payload)))
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// the body is drained so the connection can be reused, it is never stored: last_error is shown to the webhook's owner,
+	// who could otherwise read whatever the target answers
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("non 2xx response: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff doubles the delay after every attempt, with jitter so deliveries that failed together don't retry together
+func backoff(c *WebhookConfig, attempts int64) time.Duration {
+	delay := time.Duration(c.BackoffSeconds) * time.Second
+	max := time.Duration(c.MaxBackoffMinutes) * time.Minute
+	for i := int64(1); i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay + time.Duration(mrand.Int63n(int64(delay)/10+1))
+}
@@ -0,0 +1,195 @@
+package accumulator
+
+import (
+	"accumulator/db"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/boil"
+	"go.uber.org/zap"
+)
+
+func TestPublicIP(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":      true,
+		"2606:2800:220:1::1": true,
+		"127.0.0.1":          false,
+		"10.1.2.3":           false,
+		"172.16.0.1":         false,
+		"192.168.1.1":        false,
+		"100.64.0.1":         false,
+		"169.254.169.254":    false,
+		"0.0.0.0":            false,
+		"::1":                false,
+		"::":                 false,
+		"fd00::1":            false,
+		"fe80::1":            false,
+		"::ffff:127.0.0.1":   false,
+		"::ffff:169.254.1.1": false,
+	} {
+		if got := publicIP(net.ParseIP(addr)); got != want {
+			t.Errorf("publicIP(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestCheckWebhookURL(t *testing.T) {
+	for rawURL, ok := range map[string]bool{
+		"https://93.184.216.34/hooks":              true,
+		"http://127.0.0.1:8080/hooks":              false,
+		"http://localhost/hooks":                   false,
+		"http://169.254.169.254/latest/meta-data/": false,
+		"http://[::1]/hooks":                       false,
+		"http://[::ffff:10.0.0.1]/hooks":           false,
+	} {
+		err := checkWebhookURL(context.Background(), rawURL)
+		if ok && err != nil {
+			t.Errorf("%s: %v", rawURL, err)
+		}
+		validationErr := &ValidationError{}
+		if !ok && !errors.As(err, &validationErr) {
+			t.Errorf("%s: got %v, want a validation error", rawURL, err)
+		}
+	}
+}
+
+func TestWebhookClientRefusesPrivateTargets(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+	_, err := webhookClient(time.Second).Post(srv.URL, "application/json", nil)
+	if !errors.Is(err, errPrivateTarget) {
+		t.Errorf("got %v posting to %s, want errPrivateTarget", err, srv.URL)
+	}
+	if called {
+		t.Error("the loopback server was called")
+	}
+}
+
+// slowReceiver answers every delivery after a delay, recording how many it was answering at once
+type slowReceiver struct {
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (s *slowReceiver) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	s.current++
+	if s.current > s.max {
+		s.max = s.current
+	}
+	s.mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	s.mu.Lock()
+	s.current--
+	s.mu.Unlock()
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Request: req}, nil
+}
+
+func TestSendDeliveries(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		u, err := CreateUser("admin@example.com", "password", roleAdmin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		integration := &db.Integration{UserID: u.ID, Username: "webhooks", APIKey: "key", AuthToken: []byte{}, AuthTokenNonce: []byte{}}
+		err = integration.InsertG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := NewDarer(testMasterKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = CreateWebhook(d, integration.ID, "https://hooks.example.com/accumulator", []string{EventStudentJoined})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 12; i++ {
+			err = emit(newEvent(integration.ID, EventStudentJoined, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		deliveries, err := db.WebhookDeliveries().AllG()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		receiver := &slowReceiver{}
+		config := &WebhookConfig{Workers: 4, TimeoutSeconds: 10, MaxAttempts: 8, BackoffSeconds: 30, MaxBackoffMinutes: 360}
+		sendDeliveries(context.Background(), &http.Client{Transport: receiver}, config, d, deliveries, zap.NewNop().Sugar())
+
+		if receiver.max != config.Workers {
+			t.Errorf("sent %d deliveries at once, want %d", receiver.max, config.Workers)
+		}
+		delivered, err := db.WebhookDeliveries(db.WebhookDeliveryWhere.Status.EQ(deliveryDelivered)).CountG()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if delivered != int64(len(deliveries)) {
+			t.Errorf("delivered %d of %d", delivered, len(deliveries))
+		}
+	})
+}
+
+func TestFailedDeliveryKeepsOnlyTheStatus(t *testing.T) {
+	withDatabases(t, func(t *testing.T, c *DatabaseConfig) {
+		u, err := CreateUser("admin@example.com", "password", roleAdmin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		integration := &db.Integration{UserID: u.ID, Username: "webhooks", APIKey: "key", AuthToken: []byte{}, AuthTokenNonce: []byte{}}
+		err = integration.InsertG(boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := NewDarer(testMasterKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = CreateWebhook(d, integration.ID, "https://hooks.example.com/accumulator", []string{EventStudentJoined})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = emit(newEvent(integration.ID, EventStudentJoined, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		delivery, err := db.WebhookDeliveries().OneG()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the target answers with something the webhook's owner shouldn't be able to read
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "secret internal page", http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+		config := &WebhookConfig{Workers: 1, TimeoutSeconds: 10, MaxAttempts: 8, BackoffSeconds: 30, MaxBackoffMinutes: 360}
+		_, err = db.Webhooks().UpdateAllG(db.M{db.WebhookColumns.URL: srv.URL})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = deliver(context.Background(), srv.Client(), config, d, delivery)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if delivery.Status != deliveryPending || delivery.ResponseStatus.Int64 != http.StatusInternalServerError {
+			t.Errorf("got %s with status %v, want it pending with a 500", delivery.Status, delivery.ResponseStatus)
+		}
+		if strings.Contains(delivery.LastError.String, "secret") {
+			t.Errorf("last error %q has the response body", delivery.LastError.String)
+		}
+	})
+}